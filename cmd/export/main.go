@@ -0,0 +1,137 @@
+// Command export writes the enrollments, joined with student email and course name, to a CSV or
+// JSON Lines file or to stdout. Rows are streamed from the database, so exports of any size fit in memory.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"github/rakadityas/course-management-system/common/auth"
+	"github/rakadityas/course-management-system/common/database"
+	"github/rakadityas/course-management-system/config"
+	courseenrollmentdomain "github/rakadityas/course-management-system/domain/course-enrollment"
+	exportusecase "github/rakadityas/course-management-system/use-case/export"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+)
+
+func main() {
+	configPath := flag.String("config", os.Getenv("CONFIG_FILE"), "path to a JSON or YAML config file")
+	format := flag.String("format", string(exportusecase.FormatCSV), "export format, csv or jsonl")
+	courseID := flag.Int64("course-id", 0, "only export enrollments of this course")
+	status := flag.String("status", "", "only export enrollments in these comma separated statuses")
+	from := flag.String("from", "", "only export enrollments created at or after this RFC 3339 time")
+	to := flag.String("to", "", "only export enrollments created before this RFC 3339 time")
+	outputPath := flag.String("output", "", "file to write the export to, stdout when empty")
+	flag.Parse()
+
+	cfg, err := config.LoadWithoutAuth(*configPath)
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+	req, err := exportRequest(*format, *courseID, *status, *from, *to)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	resp, err := run(ctx, cfg, req, *outputPath)
+	stop()
+	if err != nil {
+		log.Fatalf("%s after %d rows: %v", resp.Message, resp.Rows, err)
+	}
+	log.Printf("exported %d enrollments", resp.Rows)
+}
+
+// exportRequest builds the export request of the command line flags.
+func exportRequest(format string, courseID int64, status, from, to string) (exportusecase.ExportEnrollmentsRequest, error) {
+	req := exportusecase.ExportEnrollmentsRequest{Format: exportusecase.ExportFormat(format), CourseID: courseID}
+	if !req.Format.IsValid() {
+		return req, fmt.Errorf("invalid -format %q, want csv or jsonl", format)
+	}
+	if status != "" {
+		for _, name := range strings.Split(status, ",") {
+			var enrollmentStatus courseenrollmentdomain.EnrollmentStatus
+			if err := enrollmentStatus.UnmarshalText([]byte(strings.TrimSpace(name))); err != nil {
+				return req, fmt.Errorf("invalid -status %q", name)
+			}
+			req.Statuses = append(req.Statuses, enrollmentStatus)
+		}
+	}
+	for _, flagTime := range []struct {
+		name  string
+		value string
+		time  **time.Time
+	}{
+		{"from", from, &req.CreatedFrom},
+		{"to", to, &req.CreatedBefore},
+	} {
+		if flagTime.value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, flagTime.value)
+		if err != nil {
+			return req, fmt.Errorf("invalid -%s %q, want an RFC 3339 time", flagTime.name, flagTime.value)
+		}
+		*flagTime.time = &parsed
+	}
+
+	return req, nil
+}
+
+// run exports the enrollments matching the request to the file at outputPath, or to stdout when it is empty.
+// A failed close of the output file is reported as a write error, since buffered rows may be lost.
+func run(ctx context.Context, cfg config.Config, req exportusecase.ExportEnrollmentsRequest, outputPath string) (resp exportusecase.ExportResp, err error) {
+	var output io.Writer = os.Stdout
+	if outputPath != "" {
+		f, err := os.Create(outputPath)
+		if err != nil {
+			return exportusecase.ExportResp{Message: "failed to create the output file"}, err
+		}
+		defer func() {
+			if closeErr := f.Close(); closeErr != nil && err == nil {
+				resp.Message, err = "failed to write the output file", closeErr
+			}
+		}()
+		output = f
+	}
+
+	db, err := sql.Open("mysql", cfg.Database.URL)
+	if err != nil {
+		return exportusecase.ExportResp{Message: "failed to open the database"}, err
+	}
+	defer db.Close()
+	err = database.WaitUntilReady(ctx, db, database.Backoff{
+		Timeout:        cfg.Database.ReadyTimeout.Duration,
+		InitialBackoff: cfg.Database.ReadyInitialBackoff.Duration,
+		MaxBackoff:     cfg.Database.ReadyMaxBackoff.Duration,
+	})
+	if err != nil {
+		return exportusecase.ExportResp{Message: "database is not ready"}, err
+	}
+
+	reEnrollmentPolicy := courseenrollmentdomain.ReEnrollmentPolicy{
+		Cooldown:         cfg.Enrollment.ReEnrollmentCooldown.Duration,
+		MaxReEnrollments: cfg.Enrollment.MaxReEnrollments,
+	}
+	exportUseCase := exportusecase.NewExportUseCase(
+		courseenrollmentdomain.NewCourseEnrollmentService(courseenrollmentdomain.NewSQLCourseEnrollmentRepository(db), reEnrollmentPolicy, courseenrollmentdomain.NewGradingScale(cfg.Grading.Scale, cfg.Grading.MinPassingPoints)),
+	)
+
+	// Whoever can reach the database may export, so the command acts as an administrator
+	ctx = auth.WithPrincipal(ctx, auth.Principal{
+		Subject:     "export",
+		Roles:       []string{auth.RoleAdmin},
+		Permissions: []auth.Permission{auth.PermManageEnrollments},
+	})
+
+	return exportUseCase.ExportEnrollments(ctx, req, output)
+}
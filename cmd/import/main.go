@@ -0,0 +1,117 @@
+// Command import loads students, courses and enrollments from CSV files into the database in one
+// transaction, printing the import report as JSON. It exits non-zero when a row is invalid.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"flag"
+	"github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/auth"
+	"github/rakadityas/course-management-system/common/database"
+	"github/rakadityas/course-management-system/common/transaction"
+	"github/rakadityas/course-management-system/config"
+	coursedomain "github/rakadityas/course-management-system/domain/course"
+	courseenrollmentdomain "github/rakadityas/course-management-system/domain/course-enrollment"
+	sectiondomain "github/rakadityas/course-management-system/domain/section"
+	studentdomain "github/rakadityas/course-management-system/domain/student"
+	importusecase "github/rakadityas/course-management-system/use-case/import"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	_ "github.com/go-sql-driver/mysql"
+)
+
+func main() {
+	configPath := flag.String("config", os.Getenv("CONFIG_FILE"), "path to a JSON or YAML config file")
+	studentsPath := flag.String("students", "", "CSV file of students to import")
+	coursesPath := flag.String("courses", "", "CSV file of courses to import")
+	enrollmentsPath := flag.String("enrollments", "", "CSV file of enrollments to import")
+	dryRun := flag.Bool("dry-run", false, "validate every row without importing")
+	flag.Parse()
+
+	cfg, err := config.LoadWithoutAuth(*configPath)
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+	if *studentsPath == "" && *coursesPath == "" && *enrollmentsPath == "" {
+		log.Fatal("one of -students, -courses or -enrollments is required")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	resp, err := run(ctx, cfg, *studentsPath, *coursesPath, *enrollmentsPath, *dryRun)
+	stop()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	encoder.Encode(resp)
+	if resp.Status != common.StatusSuccess {
+		os.Exit(1)
+	}
+}
+
+// run imports the given files, an empty path leaves that file out.
+// main prints the report and sets the exit code only after run has closed the files and the database.
+func run(ctx context.Context, cfg config.Config, studentsPath, coursesPath, enrollmentsPath string, dryRun bool) (importusecase.ImportResp, error) {
+	req := importusecase.ImportRequest{DryRun: dryRun}
+	for _, file := range []struct {
+		path   string
+		reader *io.Reader
+	}{
+		{studentsPath, &req.Students},
+		{coursesPath, &req.Courses},
+		{enrollmentsPath, &req.Enrollments},
+	} {
+		if file.path == "" {
+			continue
+		}
+		f, err := os.Open(file.path)
+		if err != nil {
+			return importusecase.ImportResp{}, err
+		}
+		defer f.Close()
+		*file.reader = f
+	}
+
+	db, err := sql.Open("mysql", cfg.Database.URL)
+	if err != nil {
+		return importusecase.ImportResp{}, err
+	}
+	defer db.Close()
+	err = database.WaitUntilReady(ctx, db, database.Backoff{
+		Timeout:        cfg.Database.ReadyTimeout.Duration,
+		InitialBackoff: cfg.Database.ReadyInitialBackoff.Duration,
+		MaxBackoff:     cfg.Database.ReadyMaxBackoff.Duration,
+	})
+	if err != nil {
+		return importusecase.ImportResp{}, err
+	}
+
+	reEnrollmentPolicy := courseenrollmentdomain.ReEnrollmentPolicy{
+		Cooldown:         cfg.Enrollment.ReEnrollmentCooldown.Duration,
+		MaxReEnrollments: cfg.Enrollment.MaxReEnrollments,
+	}
+	importUseCase := importusecase.NewImportUseCase(
+		studentdomain.NewStudentService(studentdomain.NewSQLStudentRepository(db)),
+		coursedomain.NewCourseService(coursedomain.NewSQLCourseRepository(db)),
+		sectiondomain.NewSectionService(sectiondomain.NewSQLSectionRepository(db)),
+		courseenrollmentdomain.NewCourseEnrollmentService(courseenrollmentdomain.NewSQLCourseEnrollmentRepository(db), reEnrollmentPolicy, courseenrollmentdomain.NewGradingScale(cfg.Grading.Scale, cfg.Grading.MinPassingPoints)),
+		transaction.NewSQLUnitOfWork(db),
+	)
+
+	// Whoever can reach the database may import, so the command acts as an administrator
+	ctx = auth.WithPrincipal(ctx, auth.Principal{
+		Subject:     "import",
+		Roles:       []string{auth.RoleAdmin},
+		Permissions: []auth.Permission{auth.PermManageStudents, auth.PermManageCourses, auth.PermManageEnrollments},
+	})
+
+	return importUseCase.Import(ctx, req)
+}
@@ -1,17 +1,35 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"flag"
 	"fmt"
+	"github/rakadityas/course-management-system/common/auth"
+	"github/rakadityas/course-management-system/common/database"
+	"github/rakadityas/course-management-system/common/health"
+	"github/rakadityas/course-management-system/common/logger"
+	"github/rakadityas/course-management-system/common/server"
+	"github/rakadityas/course-management-system/common/transaction"
+	"github/rakadityas/course-management-system/config"
+	accessdomain "github/rakadityas/course-management-system/domain/access"
 	coursedomain "github/rakadityas/course-management-system/domain/course"
 	courseenrollmentdomain "github/rakadityas/course-management-system/domain/course-enrollment"
+	instructordomain "github/rakadityas/course-management-system/domain/instructor"
+	sectiondomain "github/rakadityas/course-management-system/domain/section"
 	studentdomain "github/rakadityas/course-management-system/domain/student"
+	termdomain "github/rakadityas/course-management-system/domain/term"
 	"os"
-	"time"
+	"os/signal"
+	"syscall"
 
 	handlers "github/rakadityas/course-management-system/handlers"
 	"github/rakadityas/course-management-system/routes"
+	catalogusecase "github/rakadityas/course-management-system/use-case/catalog"
 	enrollmentusecase "github/rakadityas/course-management-system/use-case/enrollment"
+	exportusecase "github/rakadityas/course-management-system/use-case/export"
+	importusecase "github/rakadityas/course-management-system/use-case/import"
+	studentusecase "github/rakadityas/course-management-system/use-case/student"
 	"log"
 	"net/http"
 
@@ -19,48 +37,113 @@ import (
 )
 
 func main() {
-	// Get the database connection string from the environment
-	databaseURL := os.Getenv("DATABASE_URL")
-	if databaseURL == "" {
-		log.Fatal("DATABASE_URL is not set")
+	// Load the configuration file, if any, with environment overrides on top
+	configPath := flag.String("config", os.Getenv("CONFIG_FILE"), "path to a JSON or YAML config file")
+	flag.Parse()
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
 	}
 
-	// Get the app port string from the environment
-	appPort := os.Getenv("APP_PORT")
-	if appPort == "" {
-		log.Fatal("APP_PORT is not set")
+	// SIGTERM (sent on deploys) and SIGINT start a graceful shutdown
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, cfg); err != nil {
+		log.Fatal(err)
 	}
+	logger.Infof("server stopped")
+}
 
-	// TODO: find more elegant way for solving racing issue during docker compose up due to DB not yet ready
-	time.Sleep(10 * time.Second)
+// run starts the application and blocks until ctx is done and the server has drained.
+// It returns instead of exiting so that deferred cleanup, such as closing the database, always runs.
+func run(ctx context.Context, cfg config.Config) error {
+	logLevel, _ := logger.ParseLevel(cfg.Log.Level) // validated by config.Load
+	logger.SetLevel(logLevel)
 
 	// initialize database connection
-	db, err := sql.Open("mysql", databaseURL)
+	db, err := sql.Open("mysql", cfg.Database.URL)
 	if err != nil {
-		log.Fatalf("failed to connect to database: %v", err)
+		return fmt.Errorf("failed to connect to database: %w", err)
 	}
-	defer db.Close()
+	defer func() {
+		if err := db.Close(); err != nil {
+			logger.Errorf("failed to close database: %v", err)
+		}
+	}()
+	db.SetMaxOpenConns(cfg.Database.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.Database.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.Database.ConnMaxLifetime.Duration)
 
-	if err := db.Ping(); err != nil {
-		log.Fatalf("Failed to ping database: %v", err)
+	// Wait for the database, which may still be starting when running under docker compose
+	err = database.WaitUntilReady(ctx, db, database.Backoff{
+		Timeout:        cfg.Database.ReadyTimeout.Duration,
+		InitialBackoff: cfg.Database.ReadyInitialBackoff.Duration,
+		MaxBackoff:     cfg.Database.ReadyMaxBackoff.Duration,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to ping database: %w", err)
 	}
 
 	// initialize domains
 	studentService := studentdomain.NewStudentService(studentdomain.NewSQLStudentRepository(db))
 	courseService := coursedomain.NewCourseService(coursedomain.NewSQLCourseRepository(db))
-	courseEnrollmentService := courseenrollmentdomain.NewCourseEnrollmentService(courseenrollmentdomain.NewSQLCourseEnrollmentRepository(db))
+	termService := termdomain.NewTermService(termdomain.NewSQLTermRepository(db))
+	sectionService := sectiondomain.NewSectionService(sectiondomain.NewSQLSectionRepository(db))
+	reEnrollmentPolicy := courseenrollmentdomain.ReEnrollmentPolicy{
+		Cooldown:         cfg.Enrollment.ReEnrollmentCooldown.Duration,
+		MaxReEnrollments: cfg.Enrollment.MaxReEnrollments,
+	}
+	courseEnrollmentService := courseenrollmentdomain.NewCourseEnrollmentService(courseenrollmentdomain.NewSQLCourseEnrollmentRepository(db), reEnrollmentPolicy, courseenrollmentdomain.NewGradingScale(cfg.Grading.Scale, cfg.Grading.MinPassingPoints))
+	instructorService := instructordomain.NewInstructorService(instructordomain.NewSQLInstructorRepository(db))
+	accessService := accessdomain.NewAccessService(accessdomain.NewSQLAccessRepository(db))
 
 	// initialize use cases
-	enrollmentUseCase := enrollmentusecase.NewEnrollmentUseCase(studentService, courseService, courseEnrollmentService)
+	unitOfWork := transaction.NewSQLUnitOfWork(db)
+	enrollmentFeatures := enrollmentusecase.Features{
+		Waitlist:      cfg.Features.Waitlist,
+		Prerequisites: cfg.Features.Prerequisites,
+	}
+	creditLoadPolicy := courseenrollmentdomain.CreditLoadPolicy{
+		MaxCredits:         cfg.Enrollment.MaxCreditsPerTerm,
+		MinFullTimeCredits: cfg.Enrollment.MinFullTimeCredits,
+	}
+	enrollmentUseCase := enrollmentusecase.NewEnrollmentUseCase(studentService, courseService, sectionService, termService, courseEnrollmentService, instructorService, unitOfWork, enrollmentFeatures, creditLoadPolicy)
+	studentUseCase := studentusecase.NewStudentUseCase(studentService, enrollmentUseCase, unitOfWork)
+	catalogUseCase := catalogusecase.NewCatalogUseCase(courseService, instructorService, sectionService, termService, unitOfWork)
+	importUseCase := importusecase.NewImportUseCase(studentService, courseService, sectionService, courseEnrollmentService, unitOfWork)
+	exportUseCase := exportusecase.NewExportUseCase(courseEnrollmentService)
+
+	// readiness turns unhealthy as soon as shutdown starts
+	readiness := &server.Readiness{}
+	readinessChecker := health.Checker{
+		Timeout: cfg.Database.PingTimeout.Duration,
+		Checks: []health.Check{
+			{Name: "server", Probe: readiness.Probe},
+			{Name: "database", Probe: db.PingContext},
+			{Name: "migrations", Probe: func(ctx context.Context) error { return database.CheckSchemaVersion(ctx, db) }},
+		},
+	}
 
 	// init http service
-	handler := handlers.NewHandler(enrollmentUseCase)
+	tokenVerifier := auth.NewHMACVerifier([]byte(cfg.Auth.HMACKey), cfg.Auth.Issuer, cfg.Auth.Leeway.Duration)
+	handler := handlers.NewHandler(enrollmentUseCase, studentUseCase, catalogUseCase, importUseCase, exportUseCase, readinessChecker, tokenVerifier, accessService)
 
 	// Setup routes
 	router := routes.SetupRoutes(handler)
 
-	fmt.Printf("Starting server on port %s\n", appPort)
-	if err := http.ListenAndServe(appPort, router); err != nil {
-		log.Fatalf("Error starting server: %v\n", err)
+	httpServer := &http.Server{
+		Addr:         cfg.HTTP.Port,
+		Handler:      router,
+		ReadTimeout:  cfg.HTTP.ReadTimeout.Duration,
+		WriteTimeout: cfg.HTTP.WriteTimeout.Duration,
+		IdleTimeout:  cfg.HTTP.IdleTimeout.Duration,
 	}
+
+	logger.Infof("Starting server on port %s", cfg.HTTP.Port)
+	return server.Run(ctx, httpServer, readiness, server.ShutdownOptions{
+		Delay:   cfg.HTTP.ShutdownDelay.Duration,
+		Timeout: cfg.HTTP.ShutdownTimeout.Duration,
+	})
 }
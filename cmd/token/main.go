@@ -0,0 +1,52 @@
+// Command token prints a signed bearer token for local development and testing.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github/rakadityas/course-management-system/common/auth"
+	"github/rakadityas/course-management-system/config"
+	"log"
+	"os"
+	"time"
+)
+
+func main() {
+	configPath := flag.String("config", os.Getenv("CONFIG_FILE"), "path to a JSON or YAML config file")
+	studentID := flag.Int64("student-id", 0, "student the token acts for")
+	instructorID := flag.Int64("instructor-id", 0, "instructor the token acts for")
+	subject := flag.String("subject", "", "token subject, defaults to the student or instructor subject")
+	ttl := flag.Duration("ttl", time.Hour, "token lifetime")
+	flag.Parse()
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+	if *subject == "" {
+		switch {
+		case *studentID != 0:
+			*subject = auth.StudentSubject(*studentID)
+		case *instructorID != 0:
+			*subject = auth.InstructorSubject(*instructorID)
+		default:
+			log.Fatal("one of -student-id, -instructor-id or -subject is required")
+		}
+	}
+
+	now := time.Now()
+	verifier := auth.NewHMACVerifier([]byte(cfg.Auth.HMACKey), cfg.Auth.Issuer, cfg.Auth.Leeway.Duration)
+	token, err := verifier.Sign(auth.Claims{
+		Subject:      *subject,
+		Issuer:       cfg.Auth.Issuer,
+		StudentID:    *studentID,
+		InstructorID: *instructorID,
+		IssuedAt:     now.Unix(),
+		ExpiresAt:    now.Add(*ttl).Unix(),
+	})
+	if err != nil {
+		log.Fatalf("failed to sign token: %v", err)
+	}
+
+	fmt.Println(token)
+}
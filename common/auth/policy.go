@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"context"
+	"errors"
+)
+
+// Policy errors
+var (
+	ErrUnauthenticated = errors.New("authentication required")
+	ErrForbidden       = errors.New("permission denied")
+)
+
+// Permission is an action a role allows. Roles and their permissions are stored in MySQL.
+type Permission string
+
+// Permissions
+const (
+	PermManageCourses        Permission = "courses:manage"
+	PermManageStudents       Permission = "students:manage"
+	PermManageEnrollments    Permission = "enrollments:manage"     // act on any student's enrollments
+	PermManageOwnEnrollments Permission = "enrollments:manage_own" // act on the caller's own enrollments
+	PermViewRosters          Permission = "rosters:view"
+	PermWriteGrades          Permission = "grades:write"
+)
+
+// Roles
+const (
+	RoleAdmin      = "admin"
+	RoleInstructor = "instructor"
+	// RoleStudent is granted to every principal with a student ID, without a stored assignment.
+	RoleStudent = "student"
+)
+
+// PrincipalResolver fills in the roles and permissions of an authenticated principal.
+type PrincipalResolver interface {
+	ResolvePrincipal(ctx context.Context, principal Principal) (Principal, error)
+}
+
+// Can reports whether the principal holds the permission.
+func (p Principal) Can(permission Permission) bool {
+	for _, granted := range p.Permissions {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// HasRole reports whether the principal holds the role.
+func (p Principal) HasRole(role string) bool {
+	for _, granted := range p.Roles {
+		if granted == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Authorize returns nil when the principal of ctx holds any of the permissions,
+// ErrUnauthenticated when ctx has no principal and ErrForbidden otherwise.
+func Authorize(ctx context.Context, permissions ...Permission) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	for _, permission := range permissions {
+		if principal.Can(permission) {
+			return nil
+		}
+	}
+	return ErrForbidden
+}
+
+// AuthorizeStudent returns nil when the principal of ctx may act on the enrollments of studentID:
+// either its own with PermManageOwnEnrollments, or anyone's with PermManageEnrollments.
+func AuthorizeStudent(ctx context.Context, studentID int64) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	if principal.Can(PermManageEnrollments) {
+		return nil
+	}
+	if principal.Can(PermManageOwnEnrollments) && principal.IsStudent() && principal.StudentID == studentID {
+		return nil
+	}
+	return ErrForbidden
+}
+
+// AuthorizeSelf returns nil when the principal of ctx is the student studentID
+// or holds any of the permissions.
+func AuthorizeSelf(ctx context.Context, studentID int64, permissions ...Permission) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	if principal.IsStudent() && principal.StudentID == studentID {
+		return nil
+	}
+	return Authorize(ctx, permissions...)
+}
@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestAuthorizeStudent(t *testing.T) {
+	student := Principal{Subject: StudentSubject(1), StudentID: 1, Roles: []string{RoleStudent}, Permissions: []Permission{PermManageOwnEnrollments}}
+	admin := Principal{Subject: "admin", Roles: []string{RoleAdmin}, Permissions: []Permission{PermManageEnrollments}}
+	instructor := Principal{Subject: "instructor:1", Roles: []string{RoleInstructor}, Permissions: []Permission{PermViewRosters}}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		studentID int64
+		wantErr   error
+	}{
+		{
+			name:      "Own Enrollments",
+			ctx:       WithPrincipal(context.Background(), student),
+			studentID: 1,
+		},
+		{
+			name:      "Another Student",
+			ctx:       WithPrincipal(context.Background(), student),
+			studentID: 2,
+			wantErr:   ErrForbidden,
+		},
+		{
+			name:      "Admin Acts For Any Student",
+			ctx:       WithPrincipal(context.Background(), admin),
+			studentID: 2,
+		},
+		{
+			name:      "Instructor",
+			ctx:       WithPrincipal(context.Background(), instructor),
+			studentID: 1,
+			wantErr:   ErrForbidden,
+		},
+		{
+			name:      "Unauthenticated",
+			ctx:       context.Background(),
+			studentID: 1,
+			wantErr:   ErrUnauthenticated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := AuthorizeStudent(tt.ctx, tt.studentID); !errors.Is(err, tt.wantErr) {
+				t.Errorf("AuthorizeStudent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	admin := Principal{Subject: "admin", Permissions: []Permission{PermManageCourses, PermManageStudents}}
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		permissions []Permission
+		wantErr     error
+	}{
+		{
+			name:        "Holds Permission",
+			ctx:         WithPrincipal(context.Background(), admin),
+			permissions: []Permission{PermManageStudents},
+		},
+		{
+			name:        "Holds One Of Permissions",
+			ctx:         WithPrincipal(context.Background(), admin),
+			permissions: []Permission{PermWriteGrades, PermManageCourses},
+		},
+		{
+			name:        "Lacks Permission",
+			ctx:         WithPrincipal(context.Background(), admin),
+			permissions: []Permission{PermWriteGrades},
+			wantErr:     ErrForbidden,
+		},
+		{
+			name:        "Unauthenticated",
+			ctx:         context.Background(),
+			permissions: []Permission{PermManageCourses},
+			wantErr:     ErrUnauthenticated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Authorize(tt.ctx, tt.permissions...); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Authorize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAuthorizeSelf(t *testing.T) {
+	student := Principal{Subject: StudentSubject(1), StudentID: 1, Roles: []string{RoleStudent}}
+	admin := Principal{Subject: "admin", Roles: []string{RoleAdmin}, Permissions: []Permission{PermManageStudents}}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		studentID int64
+		wantErr   error
+	}{
+		{
+			name:      "Self",
+			ctx:       WithPrincipal(context.Background(), student),
+			studentID: 1,
+		},
+		{
+			name:      "Another Student",
+			ctx:       WithPrincipal(context.Background(), student),
+			studentID: 2,
+			wantErr:   ErrForbidden,
+		},
+		{
+			name:      "Holds Permission",
+			ctx:       WithPrincipal(context.Background(), admin),
+			studentID: 2,
+		},
+		{
+			name:      "Unauthenticated",
+			ctx:       context.Background(),
+			studentID: 1,
+			wantErr:   ErrUnauthenticated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := AuthorizeSelf(tt.ctx, tt.studentID, PermManageStudents); !errors.Is(err, tt.wantErr) {
+				t.Errorf("AuthorizeSelf() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package auth
+
+import "context"
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	Subject      string
+	StudentID    int64 // zero when the caller is not a student
+	InstructorID int64 // zero when the caller is not an instructor
+	Roles        []string
+	Permissions  []Permission
+}
+
+// IsStudent reports whether the principal acts as a student.
+func (p Principal) IsStudent() bool {
+	return p.StudentID != 0
+}
+
+// IsInstructor reports whether the principal acts as an instructor.
+func (p Principal) IsInstructor() bool {
+	return p.InstructorID != 0
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying the authenticated principal.
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the authenticated principal of ctx, if any.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
+
+// TokenVerifier validates a bearer token and returns the principal it identifies.
+type TokenVerifier interface {
+	Verify(token string) (Principal, error)
+}
@@ -0,0 +1,148 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Token errors
+var (
+	ErrMalformedToken = errors.New("malformed token")
+	ErrUnsupportedAlg = errors.New("unsupported token algorithm")
+	ErrBadSignature   = errors.New("invalid token signature")
+	ErrTokenExpired   = errors.New("token is expired")
+	ErrTokenNotActive = errors.New("token is not valid yet")
+	ErrInvalidIssuer  = errors.New("invalid token issuer")
+)
+
+// algHS256 is the only JWT algorithm accepted, so a token cannot downgrade itself to "none".
+const algHS256 = "HS256"
+
+type tokenHeader struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ,omitempty"`
+}
+
+// Claims are the JWT claims understood by the application.
+type Claims struct {
+	Subject      string `json:"sub"`
+	Issuer       string `json:"iss,omitempty"`
+	StudentID    int64  `json:"student_id,omitempty"`
+	InstructorID int64  `json:"instructor_id,omitempty"`
+	IssuedAt     int64  `json:"iat,omitempty"`
+	NotBefore    int64  `json:"nbf,omitempty"`
+	ExpiresAt    int64  `json:"exp"`
+}
+
+// HMACVerifier signs and verifies HS256 JSON Web Tokens with a locally configured key.
+type HMACVerifier struct {
+	key    []byte
+	issuer string
+	leeway time.Duration
+	now    func() time.Time
+}
+
+// NewHMACVerifier creates a verifier for tokens signed with key. When issuer is not empty,
+// tokens must carry it in the iss claim. leeway tolerates clock skew on exp and nbf.
+func NewHMACVerifier(key []byte, issuer string, leeway time.Duration) *HMACVerifier {
+	return &HMACVerifier{
+		key:    key,
+		issuer: issuer,
+		leeway: leeway,
+		now:    time.Now,
+	}
+}
+
+// Sign returns a signed token for claims.
+func (v *HMACVerifier) Sign(claims Claims) (string, error) {
+	headerJSON, err := json.Marshal(tokenHeader{Alg: algHS256, Typ: "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(claimsJSON)
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(v.signature(signingInput)), nil
+}
+
+// Verify checks the token signature and time claims and returns the principal it identifies.
+func (v *HMACVerifier) Verify(token string) (Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return Principal{}, ErrMalformedToken
+	}
+
+	var header tokenHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return Principal{}, err
+	}
+	if header.Alg != algHS256 {
+		return Principal{}, fmt.Errorf("%w: %q", ErrUnsupportedAlg, header.Alg)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return Principal{}, ErrMalformedToken
+	}
+	if !hmac.Equal(signature, v.signature(parts[0]+"."+parts[1])) {
+		return Principal{}, ErrBadSignature
+	}
+
+	var claims Claims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return Principal{}, err
+	}
+	if claims.Subject == "" || claims.ExpiresAt == 0 {
+		return Principal{}, fmt.Errorf("%w: sub and exp are required", ErrMalformedToken)
+	}
+
+	now := v.now()
+	if now.After(time.Unix(claims.ExpiresAt, 0).Add(v.leeway)) {
+		return Principal{}, ErrTokenExpired
+	}
+	if claims.NotBefore != 0 && now.Add(v.leeway).Before(time.Unix(claims.NotBefore, 0)) {
+		return Principal{}, ErrTokenNotActive
+	}
+	if v.issuer != "" && claims.Issuer != v.issuer {
+		return Principal{}, ErrInvalidIssuer
+	}
+
+	return Principal{Subject: claims.Subject, StudentID: claims.StudentID, InstructorID: claims.InstructorID}, nil
+}
+
+func (v *HMACVerifier) signature(signingInput string) []byte {
+	mac := hmac.New(sha256.New, v.key)
+	mac.Write([]byte(signingInput))
+	return mac.Sum(nil)
+}
+
+func decodeSegment(segment string, dest interface{}) error {
+	decoded, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return ErrMalformedToken
+	}
+	if err := json.Unmarshal(decoded, dest); err != nil {
+		return ErrMalformedToken
+	}
+	return nil
+}
+
+// StudentSubject returns the conventional token subject for a student.
+func StudentSubject(studentID int64) string {
+	return "student:" + strconv.FormatInt(studentID, 10)
+}
+
+// InstructorSubject returns the conventional token subject for an instructor.
+func InstructorSubject(instructorID int64) string {
+	return "instructor:" + strconv.FormatInt(instructorID, 10)
+}
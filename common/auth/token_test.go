@@ -0,0 +1,138 @@
+package auth
+
+import (
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHMACVerifier_Verify(t *testing.T) {
+	now := time.Date(2024, 8, 25, 12, 0, 0, 0, time.UTC)
+	verifier := NewHMACVerifier([]byte("0123456789abcdef0123456789abcdef"), "course-management-system", time.Minute)
+	verifier.now = func() time.Time { return now }
+
+	sign := func(claims Claims) string {
+		token, err := verifier.Sign(claims)
+		if err != nil {
+			t.Fatalf("Sign() error = %v", err)
+		}
+		return token
+	}
+	validClaims := Claims{
+		Subject:   StudentSubject(1),
+		Issuer:    "course-management-system",
+		StudentID: 1,
+		ExpiresAt: now.Add(time.Hour).Unix(),
+	}
+
+	tests := []struct {
+		name    string
+		token   func() string
+		want    Principal
+		wantErr error
+	}{
+		{
+			name:  "Valid Token",
+			token: func() string { return sign(validClaims) },
+			want:  Principal{Subject: "student:1", StudentID: 1},
+		},
+		{
+			name: "Instructor Token",
+			token: func() string {
+				return sign(Claims{Subject: InstructorSubject(2), Issuer: "course-management-system", InstructorID: 2, ExpiresAt: now.Add(time.Hour).Unix()})
+			},
+			want: Principal{Subject: "instructor:2", InstructorID: 2},
+		},
+		{
+			name: "Expired Within Leeway",
+			token: func() string {
+				claims := validClaims
+				claims.ExpiresAt = now.Add(-30 * time.Second).Unix()
+				return sign(claims)
+			},
+			want: Principal{Subject: "student:1", StudentID: 1},
+		},
+		{
+			name: "Expired",
+			token: func() string {
+				claims := validClaims
+				claims.ExpiresAt = now.Add(-time.Hour).Unix()
+				return sign(claims)
+			},
+			wantErr: ErrTokenExpired,
+		},
+		{
+			name: "Not Valid Yet",
+			token: func() string {
+				claims := validClaims
+				claims.NotBefore = now.Add(time.Hour).Unix()
+				return sign(claims)
+			},
+			wantErr: ErrTokenNotActive,
+		},
+		{
+			name: "Wrong Issuer",
+			token: func() string {
+				claims := validClaims
+				claims.Issuer = "someone-else"
+				return sign(claims)
+			},
+			wantErr: ErrInvalidIssuer,
+		},
+		{
+			name: "Missing Expiry",
+			token: func() string {
+				claims := validClaims
+				claims.ExpiresAt = 0
+				return sign(claims)
+			},
+			wantErr: ErrMalformedToken,
+		},
+		{
+			name: "Signed With Another Key",
+			token: func() string {
+				other := NewHMACVerifier([]byte("another-key-another-key-another!!"), "", 0)
+				token, _ := other.Sign(validClaims)
+				return token
+			},
+			wantErr: ErrBadSignature,
+		},
+		{
+			name: "Tampered Claims",
+			token: func() string {
+				parts := strings.Split(sign(validClaims), ".")
+				parts[1] = base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"student:2","student_id":2,"exp":9999999999}`))
+				return strings.Join(parts, ".")
+			},
+			wantErr: ErrBadSignature,
+		},
+		{
+			name: "Algorithm None",
+			token: func() string {
+				parts := strings.Split(sign(validClaims), ".")
+				parts[0] = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+				return parts[0] + "." + parts[1] + "."
+			},
+			wantErr: ErrUnsupportedAlg,
+		},
+		{
+			name:    "Malformed",
+			token:   func() string { return "not-a-token" },
+			wantErr: ErrMalformedToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := verifier.Verify(tt.token())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Verify() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -5,3 +5,6 @@ const (
 	StatusSuccess = "success"
 	StatusFailure = "failure"
 )
+
+// MaxInClauseIDs caps the number of IDs bound into a single `IN (...)` query.
+const MaxInClauseIDs = 500
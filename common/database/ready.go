@@ -0,0 +1,50 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github/rakadityas/course-management-system/common/logger"
+)
+
+// Pinger checks whether the database accepts connections. *sql.DB implements it.
+type Pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+// Backoff configures how WaitUntilReady retries.
+type Backoff struct {
+	Timeout        time.Duration // total time allowed before giving up
+	InitialBackoff time.Duration // wait after the first failed ping
+	MaxBackoff     time.Duration // the wait doubles after every failure up to this value
+}
+
+// WaitUntilReady pings the database until it answers, backing off exponentially between attempts.
+// It returns the last ping error once the backoff timeout elapses or ctx is done.
+func WaitUntilReady(ctx context.Context, db Pinger, backoff Backoff) error {
+	ctx, cancel := context.WithTimeout(ctx, backoff.Timeout)
+	defer cancel()
+
+	wait := backoff.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		err := db.PingContext(ctx)
+		if err == nil {
+			return nil
+		}
+		logger.Warnf("database is not ready (attempt %d), retrying in %s: %v", attempt, wait, err)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("database not ready after %d attempts: %w", attempt, err)
+		case <-timer.C:
+		}
+
+		wait *= 2
+		if wait > backoff.MaxBackoff {
+			wait = backoff.MaxBackoff
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type fakePinger struct {
+	failures int
+	calls    int
+}
+
+func (p *fakePinger) PingContext(ctx context.Context) error {
+	p.calls++
+	if p.calls <= p.failures {
+		return errors.New("connection refused")
+	}
+	return nil
+}
+
+func TestWaitUntilReady(t *testing.T) {
+	tests := []struct {
+		name      string
+		failures  int
+		backoff   Backoff
+		wantCalls int
+		wantErr   bool
+	}{
+		{
+			name:      "Ready Immediately",
+			failures:  0,
+			backoff:   Backoff{Timeout: time.Second, InitialBackoff: time.Millisecond, MaxBackoff: 4 * time.Millisecond},
+			wantCalls: 1,
+			wantErr:   false,
+		},
+		{
+			name:      "Ready After Retries",
+			failures:  3,
+			backoff:   Backoff{Timeout: time.Second, InitialBackoff: time.Millisecond, MaxBackoff: 4 * time.Millisecond},
+			wantCalls: 4,
+			wantErr:   false,
+		},
+		{
+			name:     "Deadline Exceeded",
+			failures: 1000,
+			backoff:  Backoff{Timeout: 20 * time.Millisecond, InitialBackoff: 5 * time.Millisecond, MaxBackoff: 5 * time.Millisecond},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pinger := &fakePinger{failures: tt.failures}
+			err := WaitUntilReady(context.Background(), pinger, tt.backoff)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WaitUntilReady() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && pinger.calls != tt.wantCalls {
+				t.Errorf("WaitUntilReady() pinged %d times, want %d", pinger.calls, tt.wantCalls)
+			}
+		})
+	}
+}
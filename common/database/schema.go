@@ -0,0 +1,29 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// SchemaVersion is the latest numbered script in the db directory the application depends on.
+// Bump it whenever a new script is added.
+const SchemaVersion = 17
+
+// RowQueryer runs a query expected to return at most one row. *sql.DB implements it.
+type RowQueryer interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// CheckSchemaVersion returns an error unless the schema_migrations table records SchemaVersion or later.
+func CheckSchemaVersion(ctx context.Context, db RowQueryer) error {
+	var version sql.NullInt64
+	if err := db.QueryRowContext(ctx, "SELECT MAX(version) FROM schema_migrations").Scan(&version); err != nil {
+		return fmt.Errorf("failed to read schema version: %w", err)
+	}
+	if version.Int64 < SchemaVersion {
+		return fmt.Errorf("schema version %d is older than required version %d", version.Int64, SchemaVersion)
+	}
+
+	return nil
+}
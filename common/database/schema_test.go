@@ -0,0 +1,67 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCheckSchemaVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		mock    func(mock sqlmock.Sqlmock)
+		wantErr bool
+	}{
+		{
+			name: "Up To Date",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT MAX\\(version\\) FROM schema_migrations").
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(SchemaVersion))
+			},
+			wantErr: false,
+		},
+		{
+			name: "Outdated",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT MAX\\(version\\) FROM schema_migrations").
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(SchemaVersion - 1))
+			},
+			wantErr: true,
+		},
+		{
+			name: "No Migrations Recorded",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT MAX\\(version\\) FROM schema_migrations").
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(nil))
+			},
+			wantErr: true,
+		},
+		{
+			name: "Query Error",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT MAX\\(version\\) FROM schema_migrations").
+					WillReturnError(errors.New("table doesn't exist"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to open sqlmock: %v", err)
+			}
+			defer db.Close()
+			tt.mock(mock)
+
+			if err := CheckSchemaVersion(context.Background(), db); (err != nil) != tt.wantErr {
+				t.Errorf("CheckSchemaVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package common
+
+import (
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// mysqlErrDuplicateEntry is the MySQL error number for a unique key violation.
+const mysqlErrDuplicateEntry = 1062
+
+// IsDuplicateEntryError reports whether err is a MySQL unique key violation.
+func IsDuplicateEntryError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+}
@@ -0,0 +1,80 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Component status
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+// Check probes one dependency of the application. It returns nil when the dependency is healthy.
+type Check struct {
+	Name  string
+	Probe func(ctx context.Context) error
+}
+
+// ComponentReport is the result of a single Check.
+type ComponentReport struct {
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+	DurationMS int64  `json:"duration_ms"`
+}
+
+// Report is the overall health of the application and of each checked component.
+type Report struct {
+	Status     string                     `json:"status"`
+	Components map[string]ComponentReport `json:"components,omitempty"`
+}
+
+// IsUp reports whether every component is healthy.
+func (r Report) IsUp() bool {
+	return r.Status == StatusUp
+}
+
+// Checker runs a set of checks, each bounded by Timeout.
+type Checker struct {
+	Timeout time.Duration
+	Checks  []Check
+}
+
+// Run probes every check concurrently and reports the application as up only when all of them pass.
+func (c Checker) Run(ctx context.Context) Report {
+	report := Report{Status: StatusUp, Components: make(map[string]ComponentReport, len(c.Checks))}
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for _, check := range c.Checks {
+		wg.Add(1)
+		go func(check Check) {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, c.Timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := check.Probe(checkCtx)
+			component := ComponentReport{Status: StatusUp, DurationMS: time.Since(start).Milliseconds()}
+			if err != nil {
+				component.Status = StatusDown
+				component.Error = err.Error()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Components[check.Name] = component
+			if err != nil {
+				report.Status = StatusDown
+			}
+		}(check)
+	}
+	wg.Wait()
+
+	return report
+}
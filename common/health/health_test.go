@@ -0,0 +1,68 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestChecker_Run(t *testing.T) {
+	up := Check{Name: "up", Probe: func(ctx context.Context) error { return nil }}
+	down := Check{Name: "down", Probe: func(ctx context.Context) error { return errors.New("connection refused") }}
+	slow := Check{Name: "slow", Probe: func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}}
+
+	tests := []struct {
+		name           string
+		checks         []Check
+		wantStatus     string
+		wantComponents map[string]string
+	}{
+		{
+			name:           "All Up",
+			checks:         []Check{up},
+			wantStatus:     StatusUp,
+			wantComponents: map[string]string{"up": StatusUp},
+		},
+		{
+			name:           "One Down",
+			checks:         []Check{up, down},
+			wantStatus:     StatusDown,
+			wantComponents: map[string]string{"up": StatusUp, "down": StatusDown},
+		},
+		{
+			name:           "Timed Out",
+			checks:         []Check{slow},
+			wantStatus:     StatusDown,
+			wantComponents: map[string]string{"slow": StatusDown},
+		},
+		{
+			name:           "No Checks",
+			wantStatus:     StatusUp,
+			wantComponents: map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := Checker{Timeout: 10 * time.Millisecond, Checks: tt.checks}.Run(context.Background())
+			if report.Status != tt.wantStatus {
+				t.Errorf("Run() status = %q, want %q", report.Status, tt.wantStatus)
+			}
+			if len(report.Components) != len(tt.wantComponents) {
+				t.Fatalf("Run() components = %+v, want %v", report.Components, tt.wantComponents)
+			}
+			for name, wantStatus := range tt.wantComponents {
+				component := report.Components[name]
+				if component.Status != wantStatus {
+					t.Errorf("component %q status = %q, want %q", name, component.Status, wantStatus)
+				}
+				if (component.Error != "") != (wantStatus == StatusDown) {
+					t.Errorf("component %q error = %q", name, component.Error)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package common
+
+import (
+	"sort"
+	"strings"
+)
+
+// UniqueIDs returns the IDs sorted ascending without duplicates.
+func UniqueIDs(ids []int64) []int64 {
+	unique := make([]int64, 0, len(ids))
+	seen := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	sort.Slice(unique, func(i, j int) bool { return unique[i] < unique[j] })
+
+	return unique
+}
+
+// ChunkIDs splits ids into consecutive chunks of at most size IDs.
+func ChunkIDs(ids []int64, size int) [][]int64 {
+	var chunks [][]int64
+	for size < len(ids) {
+		chunks = append(chunks, ids[:size:size])
+		ids = ids[size:]
+	}
+	if len(ids) > 0 {
+		chunks = append(chunks, ids)
+	}
+
+	return chunks
+}
+
+// InClause returns the placeholders and arguments for binding ids into an `IN (...)` query.
+func InClause(ids []int64) (string, []interface{}) {
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+
+	return strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", "), args
+}
@@ -0,0 +1,51 @@
+package common
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChunkIDs(t *testing.T) {
+	tests := []struct {
+		name string
+		ids  []int64
+		size int
+		want [][]int64
+	}{
+		{
+			name: "Empty",
+			ids:  nil,
+			size: 2,
+			want: nil,
+		},
+		{
+			name: "Exact Chunks",
+			ids:  []int64{1, 2, 3, 4},
+			size: 2,
+			want: [][]int64{{1, 2}, {3, 4}},
+		},
+		{
+			name: "Partial Last Chunk",
+			ids:  []int64{1, 2, 3},
+			size: 2,
+			want: [][]int64{{1, 2}, {3}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ChunkIDs(tt.ids, tt.size); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ChunkIDs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInClause(t *testing.T) {
+	placeholders, args := InClause([]int64{4, 5, 6})
+	if placeholders != "?, ?, ?" {
+		t.Errorf("InClause() placeholders = %q, want %q", placeholders, "?, ?, ?")
+	}
+	if want := []interface{}{int64(4), int64(5), int64(6)}; !reflect.DeepEqual(args, want) {
+		t.Errorf("InClause() args = %v, want %v", args, want)
+	}
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github/rakadityas/course-management-system/common/logger"
+)
+
+// Readiness reports whether the application should receive traffic.
+// It starts unready and is safe for concurrent use.
+type Readiness struct {
+	ready atomic.Bool
+}
+
+// SetReady marks the application as ready or not ready to receive traffic.
+func (r *Readiness) SetReady(ready bool) {
+	r.ready.Store(ready)
+}
+
+// IsReady reports whether the application is ready to receive traffic.
+func (r *Readiness) IsReady() bool {
+	return r.ready.Load()
+}
+
+// Probe returns an error while the application is not ready, for use as a readiness check.
+func (r *Readiness) Probe(ctx context.Context) error {
+	if !r.IsReady() {
+		return errors.New("server is not accepting traffic")
+	}
+	return nil
+}
+
+// ShutdownOptions configures how Run stops the server.
+type ShutdownOptions struct {
+	Delay   time.Duration // time to keep serving after readiness turns unhealthy
+	Timeout time.Duration // time allowed for in-flight requests to drain
+}
+
+// Run serves srv on srv.Addr until ctx is done, then shuts it down gracefully:
+// readiness is flipped to unhealthy, the server keeps serving for opts.Delay so load
+// balancers can stop routing to it, and in-flight requests get opts.Timeout to finish.
+// It returns nil after a clean shutdown.
+func Run(ctx context.Context, srv *http.Server, readiness *Readiness, opts ShutdownOptions) error {
+	listener, err := net.Listen("tcp", srv.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", srv.Addr, err)
+	}
+
+	return serve(ctx, srv, listener, readiness, opts)
+}
+
+func serve(ctx context.Context, srv *http.Server, listener net.Listener, readiness *Readiness, opts ShutdownOptions) error {
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- srv.Serve(listener)
+	}()
+	readiness.SetReady(true)
+
+	select {
+	case err := <-serveErr:
+		readiness.SetReady(false)
+		return err
+	case <-ctx.Done():
+	}
+
+	readiness.SetReady(false)
+	logger.Infof("shutting down server, draining connections for up to %s", opts.Delay+opts.Timeout)
+
+	if opts.Delay > 0 {
+		time.Sleep(opts.Delay)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), opts.Timeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to drain connections: %w", err)
+	}
+
+	if err := <-serveErr; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestServe(t *testing.T) {
+	tests := []struct {
+		name         string
+		handlerDelay time.Duration
+		opts         ShutdownOptions
+		wantErr      bool
+		wantBody     string
+	}{
+		{
+			name:         "In-flight Request Drains",
+			handlerDelay: 50 * time.Millisecond,
+			opts:         ShutdownOptions{Delay: 10 * time.Millisecond, Timeout: time.Second},
+			wantErr:      false,
+			wantBody:     "done",
+		},
+		{
+			name:         "Drain Deadline Exceeded",
+			handlerDelay: time.Second,
+			opts:         ShutdownOptions{Timeout: 20 * time.Millisecond},
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			started := make(chan struct{})
+			srv := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				close(started)
+				time.Sleep(tt.handlerDelay)
+				w.Write([]byte("done"))
+			})}
+
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatalf("failed to listen: %v", err)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			readiness := &Readiness{}
+			serveErr := make(chan error, 1)
+			go func() {
+				serveErr <- serve(ctx, srv, listener, readiness, tt.opts)
+			}()
+
+			respBody := make(chan string, 1)
+			go func() {
+				resp, err := http.Get("http://" + listener.Addr().String())
+				if err != nil {
+					respBody <- ""
+					return
+				}
+				defer resp.Body.Close()
+				body, _ := io.ReadAll(resp.Body)
+				respBody <- string(body)
+			}()
+
+			<-started
+			if !readiness.IsReady() {
+				t.Errorf("IsReady() = false while serving, want true")
+			}
+			cancel()
+
+			err = <-serveErr
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("serve() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if readiness.IsReady() {
+				t.Errorf("IsReady() = true after shutdown, want false")
+			}
+			if !tt.wantErr {
+				if body := <-respBody; body != tt.wantBody {
+					t.Errorf("in-flight response body = %q, want %q", body, tt.wantBody)
+				}
+			}
+		})
+	}
+}
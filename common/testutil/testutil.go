@@ -0,0 +1,29 @@
+package testutil
+
+import (
+	"context"
+
+	"github/rakadityas/course-management-system/common/auth"
+	"github/rakadityas/course-management-system/common/transaction"
+	transactionMock "github/rakadityas/course-management-system/common/transaction/mocks"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// AdminCtx returns a context authenticated as an administrator granted the given permissions.
+func AdminCtx(permissions ...auth.Permission) context.Context {
+	return auth.WithPrincipal(context.Background(), auth.Principal{
+		Subject:     "admin",
+		Roles:       []string{auth.RoleAdmin},
+		Permissions: permissions,
+	})
+}
+
+// NewPassThroughUnitOfWork returns a unit of work that runs the given function without a transaction.
+func NewPassThroughUnitOfWork(ctrl *gomock.Controller) transaction.UnitOfWork {
+	mock := transactionMock.NewMockUnitOfWork(ctrl)
+	mock.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}).AnyTimes()
+	return mock
+}
@@ -0,0 +1,74 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+)
+
+// txKey is the context key under which the running transaction is stored.
+type txKey struct{}
+
+// Executor is the subset of *sql.DB and *sql.Tx used by the repositories.
+type Executor interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// UnitOfWork runs a group of repository calls atomically.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type SQLUnitOfWork struct {
+	DB *sql.DB
+}
+
+// NewSQLUnitOfWork creates a unit of work backed by the given database connection.
+func NewSQLUnitOfWork(db *sql.DB) UnitOfWork {
+	return &SQLUnitOfWork{DB: db}
+}
+
+// Do runs fn inside a database transaction, see Do.
+func (uow *SQLUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return Do(ctx, uow.DB, fn)
+}
+
+// Do runs fn inside a transaction carried by the context passed to fn. The transaction is
+// committed when fn returns nil and rolled back otherwise. When ctx already carries a
+// transaction fn joins it, and the outermost Do decides whether to commit.
+func Do(ctx context.Context, db *sql.DB, fn func(ctx context.Context) error) (err error) {
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err = fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetExecutor returns the transaction carried by ctx, or db when there is none, so
+// repository calls take part in the surrounding unit of work.
+func GetExecutor(ctx context.Context, db *sql.DB) Executor {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+
+	return db
+}
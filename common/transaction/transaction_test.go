@@ -0,0 +1,106 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestDo(t *testing.T) {
+	tests := []struct {
+		name    string
+		mock    func(mock sqlmock.Sqlmock)
+		fn      func(ctx context.Context, db *sql.DB) error
+		wantErr bool
+	}{
+		{
+			name: "Commit On Success",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE courses").WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			fn: func(ctx context.Context, db *sql.DB) error {
+				_, err := GetExecutor(ctx, db).ExecContext(ctx, "UPDATE courses SET name = ?", "name")
+				return err
+			},
+			wantErr: false,
+		},
+		{
+			name: "Rollback On Error",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectRollback()
+			},
+			fn: func(ctx context.Context, db *sql.DB) error {
+				return errors.New("fn error")
+			},
+			wantErr: true,
+		},
+		{
+			name: "Nested Do Joins Outer Transaction",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE courses").WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("UPDATE students").WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			fn: func(ctx context.Context, db *sql.DB) error {
+				if _, err := GetExecutor(ctx, db).ExecContext(ctx, "UPDATE courses SET name = ?", "name"); err != nil {
+					return err
+				}
+				return Do(ctx, db, func(ctx context.Context) error {
+					_, err := GetExecutor(ctx, db).ExecContext(ctx, "UPDATE students SET email = ?", "email")
+					return err
+				})
+			},
+			wantErr: false,
+		},
+		{
+			name: "Begin Error",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin().WillReturnError(errors.New("begin error"))
+			},
+			fn: func(ctx context.Context, db *sql.DB) error {
+				return nil
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("error creating mock database: %v", err)
+			}
+			defer db.Close()
+			tt.mock(mock)
+
+			err = NewSQLUnitOfWork(db).Do(context.Background(), func(ctx context.Context) error {
+				return tt.fn(ctx, db)
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SQLUnitOfWork.Do() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestGetExecutor(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error creating mock database: %v", err)
+	}
+	defer db.Close()
+
+	if got := GetExecutor(context.Background(), db); got != db {
+		t.Errorf("GetExecutor() = %v, want the database outside a unit of work", got)
+	}
+}
@@ -0,0 +1,297 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github/rakadityas/course-management-system/common/logger"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config holds the application configuration.
+type Config struct {
+	Database   DatabaseConfig   `json:"database" yaml:"database"`
+	HTTP       HTTPConfig       `json:"http" yaml:"http"`
+	Log        LogConfig        `json:"log" yaml:"log"`
+	Enrollment EnrollmentConfig `json:"enrollment" yaml:"enrollment"`
+	Grading    GradingConfig    `json:"grading" yaml:"grading"`
+	Features   FeatureConfig    `json:"features" yaml:"features"`
+	Auth       AuthConfig       `json:"auth" yaml:"auth"`
+}
+
+// DatabaseConfig holds the MySQL connection, pool and readiness settings.
+type DatabaseConfig struct {
+	URL             string   `json:"url" yaml:"url"`
+	MaxOpenConns    int      `json:"max_open_conns" yaml:"max_open_conns"`
+	MaxIdleConns    int      `json:"max_idle_conns" yaml:"max_idle_conns"`
+	ConnMaxLifetime Duration `json:"conn_max_lifetime" yaml:"conn_max_lifetime"`
+	// ReadyTimeout bounds how long startup waits for the database to accept connections.
+	ReadyTimeout Duration `json:"ready_timeout" yaml:"ready_timeout"`
+	// ReadyInitialBackoff is the wait after the first failed ping; it doubles up to ReadyMaxBackoff.
+	ReadyInitialBackoff Duration `json:"ready_initial_backoff" yaml:"ready_initial_backoff"`
+	ReadyMaxBackoff     Duration `json:"ready_max_backoff" yaml:"ready_max_backoff"`
+	// PingTimeout bounds each database check of the /readyz endpoint.
+	PingTimeout Duration `json:"ping_timeout" yaml:"ping_timeout"`
+}
+
+// HTTPConfig holds the HTTP server settings.
+type HTTPConfig struct {
+	Port         string   `json:"port" yaml:"port"`
+	ReadTimeout  Duration `json:"read_timeout" yaml:"read_timeout"`
+	WriteTimeout Duration `json:"write_timeout" yaml:"write_timeout"`
+	IdleTimeout  Duration `json:"idle_timeout" yaml:"idle_timeout"`
+	// ShutdownDelay is how long the server keeps serving after readiness turns unhealthy,
+	// giving load balancers time to stop routing new requests to it.
+	ShutdownDelay Duration `json:"shutdown_delay" yaml:"shutdown_delay"`
+	// ShutdownTimeout bounds how long in-flight requests may take to drain on shutdown.
+	ShutdownTimeout Duration `json:"shutdown_timeout" yaml:"shutdown_timeout"`
+}
+
+// LogConfig holds the logging settings.
+type LogConfig struct {
+	Level string `json:"level" yaml:"level"` // debug, info, warn or error
+}
+
+// EnrollmentConfig holds the course enrollment business rules.
+type EnrollmentConfig struct {
+	ReEnrollmentCooldown Duration `json:"reenrollment_cooldown" yaml:"reenrollment_cooldown"`
+	MaxReEnrollments     int      `json:"max_reenrollments" yaml:"max_reenrollments"`
+	// MaxCreditsPerTerm is the most credit hours of active enrollments a student may carry in a term, 0 for unlimited.
+	MaxCreditsPerTerm int `json:"max_credits_per_term" yaml:"max_credits_per_term"`
+	// MinFullTimeCredits is the fewest credit hours in a term that count as full time, 0 to not report it.
+	MinFullTimeCredits int `json:"min_full_time_credits" yaml:"min_full_time_credits"`
+}
+
+// GradingConfig holds the grading scale used to record grades and compute the GPA.
+type GradingConfig struct {
+	// Scale maps each accepted grade letter to its grade points, in any case. It replaces the
+	// default A to F scale as a whole; leave it empty to keep the default.
+	Scale map[string]float64 `json:"scale" yaml:"scale"`
+	// MinPassingPoints is the lowest grade that completes a course, lower grades fail it.
+	MinPassingPoints float64 `json:"min_passing_points" yaml:"min_passing_points"`
+}
+
+// MaxGradePoints is the highest grade point value course_enrollments.grade_points can store.
+const MaxGradePoints = 9.99
+
+// FeatureConfig switches optional enrollment features on or off.
+type FeatureConfig struct {
+	// Waitlist puts students on the course waitlist when the course is full instead of rejecting them.
+	Waitlist bool `json:"waitlist" yaml:"waitlist"`
+	// Prerequisites rejects sign-ups until the course prerequisites are completed.
+	Prerequisites bool `json:"prerequisites" yaml:"prerequisites"`
+}
+
+// AuthConfig holds the bearer token settings.
+type AuthConfig struct {
+	// HMACKey signs and verifies HS256 tokens. It must be at least MinHMACKeyLength bytes.
+	HMACKey string   `json:"hmac_key" yaml:"hmac_key"`
+	Issuer  string   `json:"issuer" yaml:"issuer"` // required iss claim, if not empty
+	Leeway  Duration `json:"leeway" yaml:"leeway"` // tolerated clock skew on exp and nbf
+}
+
+// MinHMACKeyLength is the shortest accepted auth.hmac_key, the output size of SHA-256.
+const MinHMACKeyLength = 32
+
+// Default returns the configuration used for every value the file and environment leave unset.
+func Default() Config {
+	return Config{
+		Database: DatabaseConfig{
+			MaxOpenConns:        25,
+			MaxIdleConns:        25,
+			ConnMaxLifetime:     Duration{5 * time.Minute},
+			ReadyTimeout:        Duration{time.Minute},
+			ReadyInitialBackoff: Duration{500 * time.Millisecond},
+			ReadyMaxBackoff:     Duration{8 * time.Second},
+			PingTimeout:         Duration{2 * time.Second},
+		},
+		HTTP: HTTPConfig{
+			Port:            ":8991",
+			ReadTimeout:     Duration{10 * time.Second},
+			WriteTimeout:    Duration{10 * time.Second},
+			IdleTimeout:     Duration{time.Minute},
+			ShutdownDelay:   Duration{5 * time.Second},
+			ShutdownTimeout: Duration{20 * time.Second},
+		},
+		Log: LogConfig{
+			Level: logger.LevelInfo.String(),
+		},
+		Enrollment: EnrollmentConfig{
+			ReEnrollmentCooldown: Duration{time.Hour},
+			MaxReEnrollments:     3,
+			MaxCreditsPerTerm:    18,
+			MinFullTimeCredits:   12,
+		},
+		Grading: GradingConfig{
+			MinPassingPoints: 1.0,
+		},
+		Features: FeatureConfig{
+			Waitlist:      true,
+			Prerequisites: true,
+		},
+		Auth: AuthConfig{
+			Issuer: "course-management-system",
+			Leeway: Duration{30 * time.Second},
+		},
+	}
+}
+
+// Load builds the configuration from the defaults, the optional file at path and the
+// environment, in increasing order of precedence, and validates the result.
+// The file is parsed as YAML when its extension is .yaml or .yml and as JSON otherwise.
+func Load(path string) (Config, error) {
+	cfg, err := build(path)
+	if err != nil {
+		return Config{}, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+// LoadWithoutAuth builds the configuration like Load but leaves the auth settings unchecked,
+// for the command line tools that reach the database without serving requests.
+func LoadWithoutAuth(path string) (Config, error) {
+	cfg, err := build(path)
+	if err != nil {
+		return Config{}, err
+	}
+	if err := cfg.validate(false); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+// build applies the optional file at path and the environment to the defaults.
+func build(path string) (Config, error) {
+	cfg := Default()
+
+	if path != "" {
+		if err := loadFile(path, &cfg); err != nil {
+			return Config{}, err
+		}
+	}
+	if err := applyEnv(&cfg); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+// Validate reports every invalid setting of the configuration.
+func (cfg Config) Validate() error {
+	return cfg.validate(true)
+}
+
+// validate reports every invalid setting, the auth settings only when checkAuth is set.
+func (cfg Config) validate(checkAuth bool) error {
+	var errs []error
+
+	if cfg.Database.URL == "" {
+		errs = append(errs, errors.New("database.url is required"))
+	}
+	if cfg.Database.MaxOpenConns < 0 {
+		errs = append(errs, errors.New("database.max_open_conns must not be negative"))
+	}
+	if cfg.Database.MaxIdleConns < 0 {
+		errs = append(errs, errors.New("database.max_idle_conns must not be negative"))
+	}
+	if cfg.Database.MaxOpenConns > 0 && cfg.Database.MaxIdleConns > cfg.Database.MaxOpenConns {
+		errs = append(errs, errors.New("database.max_idle_conns must not exceed database.max_open_conns"))
+	}
+	if cfg.Database.ConnMaxLifetime.Duration < 0 {
+		errs = append(errs, errors.New("database.conn_max_lifetime must not be negative"))
+	}
+	if cfg.Database.ReadyTimeout.Duration <= 0 {
+		errs = append(errs, errors.New("database.ready_timeout must be positive"))
+	}
+	if cfg.Database.ReadyInitialBackoff.Duration <= 0 {
+		errs = append(errs, errors.New("database.ready_initial_backoff must be positive"))
+	}
+	if cfg.Database.ReadyMaxBackoff.Duration < cfg.Database.ReadyInitialBackoff.Duration {
+		errs = append(errs, errors.New("database.ready_max_backoff must not be less than database.ready_initial_backoff"))
+	}
+	if cfg.Database.PingTimeout.Duration <= 0 {
+		errs = append(errs, errors.New("database.ping_timeout must be positive"))
+	}
+	if !strings.Contains(cfg.HTTP.Port, ":") {
+		errs = append(errs, errors.New(`http.port must be a listen address such as ":8991"`))
+	}
+	if cfg.HTTP.ReadTimeout.Duration <= 0 || cfg.HTTP.WriteTimeout.Duration <= 0 || cfg.HTTP.IdleTimeout.Duration <= 0 {
+		errs = append(errs, errors.New("http timeouts must be positive"))
+	}
+	if cfg.HTTP.ShutdownDelay.Duration < 0 {
+		errs = append(errs, errors.New("http.shutdown_delay must not be negative"))
+	}
+	if cfg.HTTP.ShutdownTimeout.Duration <= 0 {
+		errs = append(errs, errors.New("http.shutdown_timeout must be positive"))
+	}
+	if _, err := logger.ParseLevel(cfg.Log.Level); err != nil {
+		errs = append(errs, fmt.Errorf("log.level: %w", err))
+	}
+	if cfg.Enrollment.ReEnrollmentCooldown.Duration < 0 {
+		errs = append(errs, errors.New("enrollment.reenrollment_cooldown must not be negative"))
+	}
+	if cfg.Enrollment.MaxReEnrollments < 0 {
+		errs = append(errs, errors.New("enrollment.max_reenrollments must not be negative"))
+	}
+	if cfg.Enrollment.MaxCreditsPerTerm < 0 || cfg.Enrollment.MinFullTimeCredits < 0 {
+		errs = append(errs, errors.New("enrollment credit limits must not be negative"))
+	}
+	if cfg.Enrollment.MaxCreditsPerTerm > 0 && cfg.Enrollment.MinFullTimeCredits > cfg.Enrollment.MaxCreditsPerTerm {
+		errs = append(errs, errors.New("enrollment.min_full_time_credits must not exceed enrollment.max_credits_per_term"))
+	}
+	for letter, points := range cfg.Grading.Scale {
+		if letter = strings.TrimSpace(letter); letter == "" || len(letter) > 4 {
+			errs = append(errs, fmt.Errorf("grading.scale letter %q must be 1 to 4 characters", letter))
+		}
+		if points < 0 || points > MaxGradePoints {
+			errs = append(errs, fmt.Errorf("grading.scale points of %q must be between 0 and %v", letter, MaxGradePoints))
+		}
+	}
+	if cfg.Grading.MinPassingPoints < 0 || cfg.Grading.MinPassingPoints > MaxGradePoints {
+		errs = append(errs, fmt.Errorf("grading.min_passing_points must be between 0 and %v", MaxGradePoints))
+	}
+	if checkAuth {
+		if len(cfg.Auth.HMACKey) < MinHMACKeyLength {
+			errs = append(errs, fmt.Errorf("auth.hmac_key must be at least %d bytes", MinHMACKeyLength))
+		}
+		if cfg.Auth.Leeway.Duration < 0 {
+			errs = append(errs, errors.New("auth.leeway must not be negative"))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid config: %w", errors.Join(errs...))
+	}
+
+	return nil
+}
+
+func loadFile(path string, cfg *Config) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(content, cfg)
+	default:
+		err = json.Unmarshal(content, cfg)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	return nil
+}
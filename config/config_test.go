@@ -0,0 +1,231 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testHMACKey = "test-hmac-key-test-hmac-key-0123"
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name        string
+		fileName    string
+		fileContent string
+		env         map[string]string
+		check       func(t *testing.T, cfg Config)
+		wantErr     string
+	}{
+		{
+			name:     "JSON File",
+			fileName: "config.json",
+			fileContent: `{
+				"database": {"url": "user:pass@tcp(db:3306)/course_management", "max_open_conns": 10, "max_idle_conns": 5},
+				"http": {"port": ":9000", "read_timeout": "3s"},
+				"features": {"waitlist": false},
+				"auth": {"hmac_key": "0123456789abcdef0123456789abcdef", "issuer": ""}
+			}`,
+			check: func(t *testing.T, cfg Config) {
+				if cfg.Database.URL != "user:pass@tcp(db:3306)/course_management" || cfg.Database.MaxOpenConns != 10 || cfg.Database.MaxIdleConns != 5 {
+					t.Errorf("Database = %+v", cfg.Database)
+				}
+				if cfg.HTTP.Port != ":9000" || cfg.HTTP.ReadTimeout.Duration != 3*time.Second {
+					t.Errorf("HTTP = %+v", cfg.HTTP)
+				}
+				if cfg.HTTP.WriteTimeout != Default().HTTP.WriteTimeout {
+					t.Errorf("HTTP.WriteTimeout = %v, want default %v", cfg.HTTP.WriteTimeout, Default().HTTP.WriteTimeout)
+				}
+				if cfg.Features.Waitlist || !cfg.Features.Prerequisites {
+					t.Errorf("Features = %+v", cfg.Features)
+				}
+				if cfg.Auth.HMACKey != "0123456789abcdef0123456789abcdef" || cfg.Auth.Issuer != "" {
+					t.Errorf("Auth = %+v", cfg.Auth)
+				}
+			},
+		},
+		{
+			name:     "YAML File",
+			fileName: "config.yaml",
+			fileContent: `
+database:
+  url: user:pass@tcp(db:3306)/course_management
+  ready_timeout: 30s
+enrollment:
+  reenrollment_cooldown: 15m
+  max_reenrollments: 1
+grading:
+  scale:
+    pass: 1
+    fail: 0
+  min_passing_points: 1
+log:
+  level: warn
+`,
+			env: map[string]string{"AUTH_HMAC_KEY": testHMACKey},
+			check: func(t *testing.T, cfg Config) {
+				if cfg.Database.ReadyTimeout.Duration != 30*time.Second {
+					t.Errorf("Database.ReadyTimeout = %v, want 30s", cfg.Database.ReadyTimeout)
+				}
+				if cfg.Enrollment.ReEnrollmentCooldown.Duration != 15*time.Minute || cfg.Enrollment.MaxReEnrollments != 1 {
+					t.Errorf("Enrollment = %+v", cfg.Enrollment)
+				}
+				if cfg.Log.Level != "warn" {
+					t.Errorf("Log.Level = %q, want warn", cfg.Log.Level)
+				}
+				want := GradingConfig{Scale: map[string]float64{"pass": 1, "fail": 0}, MinPassingPoints: 1}
+				if !reflect.DeepEqual(cfg.Grading, want) {
+					t.Errorf("Grading = %+v, want %+v", cfg.Grading, want)
+				}
+			},
+		},
+		{
+			name:        "Environment Overrides File",
+			fileName:    "config.json",
+			fileContent: `{"database": {"url": "from-file"}, "http": {"port": ":9000"}}`,
+			env: map[string]string{
+				"DATABASE_URL":          "from-env",
+				"APP_PORT":              ":8991",
+				"HTTP_IDLE_TIMEOUT":     "2m",
+				"FEATURE_WAITLIST":      "false",
+				"HTTP_SHUTDOWN_TIMEOUT": "45s",
+				"AUTH_HMAC_KEY":         testHMACKey,
+			},
+			check: func(t *testing.T, cfg Config) {
+				if cfg.Database.URL != "from-env" || cfg.HTTP.Port != ":8991" || cfg.HTTP.IdleTimeout.Duration != 2*time.Minute || cfg.Features.Waitlist {
+					t.Errorf("Config = %+v", cfg)
+				}
+				if cfg.HTTP.ShutdownTimeout.Duration != 45*time.Second {
+					t.Errorf("HTTP.ShutdownTimeout = %v, want 45s", cfg.HTTP.ShutdownTimeout)
+				}
+			},
+		},
+		{
+			name: "Environment Only",
+			env:  map[string]string{"DATABASE_URL": "from-env", "AUTH_HMAC_KEY": testHMACKey},
+			check: func(t *testing.T, cfg Config) {
+				want := Default()
+				want.Database.URL = "from-env"
+				want.Auth.HMACKey = testHMACKey
+				if !reflect.DeepEqual(cfg, want) {
+					t.Errorf("Config = %+v, want %+v", cfg, want)
+				}
+			},
+		},
+		{
+			name:    "Missing Database URL",
+			wantErr: "database.url is required",
+		},
+		{
+			name:    "Short HMAC Key",
+			env:     map[string]string{"DATABASE_URL": "from-env", "AUTH_HMAC_KEY": "secret"},
+			wantErr: "auth.hmac_key must be at least 32 bytes",
+		},
+		{
+			name:    "Invalid Environment Value",
+			env:     map[string]string{"DATABASE_URL": "from-env", "DB_MAX_OPEN_CONNS": "many"},
+			wantErr: "invalid DB_MAX_OPEN_CONNS",
+		},
+		{
+			name:        "Invalid Settings",
+			fileName:    "config.json",
+			fileContent: `{"database": {"url": "db", "max_open_conns": 5, "max_idle_conns": 10}, "log": {"level": "verbose"}}`,
+			wantErr:     "database.max_idle_conns must not exceed database.max_open_conns\nlog.level",
+		},
+		{
+			name:    "Full Time Above Credit Limit",
+			env:     map[string]string{"DATABASE_URL": "from-env", "AUTH_HMAC_KEY": testHMACKey, "MAX_CREDITS_PER_TERM": "9"},
+			wantErr: "enrollment.min_full_time_credits must not exceed enrollment.max_credits_per_term",
+		},
+		{
+			name:        "Invalid Grading Scale",
+			fileName:    "config.json",
+			fileContent: `{"database": {"url": "db"}, "grading": {"scale": {"A": 4, "A+": 12}}}`,
+			env:         map[string]string{"AUTH_HMAC_KEY": testHMACKey},
+			wantErr:     `grading.scale points of "A+" must be between 0 and 9.99`,
+		},
+		{
+			name:        "Invalid Duration",
+			fileName:    "config.json",
+			fileContent: `{"http": {"read_timeout": "soon"}}`,
+			wantErr:     "failed to parse config file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name := range envOverrides(&Config{}) {
+				t.Setenv(name, "")
+				os.Unsetenv(name)
+			}
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+
+			var path string
+			if tt.fileName != "" {
+				path = filepath.Join(t.TempDir(), tt.fileName)
+				if err := os.WriteFile(path, []byte(tt.fileContent), 0o600); err != nil {
+					t.Fatalf("failed to write config file: %v", err)
+				}
+			}
+
+			cfg, err := Load(path)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Load() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			tt.check(t, cfg)
+		})
+	}
+}
+
+func TestLoadWithoutAuth(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		wantErr string
+	}{
+		{
+			name: "Missing HMAC Key",
+			env:  map[string]string{"DATABASE_URL": "from-env"},
+		},
+		{
+			name:    "Missing Database URL",
+			env:     map[string]string{"AUTH_HMAC_KEY": "secret"},
+			wantErr: "database.url is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name := range envOverrides(&Config{}) {
+				t.Setenv(name, "")
+				os.Unsetenv(name)
+			}
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+
+			cfg, err := LoadWithoutAuth("")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("LoadWithoutAuth() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadWithoutAuth() error = %v", err)
+			}
+			if cfg.Database.URL != "from-env" {
+				t.Errorf("Database.URL = %q, want from-env", cfg.Database.URL)
+			}
+		})
+	}
+}
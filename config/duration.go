@@ -0,0 +1,24 @@
+package config
+
+import "time"
+
+// Duration is a time.Duration written as a Go duration string such as "10s" in config files.
+type Duration struct {
+	time.Duration
+}
+
+// UnmarshalText parses a Go duration string.
+func (d *Duration) UnmarshalText(text []byte) error {
+	parsed, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	d.Duration = parsed
+
+	return nil
+}
+
+// MarshalText returns the duration as a Go duration string.
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.Duration.String()), nil
+}
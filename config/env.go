@@ -0,0 +1,106 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// envOverrides maps each environment variable to the setting it overrides.
+// DATABASE_URL and APP_PORT keep the names used before the config file existed.
+func envOverrides(cfg *Config) map[string]func(value string) error {
+	return map[string]func(value string) error{
+		"DATABASE_URL":               setString(&cfg.Database.URL),
+		"DB_MAX_OPEN_CONNS":          setInt(&cfg.Database.MaxOpenConns),
+		"DB_MAX_IDLE_CONNS":          setInt(&cfg.Database.MaxIdleConns),
+		"DB_CONN_MAX_LIFETIME":       setDuration(&cfg.Database.ConnMaxLifetime),
+		"DB_READY_TIMEOUT":           setDuration(&cfg.Database.ReadyTimeout),
+		"DB_READY_INITIAL_BACKOFF":   setDuration(&cfg.Database.ReadyInitialBackoff),
+		"DB_READY_MAX_BACKOFF":       setDuration(&cfg.Database.ReadyMaxBackoff),
+		"DB_PING_TIMEOUT":            setDuration(&cfg.Database.PingTimeout),
+		"APP_PORT":                   setString(&cfg.HTTP.Port),
+		"HTTP_READ_TIMEOUT":          setDuration(&cfg.HTTP.ReadTimeout),
+		"HTTP_WRITE_TIMEOUT":         setDuration(&cfg.HTTP.WriteTimeout),
+		"HTTP_IDLE_TIMEOUT":          setDuration(&cfg.HTTP.IdleTimeout),
+		"HTTP_SHUTDOWN_DELAY":        setDuration(&cfg.HTTP.ShutdownDelay),
+		"HTTP_SHUTDOWN_TIMEOUT":      setDuration(&cfg.HTTP.ShutdownTimeout),
+		"LOG_LEVEL":                  setString(&cfg.Log.Level),
+		"REENROLLMENT_COOLDOWN":      setDuration(&cfg.Enrollment.ReEnrollmentCooldown),
+		"MAX_REENROLLMENTS":          setInt(&cfg.Enrollment.MaxReEnrollments),
+		"MAX_CREDITS_PER_TERM":       setInt(&cfg.Enrollment.MaxCreditsPerTerm),
+		"MIN_FULL_TIME_CREDITS":      setInt(&cfg.Enrollment.MinFullTimeCredits),
+		"GRADING_MIN_PASSING_POINTS": setFloat(&cfg.Grading.MinPassingPoints),
+		"FEATURE_WAITLIST":           setBool(&cfg.Features.Waitlist),
+		"FEATURE_PREREQUISITES":      setBool(&cfg.Features.Prerequisites),
+		"AUTH_HMAC_KEY":              setString(&cfg.Auth.HMACKey),
+		"AUTH_ISSUER":                setString(&cfg.Auth.Issuer),
+		"AUTH_LEEWAY":                setDuration(&cfg.Auth.Leeway),
+	}
+}
+
+// applyEnv overrides the configuration with every set environment variable.
+func applyEnv(cfg *Config) error {
+	for name, set := range envOverrides(cfg) {
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+		if err := set(value); err != nil {
+			return fmt.Errorf("invalid %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func setString(field *string) func(string) error {
+	return func(value string) error {
+		*field = value
+		return nil
+	}
+}
+
+func setInt(field *int) func(string) error {
+	return func(value string) error {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		*field = parsed
+		return nil
+	}
+}
+
+func setFloat(field *float64) func(string) error {
+	return func(value string) error {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		*field = parsed
+		return nil
+	}
+}
+
+func setBool(field *bool) func(string) error {
+	return func(value string) error {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		*field = parsed
+		return nil
+	}
+}
+
+func setDuration(field *Duration) func(string) error {
+	return func(value string) error {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		field.Duration = parsed
+		return nil
+	}
+}
@@ -12,7 +12,7 @@ INSERT INTO courses (name, create_time, update_time) VALUES
 
 -- Insert initial course enrollments
 INSERT INTO course_enrollments (student_id, course_id, status, create_time, update_time) VALUES 
-(1, 1, 1, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),  -- Status 1 could represent "Active"
+(1, 1, 1, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),  -- Status 1 is "active"
 (2, 1, 1, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP), 
 (2, 2, 1, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP), 
-(3, 3, 2, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);  -- Status 2 could represent "Cancelled"
+(3, 3, 0, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);  -- Status 0 is "cancelled"
@@ -0,0 +1,13 @@
+-- Soft delete of students. A deleted student keeps its row with delete_time set and is
+-- left out of every student query.
+USE course_management;
+
+ALTER TABLE students
+    ADD COLUMN delete_time TIMESTAMP NULL DEFAULT NULL AFTER update_time;
+
+-- Emails are unique among live students only. live_email mirrors email until the student is
+-- deleted, so a deleted student's address can be registered again or given to another student.
+ALTER TABLE students
+    ADD COLUMN live_email VARCHAR(255) AS (IF(delete_time IS NULL, email, NULL)) STORED,
+    DROP INDEX email,
+    ADD CONSTRAINT uq_students_live_email UNIQUE (live_email);
@@ -0,0 +1,7 @@
+-- Archiving of catalog courses and the name index used to sort and search the catalog.
+-- An archived course keeps its row with archive_time set and is hidden from the catalog.
+USE course_management;
+
+ALTER TABLE courses
+    ADD COLUMN archive_time TIMESTAMP NULL DEFAULT NULL AFTER name,
+    ADD INDEX idx_courses_name (name);
@@ -0,0 +1,5 @@
+-- Seat limit of courses, 0 leaves a course unlimited. Sign-ups beyond the limit are waitlisted.
+USE course_management;
+
+ALTER TABLE courses
+    ADD COLUMN capacity INT NOT NULL DEFAULT 0 AFTER name;
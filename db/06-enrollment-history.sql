@@ -0,0 +1,20 @@
+-- Re-enrollment after cancellation. reenroll_count counts how often a student rejoined the
+-- course, and course_enrollment_histories records every status an enrollment entered.
+USE course_management;
+
+ALTER TABLE course_enrollments
+    ADD COLUMN reenroll_count INT NOT NULL DEFAULT 0 AFTER status;
+
+CREATE TABLE IF NOT EXISTS course_enrollment_histories (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    course_enrollment_id BIGINT NOT NULL,
+    status INT NOT NULL,
+    create_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (course_enrollment_id) REFERENCES course_enrollments(id)
+);
+
+-- Existing enrollments start their history with the status they are in
+INSERT INTO course_enrollment_histories (course_enrollment_id, status, create_time)
+SELECT ce.id, ce.status, ce.update_time
+FROM course_enrollments ce
+WHERE NOT EXISTS (SELECT 1 FROM course_enrollment_histories h WHERE h.course_enrollment_id = ce.id);
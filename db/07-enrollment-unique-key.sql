@@ -0,0 +1,14 @@
+-- Enforce a single enrollment per student and course.
+-- Safe to apply to an existing database: duplicate rows left behind by concurrent
+-- sign-ups are removed first, keeping the most recent enrollment of each pair.
+USE course_management;
+
+DELETE h FROM course_enrollment_histories h
+JOIN course_enrollments ce ON ce.id = h.course_enrollment_id
+JOIN course_enrollments newer ON newer.student_id = ce.student_id AND newer.course_id = ce.course_id AND newer.id > ce.id;
+
+DELETE ce FROM course_enrollments ce
+JOIN course_enrollments newer ON newer.student_id = ce.student_id AND newer.course_id = ce.course_id AND newer.id > ce.id;
+
+ALTER TABLE course_enrollments
+    ADD CONSTRAINT uq_course_enrollments_student_course UNIQUE (student_id, course_id);
@@ -0,0 +1,7 @@
+-- Enrollment statuses are stored as courseenrollmentdomain.EnrollmentStatus values:
+-- 0 cancelled, 1 active, 3 waitlisted, 4 pending, 5 completed, 6 dropped, 7 failed.
+-- Older seed data used 2 for cancelled, which is not a valid status.
+USE course_management;
+
+UPDATE course_enrollments SET status = 0 WHERE status = 2;
+UPDATE course_enrollment_histories SET status = 0 WHERE status = 2;
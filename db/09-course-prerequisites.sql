@@ -0,0 +1,11 @@
+-- Courses a student must complete before signing up for a course.
+USE course_management;
+
+CREATE TABLE IF NOT EXISTS course_prerequisites (
+    course_id BIGINT NOT NULL,
+    prerequisite_course_id BIGINT NOT NULL,
+    create_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (course_id, prerequisite_course_id),
+    FOREIGN KEY (course_id) REFERENCES courses(id),
+    FOREIGN KEY (prerequisite_course_id) REFERENCES courses(id)
+);
@@ -0,0 +1,10 @@
+-- Records which numbered scripts in this directory have been applied, so readiness can
+-- verify the schema. Every later script must insert its own version.
+USE course_management;
+
+CREATE TABLE IF NOT EXISTS schema_migrations (
+    version INT PRIMARY KEY,
+    apply_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT IGNORE INTO schema_migrations (version) VALUES (1), (2), (3), (4), (5), (6), (7), (8), (9), (10);
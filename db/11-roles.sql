@@ -0,0 +1,52 @@
+-- Roles, the permissions they grant and the token subjects holding them.
+-- Students hold the student role implicitly through the student_id claim of their token.
+USE course_management;
+
+CREATE TABLE IF NOT EXISTS roles (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    name VARCHAR(64) NOT NULL UNIQUE,
+    create_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS permissions (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    name VARCHAR(64) NOT NULL UNIQUE,
+    create_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role_id BIGINT NOT NULL,
+    permission_id BIGINT NOT NULL,
+    PRIMARY KEY (role_id, permission_id),
+    FOREIGN KEY (role_id) REFERENCES roles(id),
+    FOREIGN KEY (permission_id) REFERENCES permissions(id)
+);
+
+CREATE TABLE IF NOT EXISTS principal_roles (
+    subject VARCHAR(255) NOT NULL,
+    role_id BIGINT NOT NULL,
+    create_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (subject, role_id),
+    FOREIGN KEY (role_id) REFERENCES roles(id)
+);
+
+INSERT IGNORE INTO roles (name) VALUES ('admin'), ('instructor'), ('student');
+
+INSERT IGNORE INTO permissions (name) VALUES
+    ('courses:manage'),
+    ('students:manage'),
+    ('enrollments:manage'),
+    ('enrollments:manage_own'),
+    ('rosters:view'),
+    ('grades:write');
+
+INSERT IGNORE INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r JOIN permissions p
+WHERE (r.name = 'admin' AND p.name IN ('courses:manage', 'students:manage', 'enrollments:manage', 'rosters:view', 'grades:write'))
+   OR (r.name = 'instructor' AND p.name IN ('rosters:view', 'grades:write'))
+   OR (r.name = 'student' AND p.name = 'enrollments:manage_own');
+
+-- Development administrator, use `make token SUBJECT=admin` to sign in as it
+INSERT IGNORE INTO principal_roles (subject, role_id) SELECT 'admin', id FROM roles WHERE name = 'admin';
+
+INSERT IGNORE INTO schema_migrations (version) VALUES (11);
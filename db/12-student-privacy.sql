@@ -0,0 +1,10 @@
+-- Per-student privacy settings honoured by the classmates listing.
+-- visibility is a studentdomain.Visibility: 'classmates', 'instructors' or 'hidden'.
+-- Emails are masked unless the student opts in with show_email.
+USE course_management;
+
+ALTER TABLE students
+    ADD COLUMN visibility VARCHAR(16) NOT NULL DEFAULT 'classmates' AFTER email,
+    ADD COLUMN show_email BOOLEAN NOT NULL DEFAULT FALSE AFTER visibility;
+
+INSERT IGNORE INTO schema_migrations (version) VALUES (12);
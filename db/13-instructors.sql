@@ -0,0 +1,35 @@
+-- Instructors and the courses they teach. A course may have several instructors
+-- and an instructor may teach several courses.
+USE course_management;
+
+CREATE TABLE IF NOT EXISTS instructors (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    name VARCHAR(255) NOT NULL,
+    email VARCHAR(255) NOT NULL UNIQUE,
+    create_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    update_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    delete_time TIMESTAMP NULL DEFAULT NULL
+);
+
+CREATE TABLE IF NOT EXISTS course_instructors (
+    course_id BIGINT NOT NULL,
+    instructor_id BIGINT NOT NULL,
+    create_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (course_id, instructor_id),
+    INDEX idx_course_instructors_instructor_id (instructor_id),
+    FOREIGN KEY (course_id) REFERENCES courses(id),
+    FOREIGN KEY (instructor_id) REFERENCES instructors(id)
+);
+
+-- Insert initial instructors
+INSERT IGNORE INTO instructors (name, email, create_time, update_time) VALUES
+('Ada Lovelace', 'ada@example.com', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+('Alan Turing', 'alan@example.com', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+
+-- Insert initial course assignments
+INSERT IGNORE INTO course_instructors (course_id, instructor_id, create_time) VALUES
+(1, 1, CURRENT_TIMESTAMP),
+(2, 1, CURRENT_TIMESTAMP),
+(2, 2, CURRENT_TIMESTAMP);
+
+INSERT IGNORE INTO schema_migrations (version) VALUES (13);
@@ -0,0 +1,56 @@
+-- Academic terms and the course sections offered in them. An enrollment belongs to a
+-- section; course_id stays on course_enrollments so course level queries need no join.
+-- A NULL enrollment window bound leaves that side of the window open.
+USE course_management;
+
+CREATE TABLE IF NOT EXISTS terms (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    name VARCHAR(64) NOT NULL UNIQUE,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    enrollment_open_time TIMESTAMP NULL DEFAULT NULL,
+    enrollment_close_time TIMESTAMP NULL DEFAULT NULL,
+    create_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    update_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS course_sections (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    course_id BIGINT NOT NULL,
+    term_id BIGINT NOT NULL,
+    capacity INT NOT NULL DEFAULT 0,
+    create_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    update_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_course_sections_term_id (term_id),
+    FOREIGN KEY (course_id) REFERENCES courses(id),
+    FOREIGN KEY (term_id) REFERENCES terms(id)
+);
+
+-- Insert the term existing enrollments are moved to
+INSERT IGNORE INTO terms (id, name, start_date, end_date, create_time, update_time) VALUES
+(1, '2024 Fall', '2024-08-26', '2024-12-20', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+
+-- Every existing course is offered once in that term, with its current capacity
+INSERT INTO course_sections (course_id, term_id, capacity, create_time, update_time)
+SELECT c.id, 1, c.capacity, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+FROM courses c
+WHERE NOT EXISTS (SELECT 1 FROM course_sections cs WHERE cs.course_id = c.id);
+
+ALTER TABLE course_enrollments
+    ADD COLUMN section_id BIGINT NULL AFTER course_id;
+
+UPDATE course_enrollments ce
+JOIN course_sections cs ON cs.course_id = ce.course_id AND cs.term_id = 1
+SET ce.section_id = cs.id
+WHERE ce.section_id IS NULL;
+
+-- A student may now take the same course again in another term, once per section
+ALTER TABLE course_enrollments
+    MODIFY section_id BIGINT NOT NULL,
+    ADD CONSTRAINT fk_course_enrollments_section FOREIGN KEY (section_id) REFERENCES course_sections(id),
+    ADD CONSTRAINT uq_course_enrollments_student_section UNIQUE (student_id, section_id);
+
+ALTER TABLE course_enrollments
+    DROP INDEX uq_course_enrollments_student_course;
+
+INSERT IGNORE INTO schema_migrations (version) VALUES (14);
@@ -0,0 +1,8 @@
+-- Drop deadline of a term. Active enrollments can no longer be cancelled from this time on;
+-- NULL leaves them cancellable at any time.
+USE course_management;
+
+ALTER TABLE terms
+    ADD COLUMN drop_deadline TIMESTAMP NULL DEFAULT NULL AFTER enrollment_close_time;
+
+INSERT IGNORE INTO schema_migrations (version) VALUES (15);
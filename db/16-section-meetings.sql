@@ -0,0 +1,18 @@
+-- Weekly meeting times of course sections. day_of_week follows Go's time.Weekday
+-- (0 is Sunday); a meeting runs from start_time until end_time on that day.
+USE course_management;
+
+CREATE TABLE IF NOT EXISTS section_meetings (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    section_id BIGINT NOT NULL,
+    day_of_week TINYINT NOT NULL,
+    start_time TIME NOT NULL,
+    end_time TIME NOT NULL,
+    room VARCHAR(64) NOT NULL DEFAULT '',
+    create_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    update_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_section_meetings_section_day (section_id, day_of_week, start_time),
+    FOREIGN KEY (section_id) REFERENCES course_sections(id)
+);
+
+INSERT IGNORE INTO schema_migrations (version) VALUES (16);
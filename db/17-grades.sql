@@ -0,0 +1,12 @@
+-- Credit hours of courses and grades of finished enrollments. A graded enrollment is
+-- completed or failed; grade_letter and grade_points stay NULL until a grade is recorded.
+USE course_management;
+
+ALTER TABLE courses
+    ADD COLUMN credit_hours INT NOT NULL DEFAULT 3 AFTER capacity;
+
+ALTER TABLE course_enrollments
+    ADD COLUMN grade_letter VARCHAR(4) NULL DEFAULT NULL AFTER reenroll_count,
+    ADD COLUMN grade_points DECIMAL(3,2) NULL DEFAULT NULL AFTER grade_letter;
+
+INSERT IGNORE INTO schema_migrations (version) VALUES (17);
@@ -0,0 +1,96 @@
+package accessdomain
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github/rakadityas/course-management-system/common/auth"
+	"github/rakadityas/course-management-system/common/transaction"
+)
+
+// AccessRepository defines the interface for role and permission database operations.
+type AccessRepository interface {
+	GetRoleNamesBySubject(ctx context.Context, subject string) ([]string, error)
+	GetPermissionsByRoleNames(ctx context.Context, roleNames []string) ([]auth.Permission, error)
+}
+
+// AccessDB implements the AccessRepository interface using a SQL database.
+type AccessDB struct {
+	DB *sql.DB
+}
+
+// NewSQLAccessRepository creates a new AccessDB instance with the given database connection.
+func NewSQLAccessRepository(db *sql.DB) *AccessDB {
+	return &AccessDB{DB: db}
+}
+
+// GetRoleNamesBySubject retrieves the names of the roles assigned to a token subject.
+func (repo *AccessDB) GetRoleNamesBySubject(ctx context.Context, subject string) ([]string, error) {
+	query := `
+		SELECT r.name
+		FROM principal_roles pr
+		JOIN roles r ON r.id = pr.role_id
+		WHERE pr.subject = ?
+		ORDER BY r.name
+	`
+	rows, err := transaction.GetExecutor(ctx, repo.DB).QueryContext(ctx, query, subject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve roles: %v", err)
+	}
+	defer rows.Close()
+
+	var roleNames []string
+	for rows.Next() {
+		var roleName string
+		if err := rows.Scan(&roleName); err != nil {
+			return nil, fmt.Errorf("failed to scan role: %v", err)
+		}
+		roleNames = append(roleNames, roleName)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to retrieve roles: %v", err)
+	}
+
+	return roleNames, nil
+}
+
+// GetPermissionsByRoleNames retrieves the distinct permissions granted by any of the roles.
+func (repo *AccessDB) GetPermissionsByRoleNames(ctx context.Context, roleNames []string) ([]auth.Permission, error) {
+	if len(roleNames) == 0 {
+		return nil, nil
+	}
+
+	args := make([]interface{}, len(roleNames))
+	for i, roleName := range roleNames {
+		args[i] = roleName
+	}
+	query := `
+		SELECT DISTINCT p.name
+		FROM role_permissions rp
+		JOIN roles r ON r.id = rp.role_id
+		JOIN permissions p ON p.id = rp.permission_id
+		WHERE r.name IN (` + strings.TrimSuffix(strings.Repeat("?, ", len(roleNames)), ", ") + `)
+		ORDER BY p.name
+	`
+	rows, err := transaction.GetExecutor(ctx, repo.DB).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve permissions: %v", err)
+	}
+	defer rows.Close()
+
+	var permissions []auth.Permission
+	for rows.Next() {
+		var permission auth.Permission
+		if err := rows.Scan(&permission); err != nil {
+			return nil, fmt.Errorf("failed to scan permission: %v", err)
+		}
+		permissions = append(permissions, permission)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to retrieve permissions: %v", err)
+	}
+
+	return permissions, nil
+}
@@ -0,0 +1,137 @@
+package accessdomain
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github/rakadityas/course-management-system/common/auth"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestAccessService_ResolvePrincipal(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal auth.Principal
+		mock      func(mock sqlmock.Sqlmock)
+		want      auth.Principal
+		wantErr   bool
+	}{
+		{
+			name:      "Admin",
+			principal: auth.Principal{Subject: "admin"},
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT r.name FROM principal_roles pr JOIN roles r ON r.id = pr.role_id WHERE pr.subject = ?").
+					WithArgs("admin").
+					WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("admin"))
+				mock.ExpectQuery("SELECT DISTINCT p.name FROM role_permissions rp JOIN roles r ON r.id = rp.role_id JOIN permissions p ON p.id = rp.permission_id WHERE r.name IN \\(\\?\\)").
+					WithArgs("admin").
+					WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("courses:manage").AddRow("students:manage"))
+			},
+			want: auth.Principal{
+				Subject:     "admin",
+				Roles:       []string{"admin"},
+				Permissions: []auth.Permission{auth.PermManageCourses, auth.PermManageStudents},
+			},
+		},
+		{
+			name:      "Student Holds Implicit Role",
+			principal: auth.Principal{Subject: "student:1", StudentID: 1},
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT r.name FROM principal_roles").
+					WithArgs("student:1").
+					WillReturnRows(sqlmock.NewRows([]string{"name"}))
+				mock.ExpectQuery("SELECT DISTINCT p.name FROM role_permissions .* WHERE r.name IN \\(\\?\\)").
+					WithArgs("student").
+					WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("enrollments:manage_own"))
+			},
+			want: auth.Principal{
+				Subject:     "student:1",
+				StudentID:   1,
+				Roles:       []string{"student"},
+				Permissions: []auth.Permission{auth.PermManageOwnEnrollments},
+			},
+		},
+		{
+			name:      "Instructor Holds Implicit Role",
+			principal: auth.Principal{Subject: "instructor:1", InstructorID: 1},
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT r.name FROM principal_roles").
+					WithArgs("instructor:1").
+					WillReturnRows(sqlmock.NewRows([]string{"name"}))
+				mock.ExpectQuery("SELECT DISTINCT p.name FROM role_permissions .* WHERE r.name IN \\(\\?\\)").
+					WithArgs("instructor").
+					WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("rosters:view"))
+			},
+			want: auth.Principal{
+				Subject:      "instructor:1",
+				InstructorID: 1,
+				Roles:        []string{"instructor"},
+				Permissions:  []auth.Permission{auth.PermViewRosters},
+			},
+		},
+		{
+			name:      "Student With Assigned Role",
+			principal: auth.Principal{Subject: "student:1", StudentID: 1},
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT r.name FROM principal_roles").
+					WithArgs("student:1").
+					WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("instructor"))
+				mock.ExpectQuery("SELECT DISTINCT p.name FROM role_permissions .* WHERE r.name IN \\(\\?, \\?\\)").
+					WithArgs("instructor", "student").
+					WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("enrollments:manage_own").AddRow("rosters:view"))
+			},
+			want: auth.Principal{
+				Subject:     "student:1",
+				StudentID:   1,
+				Roles:       []string{"instructor", "student"},
+				Permissions: []auth.Permission{auth.PermManageOwnEnrollments, auth.PermViewRosters},
+			},
+		},
+		{
+			name:      "Unknown Subject",
+			principal: auth.Principal{Subject: "service:reporting"},
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT r.name FROM principal_roles").
+					WithArgs("service:reporting").
+					WillReturnRows(sqlmock.NewRows([]string{"name"}))
+			},
+			want: auth.Principal{Subject: "service:reporting"},
+		},
+		{
+			name:      "Role Query Error",
+			principal: auth.Principal{Subject: "admin"},
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT r.name FROM principal_roles").
+					WithArgs("admin").
+					WillReturnError(errors.New("db error"))
+			},
+			want:    auth.Principal{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("error creating mock database: %v", err)
+			}
+			defer db.Close()
+			tt.mock(mock)
+
+			s := NewAccessService(NewSQLAccessRepository(db))
+			got, err := s.ResolvePrincipal(context.Background(), tt.principal)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ResolvePrincipal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ResolvePrincipal() = %+v, want %+v", got, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet expectations: %v", err)
+			}
+		})
+	}
+}
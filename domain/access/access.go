@@ -0,0 +1,52 @@
+package accessdomain
+
+import (
+	"context"
+
+	"github/rakadityas/course-management-system/common/auth"
+)
+
+type AccessDomainItf interface {
+	ResolvePrincipal(ctx context.Context, principal auth.Principal) (auth.Principal, error)
+}
+
+type AccessService struct {
+	repo AccessRepository
+}
+
+func NewAccessService(repo AccessRepository) AccessDomainItf {
+	return &AccessService{repo: repo}
+}
+
+// ResolvePrincipal fills in the roles assigned to the principal's subject and the permissions they grant.
+// A principal acting as a student or instructor also holds the student or instructor role.
+func (s *AccessService) ResolvePrincipal(ctx context.Context, principal auth.Principal) (auth.Principal, error) {
+	roleNames, err := s.repo.GetRoleNamesBySubject(ctx, principal.Subject)
+	if err != nil {
+		return auth.Principal{}, err
+	}
+	if principal.IsStudent() && !containsRole(roleNames, auth.RoleStudent) {
+		roleNames = append(roleNames, auth.RoleStudent)
+	}
+	if principal.IsInstructor() && !containsRole(roleNames, auth.RoleInstructor) {
+		roleNames = append(roleNames, auth.RoleInstructor)
+	}
+
+	permissions, err := s.repo.GetPermissionsByRoleNames(ctx, roleNames)
+	if err != nil {
+		return auth.Principal{}, err
+	}
+
+	principal.Roles = roleNames
+	principal.Permissions = permissions
+	return principal, nil
+}
+
+func containsRole(roleNames []string, roleName string) bool {
+	for _, name := range roleNames {
+		if name == roleName {
+			return true
+		}
+	}
+	return false
+}
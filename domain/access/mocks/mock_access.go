@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/access/access.go
+
+// Package accessdomain is a generated GoMock package.
+package accessdomain
+
+import (
+	context "context"
+	auth "github/rakadityas/course-management-system/common/auth"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAccessDomainItf is a mock of AccessDomainItf interface.
+type MockAccessDomainItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessDomainItfMockRecorder
+}
+
+// MockAccessDomainItfMockRecorder is the mock recorder for MockAccessDomainItf.
+type MockAccessDomainItfMockRecorder struct {
+	mock *MockAccessDomainItf
+}
+
+// NewMockAccessDomainItf creates a new mock instance.
+func NewMockAccessDomainItf(ctrl *gomock.Controller) *MockAccessDomainItf {
+	mock := &MockAccessDomainItf{ctrl: ctrl}
+	mock.recorder = &MockAccessDomainItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessDomainItf) EXPECT() *MockAccessDomainItfMockRecorder {
+	return m.recorder
+}
+
+// ResolvePrincipal mocks base method.
+func (m *MockAccessDomainItf) ResolvePrincipal(ctx context.Context, principal auth.Principal) (auth.Principal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePrincipal", ctx, principal)
+	ret0, _ := ret[0].(auth.Principal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolvePrincipal indicates an expected call of ResolvePrincipal.
+func (mr *MockAccessDomainItfMockRecorder) ResolvePrincipal(ctx, principal interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePrincipal", reflect.TypeOf((*MockAccessDomainItf)(nil).ResolvePrincipal), ctx, principal)
+}
@@ -1,6 +1,99 @@
 package courseenrollmentdomain
 
+import "fmt"
+
+// EnrollmentStatus is the lifecycle state of a course enrollment. The numeric values are
+// stored in course_enrollments.status and must not be renumbered.
+type EnrollmentStatus int
+
+const (
+	StatusCancelled EnrollmentStatus = 0
+	StatusActive    EnrollmentStatus = 1
+	// 2 is skipped because the legacy seed data used it for cancelled enrollments.
+	StatusWaitlisted EnrollmentStatus = 3
+	StatusPending    EnrollmentStatus = 4
+	StatusCompleted  EnrollmentStatus = 5
+	StatusDropped    EnrollmentStatus = 6
+	StatusFailed     EnrollmentStatus = 7
+)
+
+var statusNames = map[EnrollmentStatus]string{
+	StatusCancelled:  "cancelled",
+	StatusActive:     "active",
+	StatusWaitlisted: "waitlisted",
+	StatusPending:    "pending",
+	StatusCompleted:  "completed",
+	StatusDropped:    "dropped",
+	StatusFailed:     "failed",
+}
+
+// statusTransitions lists the statuses an enrollment may move to from each status.
+// Completed, dropped and failed enrollments are final.
+var statusTransitions = map[EnrollmentStatus][]EnrollmentStatus{
+	StatusPending:    {StatusActive, StatusWaitlisted, StatusCancelled, StatusFailed},
+	StatusActive:     {StatusCancelled, StatusCompleted, StatusDropped, StatusFailed},
+	StatusWaitlisted: {StatusActive, StatusCancelled},
+	StatusCancelled:  {StatusActive, StatusWaitlisted},
+}
+
+// IsValid reports whether the status is one of the known enrollment statuses.
+func (s EnrollmentStatus) IsValid() bool {
+	_, ok := statusNames[s]
+	return ok
+}
+
+// CanTransitionTo reports whether an enrollment in status s may move to next.
+func (s EnrollmentStatus) CanTransitionTo(next EnrollmentStatus) bool {
+	for _, allowed := range statusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s EnrollmentStatus) String() string {
+	if name, ok := statusNames[s]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("unknown(%d)", int(s))
+}
+
+// MarshalText encodes the status by name, so JSON responses carry "active" rather than 1.
+func (s EnrollmentStatus) MarshalText() ([]byte, error) {
+	if !s.IsValid() {
+		return nil, fmt.Errorf("invalid enrollment status %d", int(s))
+	}
+
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a status from its name.
+func (s *EnrollmentStatus) UnmarshalText(text []byte) error {
+	for status, name := range statusNames {
+		if name == string(text) {
+			*s = status
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid enrollment status %q", text)
+}
+
+// EnrollmentSortField is the order of a list of enrollments. Every order ends with the
+// enrollment ID so pages stay stable.
+type EnrollmentSortField string
+
 const (
-	StatusActive    = 1
-	StatusCancelled = 0
+	// SortByEnrollTime orders enrollments by when they were created.
+	SortByEnrollTime EnrollmentSortField = "enroll_time"
+	// SortByName orders enrollments by course name.
+	SortByName EnrollmentSortField = "name"
 )
+
+// IsValid reports whether f is a known sort field.
+func (f EnrollmentSortField) IsValid() bool {
+	return f == SortByEnrollTime || f == SortByName
+}
@@ -0,0 +1,71 @@
+package courseenrollmentdomain
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnrollmentStatus_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		name string
+		from EnrollmentStatus
+		to   EnrollmentStatus
+		want bool
+	}{
+		{name: "Pending To Active", from: StatusPending, to: StatusActive, want: true},
+		{name: "Active To Cancelled", from: StatusActive, to: StatusCancelled, want: true},
+		{name: "Active To Completed", from: StatusActive, to: StatusCompleted, want: true},
+		{name: "Waitlisted To Active", from: StatusWaitlisted, to: StatusActive, want: true},
+		{name: "Cancelled To Active", from: StatusCancelled, to: StatusActive, want: true},
+		{name: "Waitlisted To Completed", from: StatusWaitlisted, to: StatusCompleted, want: false},
+		{name: "Completed Is Final", from: StatusCompleted, to: StatusActive, want: false},
+		{name: "Dropped Is Final", from: StatusDropped, to: StatusCancelled, want: false},
+		{name: "Unknown Status", from: EnrollmentStatus(2), to: StatusActive, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.from.CanTransitionTo(tt.to); got != tt.want {
+				t.Errorf("EnrollmentStatus.CanTransitionTo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnrollmentStatus_JSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  EnrollmentStatus
+		want    string
+		wantErr bool
+	}{
+		{name: "Active", status: StatusActive, want: `"active"`},
+		{name: "Cancelled", status: StatusCancelled, want: `"cancelled"`},
+		{name: "Waitlisted", status: StatusWaitlisted, want: `"waitlisted"`},
+		{name: "Unknown", status: EnrollmentStatus(2), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.status)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("json.Marshal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal() = %s, want %s", got, tt.want)
+			}
+
+			var decoded EnrollmentStatus
+			if err := json.Unmarshal(got, &decoded); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if decoded != tt.status {
+				t.Errorf("json.Unmarshal() = %v, want %v", decoded, tt.status)
+			}
+		})
+	}
+}
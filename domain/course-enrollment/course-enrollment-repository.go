@@ -4,18 +4,39 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strings"
 	"time"
+
+	common "github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/transaction"
 )
 
-// Custom error for when no rows are updated.
-var ErrNoRowsAffected = errors.New("no rows were updated")
+var (
+	// Custom error for when no rows are updated.
+	ErrNoRowsAffected = errors.New("no rows were updated")
+	// ErrEnrollmentAlreadyExists is returned when the student already has an enrollment in the course.
+	ErrEnrollmentAlreadyExists = errors.New("enrollment already exists")
+)
 
 type CourseEnrollmentRepository interface {
 	CreateEnrollment(ctx context.Context, courseEnrollment CourseEnrollment) (CourseEnrollment, error)
 	GetEnrollmentByStudentID(ctx context.Context, studentID int64) ([]CourseEnrollment, error)
+	ListEnrollmentsByStudentID(ctx context.Context, studentID int64, query EnrollmentListQuery) ([]CourseEnrollment, error)
 	GetEnrollmentByStudentIDAndCourseID(ctx context.Context, studentID, courseID int64) ([]CourseEnrollment, error)
-	UpdateCourseEnrollmentStatus(ctx context.Context, studentID, courseID int64, newStatus int) error
-	GetListClassmates(ctx context.Context, studentID int64) ([]CourseEnrollment, error)
+	GetEnrollmentByStudentIDAndSectionID(ctx context.Context, studentID, sectionID int64) (*CourseEnrollment, error)
+	LockEnrollmentByStudentIDAndSectionID(ctx context.Context, studentID, sectionID int64) (*CourseEnrollment, error)
+	UpdateCourseEnrollmentStatus(ctx context.Context, studentID, courseID int64, currentStatus, newStatus EnrollmentStatus) error
+	GetListClassmates(ctx context.Context, studentID int64, query EnrollmentListQuery) ([]CourseEnrollment, error)
+	GetEnrollmentsByCourseID(ctx context.Context, courseID int64, statuses []EnrollmentStatus) ([]CourseEnrollment, error)
+	CountEnrollmentBySectionIDAndStatus(ctx context.Context, sectionID int64, status EnrollmentStatus) (int, error)
+	GetEnrollmentByStudentIDAndStatus(ctx context.Context, studentID int64, status EnrollmentStatus) ([]CourseEnrollment, error)
+	GetWaitlistBySectionID(ctx context.Context, sectionID int64) ([]CourseEnrollment, error)
+	CancelEnrollment(ctx context.Context, enrollment CourseEnrollment, updateTime time.Time) error
+	PromoteEnrollment(ctx context.Context, enrollmentID int64, updateTime time.Time) error
+	ReactivateEnrollment(ctx context.Context, enrollmentID int64, status EnrollmentStatus, updateTime time.Time) error
+	RecordGrade(ctx context.Context, enrollmentID int64, grade Grade, status EnrollmentStatus, updateTime time.Time) error
+	GetGradedEnrollmentsByStudentID(ctx context.Context, studentID int64) ([]CourseEnrollment, error)
+	ExportEnrollments(ctx context.Context, query EnrollmentExportQuery, fn func(EnrollmentExportRow) error) error
 }
 
 type CourseEnrollmentDB struct {
@@ -27,30 +48,44 @@ func NewSQLCourseEnrollmentRepository(db *sql.DB) *CourseEnrollmentDB {
 	return &CourseEnrollmentDB{DB: db}
 }
 
-// CreateEnrollment inserts a new course enrollment record into the database.
+// CreateEnrollment inserts a new course enrollment record into the database
+// together with its first history entry.
+// Returns ErrEnrollmentAlreadyExists if the student already has an enrollment in the section.
 func (repo *CourseEnrollmentDB) CreateEnrollment(ctx context.Context, courseEnrollment CourseEnrollment) (CourseEnrollment, error) {
-	query := `
-		INSERT INTO course_enrollments (student_id, course_id, status, create_time, update_time)
-		VALUES (?, ?, ?, ?, ?)
-	`
-	result, err := repo.DB.ExecContext(ctx, query, courseEnrollment.StudentID, courseEnrollment.CourseID, courseEnrollment.Status, courseEnrollment.CreateTime, courseEnrollment.UpdateTime)
-	if err != nil {
-		return CourseEnrollment{}, err
-	}
+	err := transaction.Do(ctx, repo.DB, func(ctx context.Context) error {
+		executor := transaction.GetExecutor(ctx, repo.DB)
 
-	id, err := result.LastInsertId()
+		query := `
+			INSERT INTO course_enrollments (student_id, course_id, section_id, status, create_time, update_time)
+			VALUES (?, ?, ?, ?, ?, ?)
+		`
+		result, err := executor.ExecContext(ctx, query, courseEnrollment.StudentID, courseEnrollment.CourseID, courseEnrollment.SectionID, courseEnrollment.Status, courseEnrollment.CreateTime, courseEnrollment.UpdateTime)
+		if err != nil {
+			if common.IsDuplicateEntryError(err) {
+				return ErrEnrollmentAlreadyExists
+			}
+			return err
+		}
+
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		courseEnrollment.ID = id
+		return insertEnrollmentHistory(ctx, executor, id, courseEnrollment.Status, courseEnrollment.CreateTime)
+	})
 	if err != nil {
 		return CourseEnrollment{}, err
 	}
 
-	courseEnrollment.ID = id
 	return courseEnrollment, nil
 }
 
-// GetEnrollmentByStudentID retrieves all course enrollments for a given student.
+// GetEnrollmentByStudentID retrieves all active and waitlisted course enrollments for a given student.
 func (repo *CourseEnrollmentDB) GetEnrollmentByStudentID(ctx context.Context, studentID int64) ([]CourseEnrollment, error) {
-	query := "SELECT id, student_id, course_id, status, create_time, update_time FROM course_enrollments WHERE student_id = ? and status = 1"
-	rows, err := repo.DB.QueryContext(ctx, query, studentID)
+	query := "SELECT id, student_id, course_id, section_id, status, create_time, update_time FROM course_enrollments WHERE student_id = ? and status IN (?, ?)"
+	rows, err := transaction.GetExecutor(ctx, repo.DB).QueryContext(ctx, query, studentID, StatusActive, StatusWaitlisted)
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +94,7 @@ func (repo *CourseEnrollmentDB) GetEnrollmentByStudentID(ctx context.Context, st
 	var enrollments []CourseEnrollment
 	for rows.Next() {
 		var enrollment CourseEnrollment
-		if err := rows.Scan(&enrollment.ID, &enrollment.StudentID, &enrollment.CourseID, &enrollment.Status, &enrollment.CreateTime, &enrollment.UpdateTime); err != nil {
+		if err := rows.Scan(&enrollment.ID, &enrollment.StudentID, &enrollment.CourseID, &enrollment.SectionID, &enrollment.Status, &enrollment.CreateTime, &enrollment.UpdateTime); err != nil {
 			return nil, err
 		}
 		enrollments = append(enrollments, enrollment)
@@ -72,70 +107,287 @@ func (repo *CourseEnrollmentDB) GetEnrollmentByStudentID(ctx context.Context, st
 	return enrollments, nil
 }
 
-// UpdateCourseEnrollmentStatus updates the status of course enrollments for a specific student and course.
-// Returns an error if no rows are affected by the update.
-func (repo *CourseEnrollmentDB) UpdateCourseEnrollmentStatus(ctx context.Context, studentID, courseID int64, newStatus int) error {
+// UpdateCourseEnrollmentStatus moves the course enrollment of a student from currentStatus to newStatus
+// and records the change in the enrollment history.
+// Returns ErrNoRowsAffected if the enrollment does not exist or is no longer in currentStatus.
+func (repo *CourseEnrollmentDB) UpdateCourseEnrollmentStatus(ctx context.Context, studentID, courseID int64, currentStatus, newStatus EnrollmentStatus) error {
+	return transaction.Do(ctx, repo.DB, func(ctx context.Context) error {
+		executor := transaction.GetExecutor(ctx, repo.DB)
+
+		lockQuery := `
+			SELECT id
+			FROM course_enrollments
+			WHERE student_id = ? AND course_id = ? AND status = ?
+			ORDER BY update_time DESC, id DESC
+			LIMIT 1
+			FOR UPDATE
+		`
+		var enrollmentID int64
+		err := executor.QueryRowContext(ctx, lockQuery, studentID, courseID, currentStatus).Scan(&enrollmentID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return ErrNoRowsAffected
+			}
+			return err
+		}
+
+		updateTime := time.Now()
+		query := `
+			UPDATE course_enrollments
+			SET status = ?, update_time = ?
+			WHERE id = ?
+		`
+		if _, err := executor.ExecContext(ctx, query, newStatus, updateTime, enrollmentID); err != nil {
+			return err
+		}
+
+		return insertEnrollmentHistory(ctx, executor, enrollmentID, newStatus, updateTime)
+	})
+}
+
+// ListEnrollmentsByStudentID retrieves a page of the student's enrollments matching the list query.
+func (repo *CourseEnrollmentDB) ListEnrollmentsByStudentID(ctx context.Context, studentID int64, query EnrollmentListQuery) ([]CourseEnrollment, error) {
+	selectQuery := `
+		SELECT ce.id, ce.student_id, ce.course_id, ce.section_id, ce.status, ce.create_time, ce.update_time
+		FROM course_enrollments ce
+		JOIN courses c ON c.id = ce.course_id
+		WHERE ce.student_id = ?
+	`
+	listClause, listArgs := enrollmentListClause(query)
+
+	return repo.queryEnrollments(ctx, selectQuery+listClause, append([]interface{}{studentID}, listArgs...)...)
+}
+
+// GetListClassmates retrieves a page of the enrollments of other students in the courses
+// the student is active in, matching the list query.
+func (repo *CourseEnrollmentDB) GetListClassmates(ctx context.Context, studentID int64, query EnrollmentListQuery) ([]CourseEnrollment, error) {
+	selectQuery := `
+		SELECT ce.id, ce.student_id, ce.course_id, ce.section_id, ce.status, ce.create_time, ce.update_time
+		FROM course_enrollments ce
+		JOIN course_enrollments ce2 ON ce.section_id = ce2.section_id
+		JOIN courses c ON c.id = ce.course_id
+		WHERE ce2.student_id = ? AND ce.student_id != ? AND ce2.status = ?
+	`
+	listClause, listArgs := enrollmentListClause(query)
+
+	return repo.queryEnrollments(ctx, selectQuery+listClause, append([]interface{}{studentID, studentID, StatusActive}, listArgs...)...)
+}
+
+// GetEnrollmentsByCourseID retrieves the enrollments of a course in any of the given statuses,
+// ordered by enrollment time.
+func (repo *CourseEnrollmentDB) GetEnrollmentsByCourseID(ctx context.Context, courseID int64, statuses []EnrollmentStatus) ([]CourseEnrollment, error) {
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+
+	args := []interface{}{courseID}
+	for _, status := range statuses {
+		args = append(args, status)
+	}
 	query := `
-		UPDATE course_enrollments
-		SET status = ?, update_time = ?
+		SELECT id, student_id, course_id, section_id, status, create_time, update_time
+		FROM course_enrollments
+		WHERE course_id = ? AND status IN (` + strings.TrimSuffix(strings.Repeat("?, ", len(statuses)), ", ") + `)
+		ORDER BY create_time, id
+	`
+
+	return repo.queryEnrollments(ctx, query, args...)
+}
+
+// queryEnrollments runs a query selecting id, student_id, course_id, section_id, status, create_time and update_time.
+func (repo *CourseEnrollmentDB) queryEnrollments(ctx context.Context, query string, args ...interface{}) ([]CourseEnrollment, error) {
+	rows, err := transaction.GetExecutor(ctx, repo.DB).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var enrollments []CourseEnrollment
+	for rows.Next() {
+		var enrollment CourseEnrollment
+		if err := rows.Scan(&enrollment.ID, &enrollment.StudentID, &enrollment.CourseID, &enrollment.SectionID, &enrollment.Status, &enrollment.CreateTime, &enrollment.UpdateTime); err != nil {
+			return nil, err
+		}
+		enrollments = append(enrollments, enrollment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return enrollments, nil
+}
+
+// enrollmentListClause returns the filter, seek, order and limit clauses of a list query
+// over course_enrollments ce joined with courses c.
+func enrollmentListClause(query EnrollmentListQuery) (string, []interface{}) {
+	var clause strings.Builder
+	var args []interface{}
+
+	if len(query.Statuses) > 0 {
+		clause.WriteString(" AND ce.status IN (" + strings.TrimSuffix(strings.Repeat("?, ", len(query.Statuses)), ", ") + ")")
+		for _, status := range query.Statuses {
+			args = append(args, status)
+		}
+	}
+	if query.TermID != 0 {
+		clause.WriteString(" AND ce.section_id IN (SELECT id FROM course_sections WHERE term_id = ?)")
+		args = append(args, query.TermID)
+	}
+	if query.CreatedAfter != nil {
+		clause.WriteString(" AND ce.create_time > ?")
+		args = append(args, *query.CreatedAfter)
+	}
+
+	switch query.SortBy {
+	case SortByName:
+		if query.After != nil {
+			clause.WriteString(" AND (c.name > ? OR (c.name = ? AND ce.id > ?))")
+			args = append(args, query.After.CourseName, query.After.CourseName, query.After.ID)
+		}
+		clause.WriteString(" ORDER BY c.name, ce.id")
+	default:
+		if query.After != nil {
+			clause.WriteString(" AND (ce.create_time > ? OR (ce.create_time = ? AND ce.id > ?))")
+			args = append(args, query.After.CreateTime, query.After.CreateTime, query.After.ID)
+		}
+		clause.WriteString(" ORDER BY ce.create_time, ce.id")
+	}
+
+	if query.Limit > 0 {
+		clause.WriteString(" LIMIT ?")
+		args = append(args, query.Limit)
+	}
+
+	return clause.String(), args
+}
+
+// GetEnrollmentByStudentIDAndCourseID retrieves the enrollments of a student in every section of a course,
+// the most recently updated first.
+func (repo *CourseEnrollmentDB) GetEnrollmentByStudentIDAndCourseID(ctx context.Context, studentID, courseID int64) ([]CourseEnrollment, error) {
+	query := `
+		SELECT id, student_id, course_id, section_id, status, reenroll_count, create_time, update_time
+		FROM course_enrollments
 		WHERE student_id = ? AND course_id = ?
+		ORDER BY update_time DESC, id DESC
 	`
-	result, err := repo.DB.ExecContext(ctx, query, newStatus, time.Now(), studentID, courseID)
+
+	rows, err := transaction.GetExecutor(ctx, repo.DB).QueryContext(ctx, query, studentID, courseID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	defer rows.Close()
+
+	var enrollments []CourseEnrollment
+	for rows.Next() {
+		var enrollment CourseEnrollment
+		if err := rows.Scan(&enrollment.ID, &enrollment.StudentID, &enrollment.CourseID, &enrollment.SectionID, &enrollment.Status, &enrollment.ReEnrollCount, &enrollment.CreateTime, &enrollment.UpdateTime); err != nil {
+			return nil, err
+		}
+		enrollments = append(enrollments, enrollment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
 
-	rowsAffected, err := result.RowsAffected()
+	return enrollments, nil
+}
+
+// GetEnrollmentByStudentIDAndSectionID retrieves the enrollment of a student in a section.
+// Returns nil if the student has never enrolled in the section.
+func (repo *CourseEnrollmentDB) GetEnrollmentByStudentIDAndSectionID(ctx context.Context, studentID, sectionID int64) (*CourseEnrollment, error) {
+	query := `
+		SELECT id, student_id, course_id, section_id, status, reenroll_count, create_time, update_time
+		FROM course_enrollments
+		WHERE student_id = ? AND section_id = ?
+	`
+	return repo.queryEnrollment(ctx, query, studentID, sectionID)
+}
+
+// LockEnrollmentByStudentIDAndSectionID retrieves the enrollment of a student in a section and locks
+// it until the surrounding transaction ends. It must run inside a transaction.
+// Returns nil if the student has never enrolled in the section.
+func (repo *CourseEnrollmentDB) LockEnrollmentByStudentIDAndSectionID(ctx context.Context, studentID, sectionID int64) (*CourseEnrollment, error) {
+	query := `
+		SELECT id, student_id, course_id, section_id, status, reenroll_count, create_time, update_time
+		FROM course_enrollments
+		WHERE student_id = ? AND section_id = ?
+		FOR UPDATE
+	`
+	return repo.queryEnrollment(ctx, query, studentID, sectionID)
+}
+
+// queryEnrollment runs a query selecting a single enrollment, nil if there is none.
+func (repo *CourseEnrollmentDB) queryEnrollment(ctx context.Context, query string, args ...interface{}) (*CourseEnrollment, error) {
+	row := transaction.GetExecutor(ctx, repo.DB).QueryRowContext(ctx, query, args...)
+
+	enrollment := &CourseEnrollment{}
+	err := row.Scan(&enrollment.ID, &enrollment.StudentID, &enrollment.CourseID, &enrollment.SectionID, &enrollment.Status, &enrollment.ReEnrollCount, &enrollment.CreateTime, &enrollment.UpdateTime)
 	if err != nil {
-		return err
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
 	}
 
-	if rowsAffected == 0 {
-		return ErrNoRowsAffected
+	return enrollment, nil
+}
+
+// CountEnrollmentBySectionIDAndStatus counts the enrollments of a section in the given status.
+func (repo *CourseEnrollmentDB) CountEnrollmentBySectionIDAndStatus(ctx context.Context, sectionID int64, status EnrollmentStatus) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM course_enrollments
+		WHERE section_id = ? AND status = ?
+	`
+
+	var count int
+	if err := transaction.GetExecutor(ctx, repo.DB).QueryRowContext(ctx, query, sectionID, status).Scan(&count); err != nil {
+		return 0, err
 	}
 
-	return nil
+	return count, nil
 }
 
-// GetListClassmates retrieves all students who have signed up for the same course as the specified student.
-func (repo *CourseEnrollmentDB) GetListClassmates(ctx context.Context, studentID int64) ([]CourseEnrollment, error) {
+// GetEnrollmentByStudentIDAndStatus retrieves the enrollments of a student in the given status.
+func (repo *CourseEnrollmentDB) GetEnrollmentByStudentIDAndStatus(ctx context.Context, studentID int64, status EnrollmentStatus) ([]CourseEnrollment, error) {
 	query := `
-		SELECT ce.id, ce.student_id, ce.course_id, ce.status, ce.create_time, ce.update_time
-		FROM course_enrollments ce
-		JOIN course_enrollments ce2 ON ce.course_id = ce2.course_id
-		WHERE ce2.student_id = ? AND ce.student_id != ? and ce2.status = 1 and ce.status = 1
+		SELECT id, student_id, course_id, section_id, status, create_time, update_time
+		FROM course_enrollments
+		WHERE student_id = ? AND status = ?
 	`
-	rows, err := repo.DB.QueryContext(ctx, query, studentID, studentID)
+	rows, err := transaction.GetExecutor(ctx, repo.DB).QueryContext(ctx, query, studentID, status)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var classmates []CourseEnrollment
+	var enrollments []CourseEnrollment
 	for rows.Next() {
 		var enrollment CourseEnrollment
-		if err := rows.Scan(&enrollment.ID, &enrollment.StudentID, &enrollment.CourseID, &enrollment.Status, &enrollment.CreateTime, &enrollment.UpdateTime); err != nil {
+		if err := rows.Scan(&enrollment.ID, &enrollment.StudentID, &enrollment.CourseID, &enrollment.SectionID, &enrollment.Status, &enrollment.CreateTime, &enrollment.UpdateTime); err != nil {
 			return nil, err
 		}
-		classmates = append(classmates, enrollment)
+		enrollments = append(enrollments, enrollment)
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
 
-	return classmates, nil
+	return enrollments, nil
 }
 
-// GetEnrollmentByStudentIDAndCourseID retrieves enrollments for a student and course.
-func (repo *CourseEnrollmentDB) GetEnrollmentByStudentIDAndCourseID(ctx context.Context, studentID, courseID int64) ([]CourseEnrollment, error) {
+// GetWaitlistBySectionID retrieves the waitlisted enrollments of a section in the order they were waitlisted.
+func (repo *CourseEnrollmentDB) GetWaitlistBySectionID(ctx context.Context, sectionID int64) ([]CourseEnrollment, error) {
 	query := `
-		SELECT id, student_id, course_id, status, create_time, update_time
+		SELECT id, student_id, course_id, section_id, status, create_time, update_time
 		FROM course_enrollments
-		WHERE student_id = ? AND course_id = ?
+		WHERE section_id = ? AND status = ?
+		ORDER BY update_time, id
 	`
-
-	rows, err := repo.DB.QueryContext(ctx, query, studentID, courseID)
+	rows, err := transaction.GetExecutor(ctx, repo.DB).QueryContext(ctx, query, sectionID, StatusWaitlisted)
 	if err != nil {
 		return nil, err
 	}
@@ -144,9 +396,146 @@ func (repo *CourseEnrollmentDB) GetEnrollmentByStudentIDAndCourseID(ctx context.
 	var enrollments []CourseEnrollment
 	for rows.Next() {
 		var enrollment CourseEnrollment
-		if err := rows.Scan(&enrollment.ID, &enrollment.StudentID, &enrollment.CourseID, &enrollment.Status, &enrollment.CreateTime, &enrollment.UpdateTime); err != nil {
+		if err := rows.Scan(&enrollment.ID, &enrollment.StudentID, &enrollment.CourseID, &enrollment.SectionID, &enrollment.Status, &enrollment.CreateTime, &enrollment.UpdateTime); err != nil {
+			return nil, err
+		}
+		enrollments = append(enrollments, enrollment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return enrollments, nil
+}
+
+// CancelEnrollment cancels an enrollment that is still in the given status.
+// Returns ErrNoRowsAffected if the enrollment does not exist or its status has changed.
+func (repo *CourseEnrollmentDB) CancelEnrollment(ctx context.Context, enrollment CourseEnrollment, updateTime time.Time) error {
+	return repo.updateStatus(ctx, enrollment.ID, enrollment.Status, StatusCancelled, updateTime)
+}
+
+// PromoteEnrollment moves a waitlisted enrollment to active.
+// Returns ErrNoRowsAffected if the enrollment does not exist or is no longer waitlisted.
+func (repo *CourseEnrollmentDB) PromoteEnrollment(ctx context.Context, enrollmentID int64, updateTime time.Time) error {
+	return repo.updateStatus(ctx, enrollmentID, StatusWaitlisted, StatusActive, updateTime)
+}
+
+// updateStatus moves an enrollment from currentStatus to newStatus and records the change in its history.
+// Returns ErrNoRowsAffected if the enrollment does not exist or is no longer in currentStatus.
+func (repo *CourseEnrollmentDB) updateStatus(ctx context.Context, enrollmentID int64, currentStatus, newStatus EnrollmentStatus, updateTime time.Time) error {
+	return transaction.Do(ctx, repo.DB, func(ctx context.Context) error {
+		executor := transaction.GetExecutor(ctx, repo.DB)
+
+		query := `
+			UPDATE course_enrollments
+			SET status = ?, update_time = ?
+			WHERE id = ? AND status = ?
+		`
+		result, err := executor.ExecContext(ctx, query, newStatus, updateTime, enrollmentID, currentStatus)
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return ErrNoRowsAffected
+		}
+
+		return insertEnrollmentHistory(ctx, executor, enrollmentID, newStatus, updateTime)
+	})
+}
+
+// ReactivateEnrollment moves a cancelled enrollment back to the given status and counts the re-enrollment.
+// Returns ErrNoRowsAffected if the enrollment does not exist or is no longer cancelled.
+func (repo *CourseEnrollmentDB) ReactivateEnrollment(ctx context.Context, enrollmentID int64, status EnrollmentStatus, updateTime time.Time) error {
+	return transaction.Do(ctx, repo.DB, func(ctx context.Context) error {
+		executor := transaction.GetExecutor(ctx, repo.DB)
+
+		query := `
+			UPDATE course_enrollments
+			SET status = ?, reenroll_count = reenroll_count + 1, update_time = ?
+			WHERE id = ? AND status = ?
+		`
+		result, err := executor.ExecContext(ctx, query, status, updateTime, enrollmentID, StatusCancelled)
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return ErrNoRowsAffected
+		}
+
+		return insertEnrollmentHistory(ctx, executor, enrollmentID, status, updateTime)
+	})
+}
+
+// RecordGrade stores the grade of an active enrollment and moves it to the given status.
+// Returns ErrNoRowsAffected if the enrollment does not exist or is no longer active.
+func (repo *CourseEnrollmentDB) RecordGrade(ctx context.Context, enrollmentID int64, grade Grade, status EnrollmentStatus, updateTime time.Time) error {
+	return transaction.Do(ctx, repo.DB, func(ctx context.Context) error {
+		executor := transaction.GetExecutor(ctx, repo.DB)
+
+		query := `
+			UPDATE course_enrollments
+			SET status = ?, grade_letter = ?, grade_points = ?, update_time = ?
+			WHERE id = ? AND status = ?
+		`
+		result, err := executor.ExecContext(ctx, query, status, grade.Letter, grade.Points, updateTime, enrollmentID, StatusActive)
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return ErrNoRowsAffected
+		}
+
+		return insertEnrollmentHistory(ctx, executor, enrollmentID, status, updateTime)
+	})
+}
+
+// GetGradedEnrollmentsByStudentID retrieves the completed and failed enrollments of a student
+// with their grades, the earliest graded first.
+func (repo *CourseEnrollmentDB) GetGradedEnrollmentsByStudentID(ctx context.Context, studentID int64) ([]CourseEnrollment, error) {
+	query := `
+		SELECT id, student_id, course_id, section_id, status, grade_letter, grade_points, create_time, update_time
+		FROM course_enrollments
+		WHERE student_id = ? AND status IN (?, ?)
+		ORDER BY update_time, id
+	`
+	rows, err := transaction.GetExecutor(ctx, repo.DB).QueryContext(ctx, query, studentID, StatusCompleted, StatusFailed)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var enrollments []CourseEnrollment
+	for rows.Next() {
+		var (
+			enrollment  CourseEnrollment
+			gradeLetter sql.NullString
+			gradePoints sql.NullFloat64
+		)
+		if err := rows.Scan(&enrollment.ID, &enrollment.StudentID, &enrollment.CourseID, &enrollment.SectionID, &enrollment.Status, &gradeLetter, &gradePoints, &enrollment.CreateTime, &enrollment.UpdateTime); err != nil {
 			return nil, err
 		}
+		if gradeLetter.Valid {
+			enrollment.Grade = &Grade{Letter: gradeLetter.String, Points: gradePoints.Float64}
+		}
 		enrollments = append(enrollments, enrollment)
 	}
 
@@ -156,3 +545,79 @@ func (repo *CourseEnrollmentDB) GetEnrollmentByStudentIDAndCourseID(ctx context.
 
 	return enrollments, nil
 }
+
+// ExportEnrollments passes the enrollments matching the export query to fn one at a time, in the
+// order they were created, joined with the student email and course name. Rows are read from the
+// database as fn consumes them, so the result is never held in memory at once.
+// An error returned by fn stops the export and is returned.
+func (repo *CourseEnrollmentDB) ExportEnrollments(ctx context.Context, query EnrollmentExportQuery, fn func(EnrollmentExportRow) error) error {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+	if query.CourseID != 0 {
+		conditions = append(conditions, "ce.course_id = ?")
+		args = append(args, query.CourseID)
+	}
+	if len(query.Statuses) > 0 {
+		conditions = append(conditions, "ce.status IN ("+strings.TrimSuffix(strings.Repeat("?, ", len(query.Statuses)), ", ")+")")
+		for _, status := range query.Statuses {
+			args = append(args, status)
+		}
+	}
+	if query.CreatedFrom != nil {
+		conditions = append(conditions, "ce.create_time >= ?")
+		args = append(args, *query.CreatedFrom)
+	}
+	if query.CreatedBefore != nil {
+		conditions = append(conditions, "ce.create_time < ?")
+		args = append(args, *query.CreatedBefore)
+	}
+
+	selectQuery := `
+		SELECT ce.id, ce.student_id, s.email, ce.course_id, c.name, ce.section_id, ce.status, ce.grade_letter, ce.grade_points, ce.create_time, ce.update_time
+		FROM course_enrollments ce
+		JOIN students s ON s.id = ce.student_id
+		JOIN courses c ON c.id = ce.course_id
+	`
+	if len(conditions) > 0 {
+		selectQuery += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	selectQuery += " ORDER BY ce.id"
+
+	rows, err := transaction.GetExecutor(ctx, repo.DB).QueryContext(ctx, selectQuery, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			row         EnrollmentExportRow
+			gradeLetter sql.NullString
+			gradePoints sql.NullFloat64
+		)
+		enrollment := &row.Enrollment
+		if err := rows.Scan(&enrollment.ID, &enrollment.StudentID, &row.StudentEmail, &enrollment.CourseID, &row.CourseName, &enrollment.SectionID, &enrollment.Status, &gradeLetter, &gradePoints, &enrollment.CreateTime, &enrollment.UpdateTime); err != nil {
+			return err
+		}
+		if gradeLetter.Valid {
+			enrollment.Grade = &Grade{Letter: gradeLetter.String, Points: gradePoints.Float64}
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// insertEnrollmentHistory appends the status an enrollment entered to its history.
+func insertEnrollmentHistory(ctx context.Context, executor transaction.Executor, enrollmentID int64, status EnrollmentStatus, createTime time.Time) error {
+	query := `
+		INSERT INTO course_enrollment_histories (course_enrollment_id, status, create_time)
+		VALUES (?, ?, ?)
+	`
+	_, err := executor.ExecContext(ctx, query, enrollmentID, status, createTime)
+	return err
+}
@@ -10,12 +10,14 @@ import (
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
 )
 
 func TestCourseEnrollmentDB_CreateEnrollment(t *testing.T) {
 	const (
 		studentID = 1
 		courseID  = 101
+		sectionID = 11
 		status    = 1
 	)
 	constCreateTime := time.Date(2023, 8, 25, 0, 0, 0, 0, time.UTC)
@@ -29,11 +31,12 @@ func TestCourseEnrollmentDB_CreateEnrollment(t *testing.T) {
 		courseEnrollment CourseEnrollment
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		want    CourseEnrollment
-		wantErr bool
+		name      string
+		fields    fields
+		args      args
+		want      CourseEnrollment
+		wantErrIs error
+		wantErr   bool
 	}{
 		{
 			name: "Success",
@@ -43,9 +46,14 @@ func TestCourseEnrollmentDB_CreateEnrollment(t *testing.T) {
 					if err != nil {
 						t.Fatalf("error creating mock database: %v", err)
 					}
+					mock.ExpectBegin()
 					mock.ExpectExec("INSERT INTO course_enrollments").
-						WithArgs(studentID, courseID, status, constCreateTime, constUpdateTime).
+						WithArgs(studentID, courseID, sectionID, status, constCreateTime, constUpdateTime).
 						WillReturnResult(sqlmock.NewResult(1, 1))
+					mock.ExpectExec("INSERT INTO course_enrollment_histories").
+						WithArgs(int64(1), status, constCreateTime).
+						WillReturnResult(sqlmock.NewResult(1, 1))
+					mock.ExpectCommit()
 					return db
 				}(),
 			},
@@ -54,6 +62,7 @@ func TestCourseEnrollmentDB_CreateEnrollment(t *testing.T) {
 				courseEnrollment: CourseEnrollment{
 					StudentID:  studentID,
 					CourseID:   courseID,
+					SectionID:  sectionID,
 					Status:     status,
 					CreateTime: constCreateTime,
 					UpdateTime: constUpdateTime,
@@ -63,6 +72,7 @@ func TestCourseEnrollmentDB_CreateEnrollment(t *testing.T) {
 				ID:         1,
 				StudentID:  studentID,
 				CourseID:   courseID,
+				SectionID:  sectionID,
 				Status:     status,
 				CreateTime: constCreateTime,
 				UpdateTime: constUpdateTime,
@@ -77,9 +87,11 @@ func TestCourseEnrollmentDB_CreateEnrollment(t *testing.T) {
 					if err != nil {
 						t.Fatalf("error creating mock database: %v", err)
 					}
+					mock.ExpectBegin()
 					mock.ExpectExec("INSERT INTO course_enrollments").
-						WithArgs(studentID, courseID, status, constCreateTime, constUpdateTime).
+						WithArgs(studentID, courseID, sectionID, status, constCreateTime, constUpdateTime).
 						WillReturnError(errors.New("insert error"))
+					mock.ExpectRollback()
 					return db
 				}(),
 			},
@@ -88,6 +100,7 @@ func TestCourseEnrollmentDB_CreateEnrollment(t *testing.T) {
 				courseEnrollment: CourseEnrollment{
 					StudentID:  studentID,
 					CourseID:   courseID,
+					SectionID:  sectionID,
 					Status:     status,
 					CreateTime: constCreateTime,
 					UpdateTime: constUpdateTime,
@@ -96,6 +109,37 @@ func TestCourseEnrollmentDB_CreateEnrollment(t *testing.T) {
 			want:    CourseEnrollment{},
 			wantErr: true,
 		},
+		{
+			name: "Duplicate Enrollment",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectBegin()
+					mock.ExpectExec("INSERT INTO course_enrollments").
+						WithArgs(studentID, courseID, sectionID, status, constCreateTime, constUpdateTime).
+						WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+					mock.ExpectRollback()
+					return db
+				}(),
+			},
+			args: args{
+				ctx: context.Background(),
+				courseEnrollment: CourseEnrollment{
+					StudentID:  studentID,
+					CourseID:   courseID,
+					SectionID:  sectionID,
+					Status:     status,
+					CreateTime: constCreateTime,
+					UpdateTime: constUpdateTime,
+				},
+			},
+			want:      CourseEnrollment{},
+			wantErrIs: ErrEnrollmentAlreadyExists,
+			wantErr:   true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -108,6 +152,10 @@ func TestCourseEnrollmentDB_CreateEnrollment(t *testing.T) {
 				t.Errorf("CourseEnrollmentDB.CreateEnrollment() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("CourseEnrollmentDB.CreateEnrollment() error = %v, want %v", err, tt.wantErrIs)
+				return
+			}
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("CourseEnrollmentDB.CreateEnrollment() = %v, want %v", got, tt.want)
 			}
@@ -140,11 +188,11 @@ func TestCourseEnrollmentDB_GetEnrollmentByStudentID(t *testing.T) {
 					if err != nil {
 						t.Fatalf("error creating mock database: %v", err)
 					}
-					rows := sqlmock.NewRows([]string{"id", "student_id", "course_id", "status", "create_time", "update_time"}).
-						AddRow(1, studentID, 101, 1, time.Date(2024, 8, 25, 0, 0, 0, 0, time.UTC), time.Date(2024, 8, 25, 0, 0, 0, 0, time.UTC))
+					rows := sqlmock.NewRows([]string{"id", "student_id", "course_id", "section_id", "status", "create_time", "update_time"}).
+						AddRow(1, studentID, 101, 11, 1, time.Date(2024, 8, 25, 0, 0, 0, 0, time.UTC), time.Date(2024, 8, 25, 0, 0, 0, 0, time.UTC))
 
-					mock.ExpectQuery(`SELECT id, student_id, course_id, status, create_time, update_time FROM course_enrollments WHERE student_id = \? and status = 1`).
-						WithArgs(studentID).
+					mock.ExpectQuery(`SELECT id, student_id, course_id, section_id, status, create_time, update_time FROM course_enrollments WHERE student_id = \? and status IN \(\?, \?\)`).
+						WithArgs(studentID, StatusActive, StatusWaitlisted).
 						WillReturnRows(rows)
 					return db
 				}(),
@@ -158,6 +206,7 @@ func TestCourseEnrollmentDB_GetEnrollmentByStudentID(t *testing.T) {
 					ID:         1,
 					StudentID:  studentID,
 					CourseID:   101,
+					SectionID:  11,
 					Status:     1,
 					CreateTime: time.Date(2024, 8, 25, 0, 0, 0, 0, time.UTC),
 					UpdateTime: time.Date(2024, 8, 25, 0, 0, 0, 0, time.UTC),
@@ -173,9 +222,9 @@ func TestCourseEnrollmentDB_GetEnrollmentByStudentID(t *testing.T) {
 					if err != nil {
 						t.Fatalf("error creating mock database: %v", err)
 					}
-					mock.ExpectQuery(`SELECT id, student_id, course_id, status, create_time, update_time FROM course_enrollments WHERE student_id = \? and status = 1`).
-						WithArgs(studentID).
-						WillReturnRows(sqlmock.NewRows([]string{"id", "student_id", "course_id", "status", "create_time", "update_time"}))
+					mock.ExpectQuery(`SELECT id, student_id, course_id, section_id, status, create_time, update_time FROM course_enrollments WHERE student_id = \? and status IN \(\?, \?\)`).
+						WithArgs(studentID, StatusActive, StatusWaitlisted).
+						WillReturnRows(sqlmock.NewRows([]string{"id", "student_id", "course_id", "section_id", "status", "create_time", "update_time"}))
 					return db
 				}(),
 			},
@@ -194,8 +243,8 @@ func TestCourseEnrollmentDB_GetEnrollmentByStudentID(t *testing.T) {
 					if err != nil {
 						t.Fatalf("error creating mock database: %v", err)
 					}
-					mock.ExpectQuery(regexp.QuoteMeta("SELECT id, student_id, course_id, status, create_time, update_time FROM course_enrollments WHERE student_id = ? and status = 1")).
-						WithArgs(studentID).
+					mock.ExpectQuery(regexp.QuoteMeta("SELECT id, student_id, course_id, section_id, status, create_time, update_time FROM course_enrollments WHERE student_id = ? and status IN (?, ?)")).
+						WithArgs(studentID, StatusActive, StatusWaitlisted).
 						WillReturnError(errors.New("query error"))
 					return db
 				}(),
@@ -227,20 +276,27 @@ func TestCourseEnrollmentDB_GetEnrollmentByStudentID(t *testing.T) {
 }
 
 func TestCourseEnrollmentDB_UpdateCourseEnrollmentStatus(t *testing.T) {
+	const (
+		lockQuery   = `SELECT id FROM course_enrollments WHERE student_id = \? AND course_id = \? AND status = \? ORDER BY update_time DESC, id DESC LIMIT 1 FOR UPDATE`
+		updateQuery = `UPDATE course_enrollments SET status = \?, update_time = \? WHERE id = \?`
+	)
+
 	type fields struct {
 		DB *sql.DB
 	}
 	type args struct {
-		ctx       context.Context
-		studentID int64
-		courseID  int64
-		newStatus int
+		ctx           context.Context
+		studentID     int64
+		courseID      int64
+		currentStatus EnrollmentStatus
+		newStatus     EnrollmentStatus
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		wantErr bool
+		name      string
+		fields    fields
+		args      args
+		wantErrIs error
+		wantErr   bool
 	}{
 		{
 			name: "Success",
@@ -250,41 +306,54 @@ func TestCourseEnrollmentDB_UpdateCourseEnrollmentStatus(t *testing.T) {
 					if err != nil {
 						t.Fatalf("error creating mock database: %v", err)
 					}
-					mock.ExpectExec(`UPDATE course_enrollments SET status = \?, update_time = \? WHERE student_id = \? AND course_id = \?`).
-						WithArgs(1, sqlmock.AnyArg(), int64(1), int64(101)).
-						WillReturnResult(sqlmock.NewResult(0, 1)) // 1 row affected
+					mock.ExpectBegin()
+					mock.ExpectQuery(lockQuery).
+						WithArgs(int64(1), int64(101), StatusActive).
+						WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10))
+					mock.ExpectExec(updateQuery).
+						WithArgs(StatusCompleted, sqlmock.AnyArg(), int64(10)).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectExec(`INSERT INTO course_enrollment_histories`).
+						WithArgs(int64(10), StatusCompleted, sqlmock.AnyArg()).
+						WillReturnResult(sqlmock.NewResult(1, 1))
+					mock.ExpectCommit()
 					return db
 				}(),
 			},
 			args: args{
-				ctx:       context.Background(),
-				studentID: 1,
-				courseID:  101,
-				newStatus: 1,
+				ctx:           context.Background(),
+				studentID:     1,
+				courseID:      101,
+				currentStatus: StatusActive,
+				newStatus:     StatusCompleted,
 			},
 			wantErr: false,
 		},
 		{
-			name: "No Rows Affected",
+			name: "Status Changed Concurrently",
 			fields: fields{
 				DB: func() *sql.DB {
 					db, mock, err := sqlmock.New()
 					if err != nil {
 						t.Fatalf("error creating mock database: %v", err)
 					}
-					mock.ExpectExec(`UPDATE course_enrollments SET status = \?, update_time = \? WHERE student_id = \? AND course_id = \?`).
-						WithArgs(1, sqlmock.AnyArg(), int64(1), int64(101)).
-						WillReturnResult(sqlmock.NewResult(0, 0)) // No rows affected
+					mock.ExpectBegin()
+					mock.ExpectQuery(lockQuery).
+						WithArgs(int64(1), int64(101), StatusActive).
+						WillReturnError(sql.ErrNoRows)
+					mock.ExpectRollback()
 					return db
 				}(),
 			},
 			args: args{
-				ctx:       context.Background(),
-				studentID: 1,
-				courseID:  101,
-				newStatus: 1,
+				ctx:           context.Background(),
+				studentID:     1,
+				courseID:      101,
+				currentStatus: StatusActive,
+				newStatus:     StatusCompleted,
 			},
-			wantErr: true,
+			wantErrIs: ErrNoRowsAffected,
+			wantErr:   true,
 		},
 		{
 			name: "Query Error",
@@ -294,17 +363,23 @@ func TestCourseEnrollmentDB_UpdateCourseEnrollmentStatus(t *testing.T) {
 					if err != nil {
 						t.Fatalf("error creating mock database: %v", err)
 					}
-					mock.ExpectExec(`UPDATE course_enrollments SET status = \?, update_time = \? WHERE student_id = \? AND course_id = \?`).
-						WithArgs(1, sqlmock.AnyArg(), int64(1), int64(101)).
+					mock.ExpectBegin()
+					mock.ExpectQuery(lockQuery).
+						WithArgs(int64(1), int64(101), StatusActive).
+						WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10))
+					mock.ExpectExec(updateQuery).
+						WithArgs(StatusCompleted, sqlmock.AnyArg(), int64(10)).
 						WillReturnError(errors.New("update failed"))
+					mock.ExpectRollback()
 					return db
 				}(),
 			},
 			args: args{
-				ctx:       context.Background(),
-				studentID: 1,
-				courseID:  101,
-				newStatus: 1,
+				ctx:           context.Background(),
+				studentID:     1,
+				courseID:      101,
+				currentStatus: StatusActive,
+				newStatus:     StatusCompleted,
 			},
 			wantErr: true,
 		},
@@ -315,15 +390,20 @@ func TestCourseEnrollmentDB_UpdateCourseEnrollmentStatus(t *testing.T) {
 			repo := &CourseEnrollmentDB{
 				DB: tt.fields.DB,
 			}
-			err := repo.UpdateCourseEnrollmentStatus(tt.args.ctx, tt.args.studentID, tt.args.courseID, tt.args.newStatus)
+			err := repo.UpdateCourseEnrollmentStatus(tt.args.ctx, tt.args.studentID, tt.args.courseID, tt.args.currentStatus, tt.args.newStatus)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("CourseEnrollmentDB.UpdateCourseEnrollmentStatus() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("CourseEnrollmentDB.UpdateCourseEnrollmentStatus() error = %v, want %v", err, tt.wantErrIs)
 			}
 		})
 	}
 }
 
 func TestCourseEnrollmentDB_GetListClassmates(t *testing.T) {
+	const classmatesQuery = `SELECT ce.id, ce.student_id, ce.course_id, ce.section_id, ce.status, ce.create_time, ce.update_time FROM course_enrollments ce JOIN course_enrollments ce2 ON ce.section_id = ce2.section_id JOIN courses c ON c.id = ce.course_id WHERE ce2.student_id = \? AND ce.student_id != \? AND ce2.status = \?`
 
 	timestamp := time.Date(2024, time.August, 1, 0, 0, 0, 0, time.UTC)
 
@@ -333,6 +413,7 @@ func TestCourseEnrollmentDB_GetListClassmates(t *testing.T) {
 	type args struct {
 		ctx       context.Context
 		studentID int64
+		query     EnrollmentListQuery
 	}
 	tests := []struct {
 		name    string
@@ -349,11 +430,38 @@ func TestCourseEnrollmentDB_GetListClassmates(t *testing.T) {
 					if err != nil {
 						t.Fatalf("error creating mock database: %v", err)
 					}
-					rows := sqlmock.NewRows([]string{"id", "student_id", "course_id", "status", "create_time", "update_time"}).
-						AddRow(1, 101, 1001, 1, timestamp, timestamp).
-						AddRow(2, 102, 1001, 1, timestamp, timestamp)
-					mock.ExpectQuery(`SELECT ce.id, ce.student_id, ce.course_id, ce.status, ce.create_time, ce.update_time FROM course_enrollments ce JOIN course_enrollments ce2 ON ce.course_id = ce2.course_id WHERE ce2.student_id = \? AND ce.student_id != \? and ce2.status = 1 and ce.status = 1`).
-						WithArgs(int64(1), int64(1)).
+					rows := sqlmock.NewRows([]string{"id", "student_id", "course_id", "section_id", "status", "create_time", "update_time"}).
+						AddRow(1, 101, 1001, 11, 1, timestamp, timestamp).
+						AddRow(2, 102, 1001, 11, 1, timestamp, timestamp)
+					mock.ExpectQuery(classmatesQuery+` AND ce.status IN \(\?\) ORDER BY ce.create_time, ce.id LIMIT \?`).
+						WithArgs(int64(1), int64(1), StatusActive, StatusActive, 21).
+						WillReturnRows(rows)
+					return db
+				}(),
+			},
+			args: args{
+				ctx:       context.Background(),
+				studentID: 1,
+				query:     EnrollmentListQuery{Statuses: []EnrollmentStatus{StatusActive}, SortBy: SortByEnrollTime, Limit: 21},
+			},
+			want: []CourseEnrollment{
+				{ID: 1, StudentID: 101, CourseID: 1001, SectionID: 11, Status: 1, CreateTime: timestamp, UpdateTime: timestamp},
+				{ID: 2, StudentID: 102, CourseID: 1001, SectionID: 11, Status: 1, CreateTime: timestamp, UpdateTime: timestamp},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success After Name Cursor",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					rows := sqlmock.NewRows([]string{"id", "student_id", "course_id", "section_id", "status", "create_time", "update_time"}).
+						AddRow(3, 103, 1002, 11, 1, timestamp, timestamp)
+					mock.ExpectQuery(classmatesQuery+` AND ce.status IN \(\?, \?\) AND ce.create_time > \? AND \(c.name > \? OR \(c.name = \? AND ce.id > \?\)\) ORDER BY c.name, ce.id LIMIT \?`).
+						WithArgs(int64(1), int64(1), StatusActive, StatusActive, StatusWaitlisted, timestamp, "Biology", "Biology", int64(2), 11).
 						WillReturnRows(rows)
 					return db
 				}(),
@@ -361,10 +469,16 @@ func TestCourseEnrollmentDB_GetListClassmates(t *testing.T) {
 			args: args{
 				ctx:       context.Background(),
 				studentID: 1,
+				query: EnrollmentListQuery{
+					Statuses:     []EnrollmentStatus{StatusActive, StatusWaitlisted},
+					CreatedAfter: &timestamp,
+					SortBy:       SortByName,
+					After:        &EnrollmentCursor{SortBy: SortByName, CourseName: "Biology", ID: 2},
+					Limit:        11,
+				},
 			},
 			want: []CourseEnrollment{
-				{ID: 1, StudentID: 101, CourseID: 1001, Status: 1, CreateTime: timestamp, UpdateTime: timestamp},
-				{ID: 2, StudentID: 102, CourseID: 1001, Status: 1, CreateTime: timestamp, UpdateTime: timestamp},
+				{ID: 3, StudentID: 103, CourseID: 1002, SectionID: 11, Status: 1, CreateTime: timestamp, UpdateTime: timestamp},
 			},
 			wantErr: false,
 		},
@@ -376,8 +490,7 @@ func TestCourseEnrollmentDB_GetListClassmates(t *testing.T) {
 					if err != nil {
 						t.Fatalf("error creating mock database: %v", err)
 					}
-					mock.ExpectQuery(`SELECT ce.id, ce.student_id, ce.course_id, ce.status, ce.create_time, ce.update_time FROM course_enrollments ce JOIN course_enrollments ce2 ON ce.course_id = ce2.course_id WHERE ce2.student_id = \? AND ce.student_id != \? and ce2.status = 1 and ce.status = 1`).
-						WithArgs(int64(1), int64(1)).
+					mock.ExpectQuery(classmatesQuery).
 						WillReturnError(sql.ErrConnDone)
 					return db
 				}(),
@@ -385,6 +498,7 @@ func TestCourseEnrollmentDB_GetListClassmates(t *testing.T) {
 			args: args{
 				ctx:       context.Background(),
 				studentID: 1,
+				query:     EnrollmentListQuery{Statuses: []EnrollmentStatus{StatusActive}},
 			},
 			want:    nil,
 			wantErr: true,
@@ -397,10 +511,9 @@ func TestCourseEnrollmentDB_GetListClassmates(t *testing.T) {
 					if err != nil {
 						t.Fatalf("error creating mock database: %v", err)
 					}
-					rows := sqlmock.NewRows([]string{"id", "student_id", "course_id", "status", "create_time", "update_time"}).
-						AddRow("invalid", 101, 1001, 1, timestamp, timestamp)
-					mock.ExpectQuery(`SELECT ce.id, ce.student_id, ce.course_id, ce.status, ce.create_time, ce.update_time FROM course_enrollments ce JOIN course_enrollments ce2 ON ce.course_id = ce2.course_id WHERE ce2.student_id = \? AND ce.student_id != \? and ce2.status = 1 and ce.status = 1`).
-						WithArgs(int64(1), int64(1)).
+					rows := sqlmock.NewRows([]string{"id", "student_id", "course_id", "section_id", "status", "create_time", "update_time"}).
+						AddRow("invalid", 101, 1001, 11, 1, timestamp, timestamp)
+					mock.ExpectQuery(classmatesQuery).
 						WillReturnRows(rows)
 					return db
 				}(),
@@ -408,6 +521,7 @@ func TestCourseEnrollmentDB_GetListClassmates(t *testing.T) {
 			args: args{
 				ctx:       context.Background(),
 				studentID: 1,
+				query:     EnrollmentListQuery{Statuses: []EnrollmentStatus{StatusActive}},
 			},
 			want:    nil,
 			wantErr: true,
@@ -419,7 +533,7 @@ func TestCourseEnrollmentDB_GetListClassmates(t *testing.T) {
 			repo := &CourseEnrollmentDB{
 				DB: tt.fields.DB,
 			}
-			got, err := repo.GetListClassmates(tt.args.ctx, tt.args.studentID)
+			got, err := repo.GetListClassmates(tt.args.ctx, tt.args.studentID, tt.args.query)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("CourseEnrollmentDB.GetListClassmates() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -431,6 +545,77 @@ func TestCourseEnrollmentDB_GetListClassmates(t *testing.T) {
 	}
 }
 
+func TestCourseEnrollmentDB_ListEnrollmentsByStudentID(t *testing.T) {
+	const listQuery = `SELECT ce.id, ce.student_id, ce.course_id, ce.section_id, ce.status, ce.create_time, ce.update_time FROM course_enrollments ce JOIN courses c ON c.id = ce.course_id WHERE ce.student_id = \? AND ce.status IN \(\?, \?\) AND \(ce.create_time > \? OR \(ce.create_time = \? AND ce.id > \?\)\) ORDER BY ce.create_time, ce.id LIMIT \?`
+
+	timestamp := time.Date(2024, time.August, 1, 0, 0, 0, 0, time.UTC)
+	query := EnrollmentListQuery{
+		Statuses: []EnrollmentStatus{StatusActive, StatusWaitlisted},
+		SortBy:   SortByEnrollTime,
+		After:    &EnrollmentCursor{SortBy: SortByEnrollTime, CreateTime: timestamp, ID: 4},
+		Limit:    3,
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error creating mock database: %v", err)
+	}
+	defer db.Close()
+	mock.ExpectQuery(listQuery).
+		WithArgs(int64(1), StatusActive, StatusWaitlisted, timestamp, timestamp, int64(4), 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "student_id", "course_id", "section_id", "status", "create_time", "update_time"}).
+			AddRow(5, 1, 1001, 11, StatusWaitlisted, timestamp, timestamp))
+
+	repo := &CourseEnrollmentDB{DB: db}
+	got, err := repo.ListEnrollmentsByStudentID(context.Background(), 1, query)
+	if err != nil {
+		t.Fatalf("CourseEnrollmentDB.ListEnrollmentsByStudentID() error = %v", err)
+	}
+	want := []CourseEnrollment{{ID: 5, StudentID: 1, CourseID: 1001, SectionID: 11, Status: StatusWaitlisted, CreateTime: timestamp, UpdateTime: timestamp}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CourseEnrollmentDB.ListEnrollmentsByStudentID() = %v, want %v", got, want)
+	}
+}
+
+func TestCourseEnrollmentDB_GetEnrollmentsByCourseID(t *testing.T) {
+	const rosterQuery = `SELECT id, student_id, course_id, section_id, status, create_time, update_time FROM course_enrollments WHERE course_id = \? AND status IN \(\?, \?, \?\) ORDER BY create_time, id`
+
+	timestamp := time.Date(2024, time.August, 1, 0, 0, 0, 0, time.UTC)
+	statuses := []EnrollmentStatus{StatusActive, StatusWaitlisted, StatusCancelled}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error creating mock database: %v", err)
+	}
+	defer db.Close()
+	mock.ExpectQuery(rosterQuery).
+		WithArgs(int64(1001), StatusActive, StatusWaitlisted, StatusCancelled).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "student_id", "course_id", "section_id", "status", "create_time", "update_time"}).
+			AddRow(1, 101, 1001, 11, StatusActive, timestamp, timestamp).
+			AddRow(2, 102, 1001, 11, StatusCancelled, timestamp, timestamp))
+
+	repo := &CourseEnrollmentDB{DB: db}
+	got, err := repo.GetEnrollmentsByCourseID(context.Background(), 1001, statuses)
+	if err != nil {
+		t.Fatalf("CourseEnrollmentDB.GetEnrollmentsByCourseID() error = %v", err)
+	}
+	want := []CourseEnrollment{
+		{ID: 1, StudentID: 101, CourseID: 1001, SectionID: 11, Status: StatusActive, CreateTime: timestamp, UpdateTime: timestamp},
+		{ID: 2, StudentID: 102, CourseID: 1001, SectionID: 11, Status: StatusCancelled, CreateTime: timestamp, UpdateTime: timestamp},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CourseEnrollmentDB.GetEnrollmentsByCourseID() = %v, want %v", got, want)
+	}
+
+	mock.ExpectQuery(rosterQuery).WillReturnError(sql.ErrConnDone)
+	if _, err := repo.GetEnrollmentsByCourseID(context.Background(), 1001, statuses); err == nil {
+		t.Errorf("CourseEnrollmentDB.GetEnrollmentsByCourseID() expected query error")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
 func TestCourseEnrollmentDB_GetEnrollmentByStudentIDAndCourseID(t *testing.T) {
 	const (
 		studentID = 1
@@ -462,9 +647,9 @@ func TestCourseEnrollmentDB_GetEnrollmentByStudentIDAndCourseID(t *testing.T) {
 					if err != nil {
 						t.Fatalf("error creating mock database: %v", err)
 					}
-					rows := sqlmock.NewRows([]string{"id", "student_id", "course_id", "status", "create_time", "update_time"}).
-						AddRow(1, studentID, courseID, status, timestamp, timestamp)
-					mock.ExpectQuery(`SELECT id, student_id, course_id, status, create_time, update_time FROM course_enrollments WHERE student_id = \? AND course_id = \?`).
+					rows := sqlmock.NewRows([]string{"id", "student_id", "course_id", "section_id", "status", "reenroll_count", "create_time", "update_time"}).
+						AddRow(1, studentID, courseID, 11, status, 2, timestamp, timestamp)
+					mock.ExpectQuery(`SELECT id, student_id, course_id, section_id, status, reenroll_count, create_time, update_time FROM course_enrollments WHERE student_id = \? AND course_id = \?`).
 						WithArgs(studentID, courseID).
 						WillReturnRows(rows)
 					return db
@@ -477,12 +662,14 @@ func TestCourseEnrollmentDB_GetEnrollmentByStudentIDAndCourseID(t *testing.T) {
 			},
 			want: []CourseEnrollment{
 				{
-					ID:         1,
-					StudentID:  studentID,
-					CourseID:   courseID,
-					Status:     status,
-					CreateTime: timestamp,
-					UpdateTime: timestamp,
+					ID:            1,
+					StudentID:     studentID,
+					CourseID:      courseID,
+					SectionID:     11,
+					Status:        status,
+					ReEnrollCount: 2,
+					CreateTime:    timestamp,
+					UpdateTime:    timestamp,
 				},
 			},
 			wantErr: false,
@@ -495,7 +682,7 @@ func TestCourseEnrollmentDB_GetEnrollmentByStudentIDAndCourseID(t *testing.T) {
 					if err != nil {
 						t.Fatalf("error creating mock database: %v", err)
 					}
-					mock.ExpectQuery("SELECT id, student_id, course_id, status, create_time, update_time FROM course_enrollments WHERE student_id = ? AND course_id = ?").
+					mock.ExpectQuery("SELECT id, student_id, course_id, section_id, status, reenroll_count, create_time, update_time FROM course_enrollments WHERE student_id = ? AND course_id = ?").
 						WithArgs(studentID, courseID).
 						WillReturnError(errors.New("query error"))
 					return db
@@ -527,3 +714,994 @@ func TestCourseEnrollmentDB_GetEnrollmentByStudentIDAndCourseID(t *testing.T) {
 		})
 	}
 }
+
+func TestCourseEnrollmentDB_GetEnrollmentByStudentIDAndSectionID(t *testing.T) {
+	const (
+		studentID = 1
+		sectionID = 11
+		query     = `SELECT id, student_id, course_id, section_id, status, reenroll_count, create_time, update_time FROM course_enrollments WHERE student_id = \? AND section_id = \?`
+	)
+	timestamp := time.Date(2023, 8, 25, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx       context.Context
+		studentID int64
+		sectionID int64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *CourseEnrollment
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					rows := sqlmock.NewRows([]string{"id", "student_id", "course_id", "section_id", "status", "reenroll_count", "create_time", "update_time"}).
+						AddRow(1, studentID, 101, sectionID, StatusActive, 1, timestamp, timestamp)
+					mock.ExpectQuery(query).
+						WithArgs(studentID, sectionID).
+						WillReturnRows(rows)
+					return db
+				}(),
+			},
+			args: args{
+				ctx:       context.Background(),
+				studentID: studentID,
+				sectionID: sectionID,
+			},
+			want: &CourseEnrollment{
+				ID:            1,
+				StudentID:     studentID,
+				CourseID:      101,
+				SectionID:     sectionID,
+				Status:        StatusActive,
+				ReEnrollCount: 1,
+				CreateTime:    timestamp,
+				UpdateTime:    timestamp,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Not Found",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(query).
+						WithArgs(studentID, sectionID).
+						WillReturnError(sql.ErrNoRows)
+					return db
+				}(),
+			},
+			args: args{
+				ctx:       context.Background(),
+				studentID: studentID,
+				sectionID: sectionID,
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "Error",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(query).
+						WithArgs(studentID, sectionID).
+						WillReturnError(errors.New("query error"))
+					return db
+				}(),
+			},
+			args: args{
+				ctx:       context.Background(),
+				studentID: studentID,
+				sectionID: sectionID,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &CourseEnrollmentDB{
+				DB: tt.fields.DB,
+			}
+			got, err := repo.GetEnrollmentByStudentIDAndSectionID(tt.args.ctx, tt.args.studentID, tt.args.sectionID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CourseEnrollmentDB.GetEnrollmentByStudentIDAndSectionID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CourseEnrollmentDB.GetEnrollmentByStudentIDAndSectionID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCourseEnrollmentDB_LockEnrollmentByStudentIDAndSectionID(t *testing.T) {
+	const lockQuery = `SELECT id, student_id, course_id, section_id, status, reenroll_count, create_time, update_time FROM course_enrollments WHERE student_id = \? AND section_id = \? FOR UPDATE`
+
+	timestamp := time.Date(2023, 8, 25, 0, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error creating mock database: %v", err)
+	}
+	defer db.Close()
+	mock.ExpectQuery(lockQuery).
+		WithArgs(int64(1), int64(11)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "student_id", "course_id", "section_id", "status", "reenroll_count", "create_time", "update_time"}).
+			AddRow(10, 1, 101, 11, StatusWaitlisted, 0, timestamp, timestamp))
+	mock.ExpectQuery(lockQuery).
+		WithArgs(int64(2), int64(11)).
+		WillReturnError(sql.ErrNoRows)
+
+	repo := &CourseEnrollmentDB{DB: db}
+	got, err := repo.LockEnrollmentByStudentIDAndSectionID(context.Background(), 1, 11)
+	if err != nil {
+		t.Fatalf("CourseEnrollmentDB.LockEnrollmentByStudentIDAndSectionID() error = %v", err)
+	}
+	want := &CourseEnrollment{ID: 10, StudentID: 1, CourseID: 101, SectionID: 11, Status: StatusWaitlisted, CreateTime: timestamp, UpdateTime: timestamp}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CourseEnrollmentDB.LockEnrollmentByStudentIDAndSectionID() = %v, want %v", got, want)
+	}
+
+	got, err = repo.LockEnrollmentByStudentIDAndSectionID(context.Background(), 2, 11)
+	if err != nil || got != nil {
+		t.Errorf("CourseEnrollmentDB.LockEnrollmentByStudentIDAndSectionID() = %v, %v, want nil, nil", got, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %v", err)
+	}
+}
+
+func TestCourseEnrollmentDB_CountEnrollmentBySectionIDAndStatus(t *testing.T) {
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx       context.Context
+		sectionID int64
+		status    EnrollmentStatus
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(`SELECT COUNT\(\*\) FROM course_enrollments WHERE section_id = \? AND status = \?`).
+						WithArgs(int64(11), StatusActive).
+						WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+					return db
+				}(),
+			},
+			args: args{
+				ctx:       context.Background(),
+				sectionID: 11,
+				status:    StatusActive,
+			},
+			want:    3,
+			wantErr: false,
+		},
+		{
+			name: "Query Error",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(`SELECT COUNT\(\*\) FROM course_enrollments WHERE section_id = \? AND status = \?`).
+						WithArgs(int64(11), StatusActive).
+						WillReturnError(errors.New("query error"))
+					return db
+				}(),
+			},
+			args: args{
+				ctx:       context.Background(),
+				sectionID: 11,
+				status:    StatusActive,
+			},
+			want:    0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &CourseEnrollmentDB{
+				DB: tt.fields.DB,
+			}
+			got, err := repo.CountEnrollmentBySectionIDAndStatus(tt.args.ctx, tt.args.sectionID, tt.args.status)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CourseEnrollmentDB.CountEnrollmentBySectionIDAndStatus() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("CourseEnrollmentDB.CountEnrollmentBySectionIDAndStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCourseEnrollmentDB_GetWaitlistBySectionID(t *testing.T) {
+	const waitlistQuery = `SELECT id, student_id, course_id, section_id, status, create_time, update_time FROM course_enrollments WHERE section_id = \? AND status = \? ORDER BY update_time, id`
+
+	timestamp := time.Date(2023, 8, 25, 0, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error creating mock database: %v", err)
+	}
+	defer db.Close()
+	mock.ExpectQuery(waitlistQuery).
+		WithArgs(int64(11), StatusWaitlisted).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "student_id", "course_id", "section_id", "status", "create_time", "update_time"}).
+			AddRow(12, 3, 101, 11, StatusWaitlisted, timestamp, timestamp).
+			AddRow(13, 4, 101, 11, StatusWaitlisted, timestamp, timestamp.Add(time.Minute)))
+
+	repo := &CourseEnrollmentDB{DB: db}
+	got, err := repo.GetWaitlistBySectionID(context.Background(), 11)
+	if err != nil {
+		t.Fatalf("CourseEnrollmentDB.GetWaitlistBySectionID() error = %v", err)
+	}
+	want := []CourseEnrollment{
+		{ID: 12, StudentID: 3, CourseID: 101, SectionID: 11, Status: StatusWaitlisted, CreateTime: timestamp, UpdateTime: timestamp},
+		{ID: 13, StudentID: 4, CourseID: 101, SectionID: 11, Status: StatusWaitlisted, CreateTime: timestamp, UpdateTime: timestamp.Add(time.Minute)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CourseEnrollmentDB.GetWaitlistBySectionID() = %v, want %v", got, want)
+	}
+}
+
+func TestCourseEnrollmentDB_CancelEnrollment(t *testing.T) {
+	constUpdateTime := time.Date(2023, 8, 26, 0, 0, 0, 0, time.UTC)
+
+	const (
+		updateQuery  = `UPDATE course_enrollments SET status = \?, update_time = \? WHERE id = \? AND status = \?`
+		historyQuery = `INSERT INTO course_enrollment_histories`
+	)
+	activeEnrollment := CourseEnrollment{ID: 10, StudentID: 1, CourseID: 101, SectionID: 11, Status: StatusActive}
+	errUpdateFailed := errors.New("update failed")
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx        context.Context
+		enrollment CourseEnrollment
+		updateTime time.Time
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectBegin()
+					mock.ExpectExec(updateQuery).
+						WithArgs(StatusCancelled, constUpdateTime, int64(10), StatusActive).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectExec(historyQuery).
+						WithArgs(int64(10), StatusCancelled, constUpdateTime).
+						WillReturnResult(sqlmock.NewResult(1, 1))
+					mock.ExpectCommit()
+					return db
+				}(),
+			},
+			args: args{
+				ctx:        context.Background(),
+				enrollment: activeEnrollment,
+				updateTime: constUpdateTime,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Status Changed",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectBegin()
+					mock.ExpectExec(updateQuery).
+						WithArgs(StatusCancelled, constUpdateTime, int64(10), StatusActive).
+						WillReturnResult(sqlmock.NewResult(0, 0))
+					mock.ExpectRollback()
+					return db
+				}(),
+			},
+			args: args{
+				ctx:        context.Background(),
+				enrollment: activeEnrollment,
+				updateTime: constUpdateTime,
+			},
+			wantErr: ErrNoRowsAffected,
+		},
+		{
+			name: "Update Error",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectBegin()
+					mock.ExpectExec(updateQuery).
+						WithArgs(StatusCancelled, constUpdateTime, int64(10), StatusActive).
+						WillReturnError(errUpdateFailed)
+					mock.ExpectRollback()
+					return db
+				}(),
+			},
+			args: args{
+				ctx:        context.Background(),
+				enrollment: activeEnrollment,
+				updateTime: constUpdateTime,
+			},
+			wantErr: errUpdateFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &CourseEnrollmentDB{
+				DB: tt.fields.DB,
+			}
+			err := repo.CancelEnrollment(tt.args.ctx, tt.args.enrollment, tt.args.updateTime)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CourseEnrollmentDB.CancelEnrollment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCourseEnrollmentDB_PromoteEnrollment(t *testing.T) {
+	constUpdateTime := time.Date(2023, 8, 26, 0, 0, 0, 0, time.UTC)
+
+	const (
+		updateQuery  = `UPDATE course_enrollments SET status = \?, update_time = \? WHERE id = \? AND status = \?`
+		historyQuery = `INSERT INTO course_enrollment_histories`
+	)
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx          context.Context
+		enrollmentID int64
+		updateTime   time.Time
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectBegin()
+					mock.ExpectExec(updateQuery).
+						WithArgs(StatusActive, constUpdateTime, int64(11), StatusWaitlisted).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectExec(historyQuery).
+						WithArgs(int64(11), StatusActive, constUpdateTime).
+						WillReturnResult(sqlmock.NewResult(1, 1))
+					mock.ExpectCommit()
+					return db
+				}(),
+			},
+			args: args{
+				ctx:          context.Background(),
+				enrollmentID: 11,
+				updateTime:   constUpdateTime,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "No Longer Waitlisted",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectBegin()
+					mock.ExpectExec(updateQuery).
+						WithArgs(StatusActive, constUpdateTime, int64(11), StatusWaitlisted).
+						WillReturnResult(sqlmock.NewResult(0, 0))
+					mock.ExpectRollback()
+					return db
+				}(),
+			},
+			args: args{
+				ctx:          context.Background(),
+				enrollmentID: 11,
+				updateTime:   constUpdateTime,
+			},
+			wantErr: ErrNoRowsAffected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &CourseEnrollmentDB{
+				DB: tt.fields.DB,
+			}
+			err := repo.PromoteEnrollment(tt.args.ctx, tt.args.enrollmentID, tt.args.updateTime)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CourseEnrollmentDB.PromoteEnrollment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCourseEnrollmentDB_ReactivateEnrollment(t *testing.T) {
+	constUpdateTime := time.Date(2023, 8, 26, 0, 0, 0, 0, time.UTC)
+
+	const updateQuery = `UPDATE course_enrollments SET status = \?, reenroll_count = reenroll_count \+ 1, update_time = \? WHERE id = \? AND status = \?`
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx          context.Context
+		enrollmentID int64
+		status       EnrollmentStatus
+		updateTime   time.Time
+	}
+	tests := []struct {
+		name      string
+		fields    fields
+		args      args
+		wantErrIs error
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectBegin()
+					mock.ExpectExec(updateQuery).
+						WithArgs(StatusActive, constUpdateTime, int64(10), StatusCancelled).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectExec(`INSERT INTO course_enrollment_histories`).
+						WithArgs(int64(10), StatusActive, constUpdateTime).
+						WillReturnResult(sqlmock.NewResult(1, 1))
+					mock.ExpectCommit()
+					return db
+				}(),
+			},
+			args: args{
+				ctx:          context.Background(),
+				enrollmentID: 10,
+				status:       StatusActive,
+				updateTime:   constUpdateTime,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Enrollment No Longer Cancelled",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectBegin()
+					mock.ExpectExec(updateQuery).
+						WithArgs(StatusActive, constUpdateTime, int64(10), StatusCancelled).
+						WillReturnResult(sqlmock.NewResult(0, 0))
+					mock.ExpectRollback()
+					return db
+				}(),
+			},
+			args: args{
+				ctx:          context.Background(),
+				enrollmentID: 10,
+				status:       StatusActive,
+				updateTime:   constUpdateTime,
+			},
+			wantErrIs: ErrNoRowsAffected,
+			wantErr:   true,
+		},
+		{
+			name: "History Error",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectBegin()
+					mock.ExpectExec(updateQuery).
+						WithArgs(StatusWaitlisted, constUpdateTime, int64(10), StatusCancelled).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectExec(`INSERT INTO course_enrollment_histories`).
+						WithArgs(int64(10), StatusWaitlisted, constUpdateTime).
+						WillReturnError(errors.New("insert error"))
+					mock.ExpectRollback()
+					return db
+				}(),
+			},
+			args: args{
+				ctx:          context.Background(),
+				enrollmentID: 10,
+				status:       StatusWaitlisted,
+				updateTime:   constUpdateTime,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &CourseEnrollmentDB{
+				DB: tt.fields.DB,
+			}
+			err := repo.ReactivateEnrollment(tt.args.ctx, tt.args.enrollmentID, tt.args.status, tt.args.updateTime)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CourseEnrollmentDB.ReactivateEnrollment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("CourseEnrollmentDB.ReactivateEnrollment() error = %v, want %v", err, tt.wantErrIs)
+			}
+		})
+	}
+}
+
+func TestCourseEnrollmentDB_GetEnrollmentByStudentIDAndStatus(t *testing.T) {
+	const (
+		studentID int64 = 1
+		query           = `SELECT id, student_id, course_id, section_id, status, create_time, update_time FROM course_enrollments WHERE student_id = \? AND status = \?`
+	)
+	timestamp := time.Date(2024, 8, 25, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx       context.Context
+		studentID int64
+		status    EnrollmentStatus
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []CourseEnrollment
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(query).
+						WithArgs(studentID, StatusCompleted).
+						WillReturnRows(sqlmock.NewRows([]string{"id", "student_id", "course_id", "section_id", "status", "create_time", "update_time"}).
+							AddRow(1, studentID, 101, 11, StatusCompleted, timestamp, timestamp))
+					return db
+				}(),
+			},
+			args: args{
+				ctx:       context.Background(),
+				studentID: studentID,
+				status:    StatusCompleted,
+			},
+			want: []CourseEnrollment{
+				{ID: 1, StudentID: studentID, CourseID: 101, SectionID: 11, Status: StatusCompleted, CreateTime: timestamp, UpdateTime: timestamp},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Query Error",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(query).
+						WithArgs(studentID, StatusCompleted).
+						WillReturnError(sql.ErrConnDone)
+					return db
+				}(),
+			},
+			args: args{
+				ctx:       context.Background(),
+				studentID: studentID,
+				status:    StatusCompleted,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &CourseEnrollmentDB{
+				DB: tt.fields.DB,
+			}
+			got, err := repo.GetEnrollmentByStudentIDAndStatus(tt.args.ctx, tt.args.studentID, tt.args.status)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CourseEnrollmentDB.GetEnrollmentByStudentIDAndStatus() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CourseEnrollmentDB.GetEnrollmentByStudentIDAndStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCourseEnrollmentDB_RecordGrade(t *testing.T) {
+	constUpdateTime := time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC)
+
+	const updateQuery = `UPDATE course_enrollments SET status = \?, grade_letter = \?, grade_points = \?, update_time = \? WHERE id = \? AND status = \?`
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx          context.Context
+		enrollmentID int64
+		grade        Grade
+		status       EnrollmentStatus
+		updateTime   time.Time
+	}
+	tests := []struct {
+		name      string
+		fields    fields
+		args      args
+		wantErrIs error
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectBegin()
+					mock.ExpectExec(updateQuery).
+						WithArgs(StatusCompleted, "B+", 3.3, constUpdateTime, int64(10), StatusActive).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectExec(`INSERT INTO course_enrollment_histories`).
+						WithArgs(int64(10), StatusCompleted, constUpdateTime).
+						WillReturnResult(sqlmock.NewResult(1, 1))
+					mock.ExpectCommit()
+					return db
+				}(),
+			},
+			args: args{
+				ctx:          context.Background(),
+				enrollmentID: 10,
+				grade:        Grade{Letter: "B+", Points: 3.3},
+				status:       StatusCompleted,
+				updateTime:   constUpdateTime,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Enrollment No Longer Active",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectBegin()
+					mock.ExpectExec(updateQuery).
+						WithArgs(StatusFailed, "F", 0.0, constUpdateTime, int64(10), StatusActive).
+						WillReturnResult(sqlmock.NewResult(0, 0))
+					mock.ExpectRollback()
+					return db
+				}(),
+			},
+			args: args{
+				ctx:          context.Background(),
+				enrollmentID: 10,
+				grade:        Grade{Letter: "F"},
+				status:       StatusFailed,
+				updateTime:   constUpdateTime,
+			},
+			wantErrIs: ErrNoRowsAffected,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &CourseEnrollmentDB{
+				DB: tt.fields.DB,
+			}
+			err := repo.RecordGrade(tt.args.ctx, tt.args.enrollmentID, tt.args.grade, tt.args.status, tt.args.updateTime)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CourseEnrollmentDB.RecordGrade() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("CourseEnrollmentDB.RecordGrade() error = %v, want %v", err, tt.wantErrIs)
+			}
+		})
+	}
+}
+
+func TestCourseEnrollmentDB_GetGradedEnrollmentsByStudentID(t *testing.T) {
+	timestamp := time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC)
+
+	const (
+		studentID int64 = 1
+		query           = `SELECT id, student_id, course_id, section_id, status, grade_letter, grade_points, create_time, update_time FROM course_enrollments WHERE student_id = \? AND status IN \(\?, \?\) ORDER BY update_time, id`
+	)
+	columns := []string{"id", "student_id", "course_id", "section_id", "status", "grade_letter", "grade_points", "create_time", "update_time"}
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx       context.Context
+		studentID int64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []CourseEnrollment
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(query).
+						WithArgs(studentID, StatusCompleted, StatusFailed).
+						WillReturnRows(sqlmock.NewRows(columns).
+							AddRow(1, studentID, 101, 11, StatusCompleted, "A-", 3.7, timestamp, timestamp).
+							AddRow(2, studentID, 102, 12, StatusFailed, nil, nil, timestamp, timestamp))
+					return db
+				}(),
+			},
+			args: args{
+				ctx:       context.Background(),
+				studentID: studentID,
+			},
+			want: []CourseEnrollment{
+				{ID: 1, StudentID: studentID, CourseID: 101, SectionID: 11, Status: StatusCompleted, Grade: &Grade{Letter: "A-", Points: 3.7}, CreateTime: timestamp, UpdateTime: timestamp},
+				{ID: 2, StudentID: studentID, CourseID: 102, SectionID: 12, Status: StatusFailed, CreateTime: timestamp, UpdateTime: timestamp},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Query Error",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(query).
+						WithArgs(studentID, StatusCompleted, StatusFailed).
+						WillReturnError(sql.ErrConnDone)
+					return db
+				}(),
+			},
+			args: args{
+				ctx:       context.Background(),
+				studentID: studentID,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &CourseEnrollmentDB{
+				DB: tt.fields.DB,
+			}
+			got, err := repo.GetGradedEnrollmentsByStudentID(tt.args.ctx, tt.args.studentID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CourseEnrollmentDB.GetGradedEnrollmentsByStudentID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CourseEnrollmentDB.GetGradedEnrollmentsByStudentID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCourseEnrollmentDB_ExportEnrollments(t *testing.T) {
+	const (
+		selectQuery   = `SELECT ce.id, ce.student_id, s.email, ce.course_id, c.name, ce.section_id, ce.status, ce.grade_letter, ce.grade_points, ce.create_time, ce.update_time FROM course_enrollments ce JOIN students s ON s.id = ce.student_id JOIN courses c ON c.id = ce.course_id`
+		filteredQuery = selectQuery + ` WHERE ce.course_id = \? AND ce.status IN \(\?, \?\) AND ce.create_time >= \? AND ce.create_time < \? ORDER BY ce.id`
+	)
+	from := time.Date(2024, time.August, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2024, time.September, 1, 0, 0, 0, 0, time.UTC)
+	timestamp := time.Date(2024, time.August, 15, 0, 0, 0, 0, time.UTC)
+	columns := []string{"id", "student_id", "email", "course_id", "name", "section_id", "status", "grade_letter", "grade_points", "create_time", "update_time"}
+	errStop := errors.New("stop")
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		query  EnrollmentExportQuery
+		stopAt int // the row fn fails on, 0 to never fail
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []EnrollmentExportRow
+		wantErr error
+	}{
+		{
+			name: "Success With Filters",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(filteredQuery).
+						WithArgs(int64(101), StatusActive, StatusCompleted, from, before).
+						WillReturnRows(sqlmock.NewRows(columns).
+							AddRow(1, 1, "alice@example.com", 101, "Mathematics 101", 11, StatusActive, nil, nil, timestamp, timestamp).
+							AddRow(2, 2, "bob@example.com", 101, "Mathematics 101", 11, StatusCompleted, "B+", 3.3, timestamp, timestamp))
+					return db
+				}(),
+			},
+			args: args{
+				query: EnrollmentExportQuery{CourseID: 101, Statuses: []EnrollmentStatus{StatusActive, StatusCompleted}, CreatedFrom: &from, CreatedBefore: &before},
+			},
+			want: []EnrollmentExportRow{
+				{
+					Enrollment:   CourseEnrollment{ID: 1, StudentID: 1, CourseID: 101, SectionID: 11, Status: StatusActive, CreateTime: timestamp, UpdateTime: timestamp},
+					StudentEmail: "alice@example.com",
+					CourseName:   "Mathematics 101",
+				},
+				{
+					Enrollment:   CourseEnrollment{ID: 2, StudentID: 2, CourseID: 101, SectionID: 11, Status: StatusCompleted, Grade: &Grade{Letter: "B+", Points: 3.3}, CreateTime: timestamp, UpdateTime: timestamp},
+					StudentEmail: "bob@example.com",
+					CourseName:   "Mathematics 101",
+				},
+			},
+		},
+		{
+			name: "Without Filters",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(selectQuery + ` ORDER BY ce.id`).
+						WithArgs().
+						WillReturnRows(sqlmock.NewRows(columns))
+					return db
+				}(),
+			},
+			want: nil,
+		},
+		{
+			name: "Stopped By Callback",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(selectQuery).
+						WillReturnRows(sqlmock.NewRows(columns).
+							AddRow(1, 1, "alice@example.com", 101, "Mathematics 101", 11, StatusActive, nil, nil, timestamp, timestamp).
+							AddRow(2, 2, "bob@example.com", 101, "Mathematics 101", 11, StatusActive, nil, nil, timestamp, timestamp))
+					return db
+				}(),
+			},
+			args: args{stopAt: 1},
+			want: []EnrollmentExportRow{
+				{
+					Enrollment:   CourseEnrollment{ID: 1, StudentID: 1, CourseID: 101, SectionID: 11, Status: StatusActive, CreateTime: timestamp, UpdateTime: timestamp},
+					StudentEmail: "alice@example.com",
+					CourseName:   "Mathematics 101",
+				},
+			},
+			wantErr: errStop,
+		},
+		{
+			name: "Query Error",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(selectQuery).WillReturnError(sql.ErrConnDone)
+					return db
+				}(),
+			},
+			want:    nil,
+			wantErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &CourseEnrollmentDB{
+				DB: tt.fields.DB,
+			}
+			var got []EnrollmentExportRow
+			err := repo.ExportEnrollments(context.Background(), tt.args.query, func(row EnrollmentExportRow) error {
+				got = append(got, row)
+				if len(got) == tt.args.stopAt {
+					return errStop
+				}
+				return nil
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CourseEnrollmentDB.ExportEnrollments() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CourseEnrollmentDB.ExportEnrollments() rows = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,33 +2,61 @@ package courseenrollmentdomain
 
 import (
 	"context"
+	"errors"
 	"time"
 )
 
+var (
+	// ErrEnrollmentNotCancelled is returned when re-enrolling an enrollment that is still in progress.
+	ErrEnrollmentNotCancelled = errors.New("enrollment is not cancelled")
+	// ErrReEnrollmentCooldown is returned when a student rejoins a course too soon after cancelling.
+	ErrReEnrollmentCooldown = errors.New("re-enrollment cooldown has not elapsed")
+	// ErrReEnrollmentLimitReached is returned when a student has rejoined a course too many times.
+	ErrReEnrollmentLimitReached = errors.New("re-enrollment limit reached")
+	// ErrInvalidStatusTransition is returned when an enrollment may not move to the requested status.
+	ErrInvalidStatusTransition = errors.New("invalid enrollment status transition")
+	// ErrInvalidCursor is returned when a page cursor is malformed or belongs to a different sort order.
+	ErrInvalidCursor = errors.New("invalid page cursor")
+	// ErrInvalidGrade is returned when a grade letter is not on the grading scale.
+	ErrInvalidGrade = errors.New("invalid grade")
+)
+
 type CourseEnrollmentDomainItf interface {
-	CreateEnrollment(ctx context.Context, studentID, courseID int64, status int) (CourseEnrollment, error)
+	CreateEnrollment(ctx context.Context, studentID, courseID, sectionID int64, status EnrollmentStatus) (CourseEnrollment, error)
 	GetEnrollmentByStudentID(ctx context.Context, studentID int64) ([]CourseEnrollment, error)
+	ListEnrollmentsByStudentID(ctx context.Context, studentID int64, query EnrollmentListQuery) ([]CourseEnrollment, error)
 	GetEnrollmentByStudentIDAndCourseID(ctx context.Context, studentID, courseID int64) ([]CourseEnrollment, error)
-	UpdateCourseEnrollmentStatus(ctx context.Context, studentID, courseID int64, newStatus int) error
-	GetListClassmates(ctx context.Context, studentID int64) ([]CourseEnrollment, error)
+	GetEnrollmentByStudentIDAndSectionID(ctx context.Context, studentID, sectionID int64) (*CourseEnrollment, error)
+	LockEnrollmentByStudentIDAndSectionID(ctx context.Context, studentID, sectionID int64) (*CourseEnrollment, error)
+	UpdateCourseEnrollmentStatus(ctx context.Context, studentID, courseID int64, newStatus EnrollmentStatus) error
+	GetListClassmates(ctx context.Context, studentID int64, query EnrollmentListQuery) ([]CourseEnrollment, error)
+	GetEnrollmentsByCourseID(ctx context.Context, courseID int64, statuses []EnrollmentStatus) ([]CourseEnrollment, error)
+	CountEnrollmentBySectionIDAndStatus(ctx context.Context, sectionID int64, status EnrollmentStatus) (int, error)
+	GetEnrollmentByStudentIDAndStatus(ctx context.Context, studentID int64, status EnrollmentStatus) ([]CourseEnrollment, error)
+	GetWaitlistBySectionID(ctx context.Context, sectionID int64) ([]CourseEnrollment, error)
+	CancelEnrollment(ctx context.Context, enrollment CourseEnrollment) (CourseEnrollment, error)
+	PromoteEnrollment(ctx context.Context, enrollment CourseEnrollment) (CourseEnrollment, error)
+	ReEnroll(ctx context.Context, enrollment CourseEnrollment, status EnrollmentStatus) (CourseEnrollment, error)
+	RecordGrade(ctx context.Context, studentID, sectionID int64, letter string) (CourseEnrollment, error)
+	GetGradedEnrollmentsByStudentID(ctx context.Context, studentID int64) ([]CourseEnrollment, error)
+	ExportEnrollments(ctx context.Context, query EnrollmentExportQuery, fn func(EnrollmentExportRow) error) error
 }
 
 type CourseEnrollmentService struct {
-	repo CourseEnrollmentRepository
+	repo               CourseEnrollmentRepository
+	reEnrollmentPolicy ReEnrollmentPolicy
+	gradingScale       GradingScale
 }
 
-func NewCourseEnrollmentService(repo CourseEnrollmentRepository) CourseEnrollmentDomainItf {
-	return &CourseEnrollmentService{repo: repo}
+func NewCourseEnrollmentService(repo CourseEnrollmentRepository, reEnrollmentPolicy ReEnrollmentPolicy, gradingScale GradingScale) CourseEnrollmentDomainItf {
+	return &CourseEnrollmentService{repo: repo, reEnrollmentPolicy: reEnrollmentPolicy, gradingScale: gradingScale}
 }
 
-func (s *CourseEnrollmentService) CreateEnrollment(ctx context.Context, studentID, courseID int64, status int) (CourseEnrollment, error) {
-	enrollment := CourseEnrollment{
-		StudentID:  studentID,
-		CourseID:   courseID,
-		Status:     status,
-		CreateTime: time.Now(),
-		UpdateTime: time.Now(),
-	}
+// CreateEnrollment enrolls the student in a section of the course.
+func (s *CourseEnrollmentService) CreateEnrollment(ctx context.Context, studentID, courseID, sectionID int64, status EnrollmentStatus) (CourseEnrollment, error) {
+	enrollment := NewCourseEnrollment(studentID, courseID, sectionID, status)
+	enrollment.CreateTime = time.Now()
+	enrollment.UpdateTime = enrollment.CreateTime
 
 	return s.repo.CreateEnrollment(ctx, enrollment)
 }
@@ -37,15 +65,204 @@ func (s *CourseEnrollmentService) GetEnrollmentByStudentID(ctx context.Context,
 	return s.repo.GetEnrollmentByStudentID(ctx, studentID)
 }
 
-func (s *CourseEnrollmentService) UpdateCourseEnrollmentStatus(ctx context.Context, studentID, courseID int64, newStatus int) error {
-	return s.repo.UpdateCourseEnrollmentStatus(ctx, studentID, courseID, newStatus)
+// ListEnrollmentsByStudentID retrieves a page of the student's enrollments.
+// Without a status filter it lists active and waitlisted enrollments.
+func (s *CourseEnrollmentService) ListEnrollmentsByStudentID(ctx context.Context, studentID int64, query EnrollmentListQuery) ([]CourseEnrollment, error) {
+	query, err := normalizeListQuery(query, StatusActive, StatusWaitlisted)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListEnrollmentsByStudentID(ctx, studentID, query)
+}
+
+// UpdateCourseEnrollmentStatus moves the most recent enrollment of a student in a course to newStatus.
+// Returns ErrInvalidStatusTransition if the current status may not move to newStatus.
+func (s *CourseEnrollmentService) UpdateCourseEnrollmentStatus(ctx context.Context, studentID, courseID int64, newStatus EnrollmentStatus) error {
+	enrollments, err := s.repo.GetEnrollmentByStudentIDAndCourseID(ctx, studentID, courseID)
+	if err != nil {
+		return err
+	}
+	if len(enrollments) == 0 {
+		return ErrNoRowsAffected
+	}
+
+	currentStatus := enrollments[0].Status
+	if !currentStatus.CanTransitionTo(newStatus) {
+		return ErrInvalidStatusTransition
+	}
+
+	return s.repo.UpdateCourseEnrollmentStatus(ctx, studentID, courseID, currentStatus, newStatus)
 }
 
-func (s *CourseEnrollmentService) GetListClassmates(ctx context.Context, studentID int64) ([]CourseEnrollment, error) {
-	return s.repo.GetListClassmates(ctx, studentID)
+// GetListClassmates retrieves a page of the enrollments of other students in the courses the
+// student is active in. Without a status filter it lists active classmates.
+func (s *CourseEnrollmentService) GetListClassmates(ctx context.Context, studentID int64, query EnrollmentListQuery) ([]CourseEnrollment, error) {
+	query, err := normalizeListQuery(query, StatusActive)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetListClassmates(ctx, studentID, query)
 }
 
 // GetEnrollmentByStudentIDAndCourseID retrieves course enrollments for a student and course.
 func (service *CourseEnrollmentService) GetEnrollmentByStudentIDAndCourseID(ctx context.Context, studentID, courseID int64) ([]CourseEnrollment, error) {
 	return service.repo.GetEnrollmentByStudentIDAndCourseID(ctx, studentID, courseID)
 }
+
+// GetEnrollmentByStudentIDAndSectionID retrieves the enrollment of a student in a section, nil if there is none.
+func (s *CourseEnrollmentService) GetEnrollmentByStudentIDAndSectionID(ctx context.Context, studentID, sectionID int64) (*CourseEnrollment, error) {
+	return s.repo.GetEnrollmentByStudentIDAndSectionID(ctx, studentID, sectionID)
+}
+
+// LockEnrollmentByStudentIDAndSectionID retrieves and locks the enrollment of a student in a section
+// until the surrounding unit of work ends, nil if there is none.
+func (s *CourseEnrollmentService) LockEnrollmentByStudentIDAndSectionID(ctx context.Context, studentID, sectionID int64) (*CourseEnrollment, error) {
+	return s.repo.LockEnrollmentByStudentIDAndSectionID(ctx, studentID, sectionID)
+}
+
+// GetEnrollmentsByCourseID retrieves the enrollments of a course in any of the given statuses.
+func (s *CourseEnrollmentService) GetEnrollmentsByCourseID(ctx context.Context, courseID int64, statuses []EnrollmentStatus) ([]CourseEnrollment, error) {
+	return s.repo.GetEnrollmentsByCourseID(ctx, courseID, statuses)
+}
+
+// CountEnrollmentBySectionIDAndStatus counts the enrollments of a section in the given status.
+func (s *CourseEnrollmentService) CountEnrollmentBySectionIDAndStatus(ctx context.Context, sectionID int64, status EnrollmentStatus) (int, error) {
+	return s.repo.CountEnrollmentBySectionIDAndStatus(ctx, sectionID, status)
+}
+
+// GetEnrollmentByStudentIDAndStatus retrieves the enrollments of a student in the given status.
+func (s *CourseEnrollmentService) GetEnrollmentByStudentIDAndStatus(ctx context.Context, studentID int64, status EnrollmentStatus) ([]CourseEnrollment, error) {
+	return s.repo.GetEnrollmentByStudentIDAndStatus(ctx, studentID, status)
+}
+
+// GetWaitlistBySectionID retrieves the waitlisted enrollments of a section, the longest waiting first.
+func (s *CourseEnrollmentService) GetWaitlistBySectionID(ctx context.Context, sectionID int64) ([]CourseEnrollment, error) {
+	return s.repo.GetWaitlistBySectionID(ctx, sectionID)
+}
+
+// CancelEnrollment cancels the enrollment, provided it is still in the status it was read with.
+// Returns ErrInvalidStatusTransition if the enrollment can no longer be cancelled.
+func (s *CourseEnrollmentService) CancelEnrollment(ctx context.Context, enrollment CourseEnrollment) (CourseEnrollment, error) {
+	if !enrollment.Status.CanTransitionTo(StatusCancelled) {
+		return CourseEnrollment{}, ErrInvalidStatusTransition
+	}
+
+	now := time.Now()
+	if err := s.repo.CancelEnrollment(ctx, enrollment, now); err != nil {
+		return CourseEnrollment{}, err
+	}
+
+	enrollment.Status = StatusCancelled
+	enrollment.UpdateTime = now
+	return enrollment, nil
+}
+
+// PromoteEnrollment gives a waitlisted enrollment the seat freed in its section.
+// Returns ErrInvalidStatusTransition unless the enrollment is waitlisted.
+func (s *CourseEnrollmentService) PromoteEnrollment(ctx context.Context, enrollment CourseEnrollment) (CourseEnrollment, error) {
+	if enrollment.Status != StatusWaitlisted {
+		return CourseEnrollment{}, ErrInvalidStatusTransition
+	}
+
+	now := time.Now()
+	if err := s.repo.PromoteEnrollment(ctx, enrollment.ID, now); err != nil {
+		return CourseEnrollment{}, err
+	}
+
+	enrollment.Status = StatusActive
+	enrollment.UpdateTime = now
+	return enrollment, nil
+}
+
+// ReEnroll reactivates a cancelled enrollment with the given status, subject to the re-enrollment policy.
+func (s *CourseEnrollmentService) ReEnroll(ctx context.Context, enrollment CourseEnrollment, status EnrollmentStatus) (CourseEnrollment, error) {
+	if enrollment.Status != StatusCancelled {
+		return CourseEnrollment{}, ErrEnrollmentNotCancelled
+	}
+	if !enrollment.Status.CanTransitionTo(status) {
+		return CourseEnrollment{}, ErrInvalidStatusTransition
+	}
+
+	now := time.Now()
+	if err := s.reEnrollmentPolicy.Check(enrollment, now); err != nil {
+		return CourseEnrollment{}, err
+	}
+
+	if err := s.repo.ReactivateEnrollment(ctx, enrollment.ID, status, now); err != nil {
+		return CourseEnrollment{}, err
+	}
+
+	enrollment.Status = status
+	enrollment.ReEnrollCount++
+	enrollment.UpdateTime = now
+	return enrollment, nil
+}
+
+// RecordGrade grades the enrollment of the student in the section. A passing grade
+// completes the enrollment and a failing one fails it.
+// Returns ErrInvalidGrade if the letter is not on the grading scale, ErrNoRowsAffected if the
+// student has no enrollment in the section and ErrInvalidStatusTransition unless it is active.
+func (s *CourseEnrollmentService) RecordGrade(ctx context.Context, studentID, sectionID int64, letter string) (CourseEnrollment, error) {
+	grade, err := s.gradingScale.Grade(letter)
+	if err != nil {
+		return CourseEnrollment{}, err
+	}
+
+	found, err := s.repo.GetEnrollmentByStudentIDAndSectionID(ctx, studentID, sectionID)
+	if err != nil {
+		return CourseEnrollment{}, err
+	}
+	if found == nil {
+		return CourseEnrollment{}, ErrNoRowsAffected
+	}
+
+	status := StatusFailed
+	if s.gradingScale.IsPassing(grade) {
+		status = StatusCompleted
+	}
+
+	enrollment := *found
+	if !enrollment.Status.CanTransitionTo(status) {
+		return CourseEnrollment{}, ErrInvalidStatusTransition
+	}
+
+	now := time.Now()
+	if err := s.repo.RecordGrade(ctx, enrollment.ID, grade, status, now); err != nil {
+		return CourseEnrollment{}, err
+	}
+
+	enrollment.Status = status
+	enrollment.Grade = &grade
+	enrollment.UpdateTime = now
+	return enrollment, nil
+}
+
+// GetGradedEnrollmentsByStudentID retrieves the completed and failed enrollments of a student
+// with their grades, in the order they were graded.
+func (s *CourseEnrollmentService) GetGradedEnrollmentsByStudentID(ctx context.Context, studentID int64) ([]CourseEnrollment, error) {
+	return s.repo.GetGradedEnrollmentsByStudentID(ctx, studentID)
+}
+
+// ExportEnrollments passes the enrollments matching the export query to fn one at a time, the
+// earliest created first, without loading them all into memory.
+func (s *CourseEnrollmentService) ExportEnrollments(ctx context.Context, query EnrollmentExportQuery, fn func(EnrollmentExportRow) error) error {
+	return s.repo.ExportEnrollments(ctx, query, fn)
+}
+
+// normalizeListQuery fills in the default statuses and sort order of a list query.
+// Returns ErrInvalidCursor if the cursor was issued for a different sort order.
+func normalizeListQuery(query EnrollmentListQuery, defaultStatuses ...EnrollmentStatus) (EnrollmentListQuery, error) {
+	if len(query.Statuses) == 0 {
+		query.Statuses = defaultStatuses
+	}
+	if query.SortBy == "" {
+		query.SortBy = SortByEnrollTime
+	}
+	if query.After != nil && query.After.SortBy != query.SortBy {
+		return EnrollmentListQuery{}, ErrInvalidCursor
+	}
+
+	return query, nil
+}
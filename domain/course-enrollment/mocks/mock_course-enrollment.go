@@ -35,19 +35,63 @@ func (m *MockCourseEnrollmentDomainItf) EXPECT() *MockCourseEnrollmentDomainItfM
 	return m.recorder
 }
 
+// CancelEnrollment mocks base method.
+func (m *MockCourseEnrollmentDomainItf) CancelEnrollment(ctx context.Context, enrollment courseenrollmentdomain.CourseEnrollment) (courseenrollmentdomain.CourseEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelEnrollment", ctx, enrollment)
+	ret0, _ := ret[0].(courseenrollmentdomain.CourseEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelEnrollment indicates an expected call of CancelEnrollment.
+func (mr *MockCourseEnrollmentDomainItfMockRecorder) CancelEnrollment(ctx, enrollment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelEnrollment", reflect.TypeOf((*MockCourseEnrollmentDomainItf)(nil).CancelEnrollment), ctx, enrollment)
+}
+
+// CountEnrollmentBySectionIDAndStatus mocks base method.
+func (m *MockCourseEnrollmentDomainItf) CountEnrollmentBySectionIDAndStatus(ctx context.Context, sectionID int64, status courseenrollmentdomain.EnrollmentStatus) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountEnrollmentBySectionIDAndStatus", ctx, sectionID, status)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountEnrollmentBySectionIDAndStatus indicates an expected call of CountEnrollmentBySectionIDAndStatus.
+func (mr *MockCourseEnrollmentDomainItfMockRecorder) CountEnrollmentBySectionIDAndStatus(ctx, sectionID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountEnrollmentBySectionIDAndStatus", reflect.TypeOf((*MockCourseEnrollmentDomainItf)(nil).CountEnrollmentBySectionIDAndStatus), ctx, sectionID, status)
+}
+
 // CreateEnrollment mocks base method.
-func (m *MockCourseEnrollmentDomainItf) CreateEnrollment(ctx context.Context, studentID, courseID int64, status int) (courseenrollmentdomain.CourseEnrollment, error) {
+func (m *MockCourseEnrollmentDomainItf) CreateEnrollment(ctx context.Context, studentID, courseID, sectionID int64, status courseenrollmentdomain.EnrollmentStatus) (courseenrollmentdomain.CourseEnrollment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateEnrollment", ctx, studentID, courseID, status)
+	ret := m.ctrl.Call(m, "CreateEnrollment", ctx, studentID, courseID, sectionID, status)
 	ret0, _ := ret[0].(courseenrollmentdomain.CourseEnrollment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateEnrollment indicates an expected call of CreateEnrollment.
-func (mr *MockCourseEnrollmentDomainItfMockRecorder) CreateEnrollment(ctx, studentID, courseID, status interface{}) *gomock.Call {
+func (mr *MockCourseEnrollmentDomainItfMockRecorder) CreateEnrollment(ctx, studentID, courseID, sectionID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEnrollment", reflect.TypeOf((*MockCourseEnrollmentDomainItf)(nil).CreateEnrollment), ctx, studentID, courseID, sectionID, status)
+}
+
+// ExportEnrollments mocks base method.
+func (m *MockCourseEnrollmentDomainItf) ExportEnrollments(ctx context.Context, query courseenrollmentdomain.EnrollmentExportQuery, fn func(courseenrollmentdomain.EnrollmentExportRow) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportEnrollments", ctx, query, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportEnrollments indicates an expected call of ExportEnrollments.
+func (mr *MockCourseEnrollmentDomainItfMockRecorder) ExportEnrollments(ctx, query, fn interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEnrollment", reflect.TypeOf((*MockCourseEnrollmentDomainItf)(nil).CreateEnrollment), ctx, studentID, courseID, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportEnrollments", reflect.TypeOf((*MockCourseEnrollmentDomainItf)(nil).ExportEnrollments), ctx, query, fn)
 }
 
 // GetEnrollmentByStudentID mocks base method.
@@ -80,23 +124,173 @@ func (mr *MockCourseEnrollmentDomainItfMockRecorder) GetEnrollmentByStudentIDAnd
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnrollmentByStudentIDAndCourseID", reflect.TypeOf((*MockCourseEnrollmentDomainItf)(nil).GetEnrollmentByStudentIDAndCourseID), ctx, studentID, courseID)
 }
 
+// GetEnrollmentByStudentIDAndSectionID mocks base method.
+func (m *MockCourseEnrollmentDomainItf) GetEnrollmentByStudentIDAndSectionID(ctx context.Context, studentID, sectionID int64) (*courseenrollmentdomain.CourseEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnrollmentByStudentIDAndSectionID", ctx, studentID, sectionID)
+	ret0, _ := ret[0].(*courseenrollmentdomain.CourseEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnrollmentByStudentIDAndSectionID indicates an expected call of GetEnrollmentByStudentIDAndSectionID.
+func (mr *MockCourseEnrollmentDomainItfMockRecorder) GetEnrollmentByStudentIDAndSectionID(ctx, studentID, sectionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnrollmentByStudentIDAndSectionID", reflect.TypeOf((*MockCourseEnrollmentDomainItf)(nil).GetEnrollmentByStudentIDAndSectionID), ctx, studentID, sectionID)
+}
+
+// GetEnrollmentByStudentIDAndStatus mocks base method.
+func (m *MockCourseEnrollmentDomainItf) GetEnrollmentByStudentIDAndStatus(ctx context.Context, studentID int64, status courseenrollmentdomain.EnrollmentStatus) ([]courseenrollmentdomain.CourseEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnrollmentByStudentIDAndStatus", ctx, studentID, status)
+	ret0, _ := ret[0].([]courseenrollmentdomain.CourseEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnrollmentByStudentIDAndStatus indicates an expected call of GetEnrollmentByStudentIDAndStatus.
+func (mr *MockCourseEnrollmentDomainItfMockRecorder) GetEnrollmentByStudentIDAndStatus(ctx, studentID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnrollmentByStudentIDAndStatus", reflect.TypeOf((*MockCourseEnrollmentDomainItf)(nil).GetEnrollmentByStudentIDAndStatus), ctx, studentID, status)
+}
+
+// GetEnrollmentsByCourseID mocks base method.
+func (m *MockCourseEnrollmentDomainItf) GetEnrollmentsByCourseID(ctx context.Context, courseID int64, statuses []courseenrollmentdomain.EnrollmentStatus) ([]courseenrollmentdomain.CourseEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnrollmentsByCourseID", ctx, courseID, statuses)
+	ret0, _ := ret[0].([]courseenrollmentdomain.CourseEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnrollmentsByCourseID indicates an expected call of GetEnrollmentsByCourseID.
+func (mr *MockCourseEnrollmentDomainItfMockRecorder) GetEnrollmentsByCourseID(ctx, courseID, statuses interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnrollmentsByCourseID", reflect.TypeOf((*MockCourseEnrollmentDomainItf)(nil).GetEnrollmentsByCourseID), ctx, courseID, statuses)
+}
+
+// GetGradedEnrollmentsByStudentID mocks base method.
+func (m *MockCourseEnrollmentDomainItf) GetGradedEnrollmentsByStudentID(ctx context.Context, studentID int64) ([]courseenrollmentdomain.CourseEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGradedEnrollmentsByStudentID", ctx, studentID)
+	ret0, _ := ret[0].([]courseenrollmentdomain.CourseEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGradedEnrollmentsByStudentID indicates an expected call of GetGradedEnrollmentsByStudentID.
+func (mr *MockCourseEnrollmentDomainItfMockRecorder) GetGradedEnrollmentsByStudentID(ctx, studentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGradedEnrollmentsByStudentID", reflect.TypeOf((*MockCourseEnrollmentDomainItf)(nil).GetGradedEnrollmentsByStudentID), ctx, studentID)
+}
+
 // GetListClassmates mocks base method.
-func (m *MockCourseEnrollmentDomainItf) GetListClassmates(ctx context.Context, studentID int64) ([]courseenrollmentdomain.CourseEnrollment, error) {
+func (m *MockCourseEnrollmentDomainItf) GetListClassmates(ctx context.Context, studentID int64, query courseenrollmentdomain.EnrollmentListQuery) ([]courseenrollmentdomain.CourseEnrollment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetListClassmates", ctx, studentID)
+	ret := m.ctrl.Call(m, "GetListClassmates", ctx, studentID, query)
 	ret0, _ := ret[0].([]courseenrollmentdomain.CourseEnrollment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetListClassmates indicates an expected call of GetListClassmates.
-func (mr *MockCourseEnrollmentDomainItfMockRecorder) GetListClassmates(ctx, studentID interface{}) *gomock.Call {
+func (mr *MockCourseEnrollmentDomainItfMockRecorder) GetListClassmates(ctx, studentID, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListClassmates", reflect.TypeOf((*MockCourseEnrollmentDomainItf)(nil).GetListClassmates), ctx, studentID, query)
+}
+
+// GetWaitlistBySectionID mocks base method.
+func (m *MockCourseEnrollmentDomainItf) GetWaitlistBySectionID(ctx context.Context, sectionID int64) ([]courseenrollmentdomain.CourseEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWaitlistBySectionID", ctx, sectionID)
+	ret0, _ := ret[0].([]courseenrollmentdomain.CourseEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWaitlistBySectionID indicates an expected call of GetWaitlistBySectionID.
+func (mr *MockCourseEnrollmentDomainItfMockRecorder) GetWaitlistBySectionID(ctx, sectionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitlistBySectionID", reflect.TypeOf((*MockCourseEnrollmentDomainItf)(nil).GetWaitlistBySectionID), ctx, sectionID)
+}
+
+// ListEnrollmentsByStudentID mocks base method.
+func (m *MockCourseEnrollmentDomainItf) ListEnrollmentsByStudentID(ctx context.Context, studentID int64, query courseenrollmentdomain.EnrollmentListQuery) ([]courseenrollmentdomain.CourseEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnrollmentsByStudentID", ctx, studentID, query)
+	ret0, _ := ret[0].([]courseenrollmentdomain.CourseEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnrollmentsByStudentID indicates an expected call of ListEnrollmentsByStudentID.
+func (mr *MockCourseEnrollmentDomainItfMockRecorder) ListEnrollmentsByStudentID(ctx, studentID, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnrollmentsByStudentID", reflect.TypeOf((*MockCourseEnrollmentDomainItf)(nil).ListEnrollmentsByStudentID), ctx, studentID, query)
+}
+
+// LockEnrollmentByStudentIDAndSectionID mocks base method.
+func (m *MockCourseEnrollmentDomainItf) LockEnrollmentByStudentIDAndSectionID(ctx context.Context, studentID, sectionID int64) (*courseenrollmentdomain.CourseEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockEnrollmentByStudentIDAndSectionID", ctx, studentID, sectionID)
+	ret0, _ := ret[0].(*courseenrollmentdomain.CourseEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockEnrollmentByStudentIDAndSectionID indicates an expected call of LockEnrollmentByStudentIDAndSectionID.
+func (mr *MockCourseEnrollmentDomainItfMockRecorder) LockEnrollmentByStudentIDAndSectionID(ctx, studentID, sectionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockEnrollmentByStudentIDAndSectionID", reflect.TypeOf((*MockCourseEnrollmentDomainItf)(nil).LockEnrollmentByStudentIDAndSectionID), ctx, studentID, sectionID)
+}
+
+// PromoteEnrollment mocks base method.
+func (m *MockCourseEnrollmentDomainItf) PromoteEnrollment(ctx context.Context, enrollment courseenrollmentdomain.CourseEnrollment) (courseenrollmentdomain.CourseEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteEnrollment", ctx, enrollment)
+	ret0, _ := ret[0].(courseenrollmentdomain.CourseEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromoteEnrollment indicates an expected call of PromoteEnrollment.
+func (mr *MockCourseEnrollmentDomainItfMockRecorder) PromoteEnrollment(ctx, enrollment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteEnrollment", reflect.TypeOf((*MockCourseEnrollmentDomainItf)(nil).PromoteEnrollment), ctx, enrollment)
+}
+
+// ReEnroll mocks base method.
+func (m *MockCourseEnrollmentDomainItf) ReEnroll(ctx context.Context, enrollment courseenrollmentdomain.CourseEnrollment, status courseenrollmentdomain.EnrollmentStatus) (courseenrollmentdomain.CourseEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReEnroll", ctx, enrollment, status)
+	ret0, _ := ret[0].(courseenrollmentdomain.CourseEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReEnroll indicates an expected call of ReEnroll.
+func (mr *MockCourseEnrollmentDomainItfMockRecorder) ReEnroll(ctx, enrollment, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReEnroll", reflect.TypeOf((*MockCourseEnrollmentDomainItf)(nil).ReEnroll), ctx, enrollment, status)
+}
+
+// RecordGrade mocks base method.
+func (m *MockCourseEnrollmentDomainItf) RecordGrade(ctx context.Context, studentID, sectionID int64, letter string) (courseenrollmentdomain.CourseEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordGrade", ctx, studentID, sectionID, letter)
+	ret0, _ := ret[0].(courseenrollmentdomain.CourseEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordGrade indicates an expected call of RecordGrade.
+func (mr *MockCourseEnrollmentDomainItfMockRecorder) RecordGrade(ctx, studentID, sectionID, letter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListClassmates", reflect.TypeOf((*MockCourseEnrollmentDomainItf)(nil).GetListClassmates), ctx, studentID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordGrade", reflect.TypeOf((*MockCourseEnrollmentDomainItf)(nil).RecordGrade), ctx, studentID, sectionID, letter)
 }
 
 // UpdateCourseEnrollmentStatus mocks base method.
-func (m *MockCourseEnrollmentDomainItf) UpdateCourseEnrollmentStatus(ctx context.Context, studentID, courseID int64, newStatus int) error {
+func (m *MockCourseEnrollmentDomainItf) UpdateCourseEnrollmentStatus(ctx context.Context, studentID, courseID int64, newStatus courseenrollmentdomain.EnrollmentStatus) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateCourseEnrollmentStatus", ctx, studentID, courseID, newStatus)
 	ret0, _ := ret[0].(error)
@@ -1,20 +1,248 @@
 package courseenrollmentdomain
 
-import "time"
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"strings"
+	"time"
+)
 
 type CourseEnrollment struct {
-	ID         int64
-	StudentID  int64
-	CourseID   int64
-	Status     int
-	CreateTime time.Time
-	UpdateTime time.Time
+	ID            int64
+	StudentID     int64
+	CourseID      int64
+	SectionID     int64
+	Status        EnrollmentStatus
+	ReEnrollCount int
+	Grade         *Grade // nil until the enrollment is graded
+	CreateTime    time.Time
+	UpdateTime    time.Time
 }
 
-func NewCourseEnrollment(studentID, courseID int64, status int) CourseEnrollment {
+func NewCourseEnrollment(studentID, courseID, sectionID int64, status EnrollmentStatus) CourseEnrollment {
 	return CourseEnrollment{
 		StudentID: studentID,
 		CourseID:  courseID,
+		SectionID: sectionID,
 		Status:    status,
 	}
 }
+
+// CourseEnrollmentHistory records a status an enrollment entered, one row per
+// activation, waitlisting or cancellation.
+type CourseEnrollmentHistory struct {
+	ID                 int64
+	CourseEnrollmentID int64
+	Status             EnrollmentStatus
+	CreateTime         time.Time
+}
+
+// ReEnrollmentPolicy limits how a student may rejoin a course after cancelling.
+type ReEnrollmentPolicy struct {
+	// Cooldown is the minimum time between a cancellation and the next re-enrollment, 0 for none.
+	Cooldown time.Duration
+	// MaxReEnrollments is the number of times a student may rejoin the same course, 0 for unlimited.
+	MaxReEnrollments int
+}
+
+// Check reports whether the cancelled enrollment may be reactivated at the given time.
+func (p ReEnrollmentPolicy) Check(enrollment CourseEnrollment, now time.Time) error {
+	if p.MaxReEnrollments > 0 && enrollment.ReEnrollCount >= p.MaxReEnrollments {
+		return ErrReEnrollmentLimitReached
+	}
+	if p.Cooldown > 0 && now.Sub(enrollment.UpdateTime) < p.Cooldown {
+		return ErrReEnrollmentCooldown
+	}
+
+	return nil
+}
+
+// CreditLoadPolicy bounds the credit hours a student carries in one term, counted over the
+// student's active enrollments of that term.
+type CreditLoadPolicy struct {
+	// MaxCredits is the most credit hours a student may carry in a term, 0 for unlimited.
+	MaxCredits int
+	// MinFullTimeCredits is the fewest credit hours of a full-time student, 0 to not track full-time status.
+	MinFullTimeCredits int
+}
+
+// IsZero reports whether the policy sets neither limit.
+func (p CreditLoadPolicy) IsZero() bool {
+	return p.MaxCredits == 0 && p.MinFullTimeCredits == 0
+}
+
+// Allows reports whether a student carrying credits in a term may take a course worth additional credits.
+func (p CreditLoadPolicy) Allows(credits, additional int) bool {
+	return p.MaxCredits == 0 || credits+additional <= p.MaxCredits
+}
+
+// Remaining returns the credit hours a student carrying credits may still take in the term.
+// The second result is false when the load is unlimited.
+func (p CreditLoadPolicy) Remaining(credits int) (int, bool) {
+	if p.MaxCredits == 0 {
+		return 0, false
+	}
+	if credits >= p.MaxCredits {
+		return 0, true
+	}
+
+	return p.MaxCredits - credits, true
+}
+
+// IsFullTime reports whether a student carrying credits in a term studies full time.
+func (p CreditLoadPolicy) IsFullTime(credits int) bool {
+	return p.MinFullTimeCredits > 0 && credits >= p.MinFullTimeCredits
+}
+
+// Grade is the final grade of a completed or failed enrollment.
+type Grade struct {
+	Letter string
+	Points float64
+}
+
+// GradingScale maps grade letters to grade points.
+type GradingScale struct {
+	// Points holds the grade points of every accepted letter.
+	Points map[string]float64
+	// MinPassingPoints is the lowest grade that completes a course, lower grades fail it.
+	MinPassingPoints float64
+}
+
+// DefaultGradingScale returns the usual 4.0 scale, passing from D upwards.
+func DefaultGradingScale() GradingScale {
+	return GradingScale{
+		Points: map[string]float64{
+			"A": 4.0, "A-": 3.7,
+			"B+": 3.3, "B": 3.0, "B-": 2.7,
+			"C+": 2.3, "C": 2.0, "C-": 1.7,
+			"D+": 1.3, "D": 1.0,
+			"F": 0,
+		},
+		MinPassingPoints: 1.0,
+	}
+}
+
+// NewGradingScale returns the scale of the given letters and their grade points, or the default
+// letters when points is empty. Letters are upper-cased, as grades are looked up regardless of case.
+func NewGradingScale(points map[string]float64, minPassingPoints float64) GradingScale {
+	scale := DefaultGradingScale()
+	scale.MinPassingPoints = minPassingPoints
+	if len(points) == 0 {
+		return scale
+	}
+
+	scale.Points = make(map[string]float64, len(points))
+	for letter, letterPoints := range points {
+		scale.Points[strings.ToUpper(strings.TrimSpace(letter))] = letterPoints
+	}
+
+	return scale
+}
+
+// Grade looks up a letter on the scale, ignoring case and surrounding spaces.
+// Returns ErrInvalidGrade if the letter is not on the scale.
+func (s GradingScale) Grade(letter string) (Grade, error) {
+	letter = strings.ToUpper(strings.TrimSpace(letter))
+	points, ok := s.Points[letter]
+	if !ok {
+		return Grade{}, ErrInvalidGrade
+	}
+
+	return Grade{Letter: letter, Points: points}, nil
+}
+
+// IsPassing reports whether the grade completes the course.
+func (s GradingScale) IsPassing(grade Grade) bool {
+	return grade.Points >= s.MinPassingPoints
+}
+
+// GradePointAverage returns the credit weighted average of the grade points of the enrollments,
+// rounded to two decimals. creditHours maps a course ID to its credit hours; ungraded enrollments
+// and courses without credit hours are left out. Returns 0 when nothing counts.
+func GradePointAverage(enrollments []CourseEnrollment, creditHours map[int64]int) float64 {
+	var points float64
+	var credits int
+	for _, enrollment := range enrollments {
+		hours := creditHours[enrollment.CourseID]
+		if enrollment.Grade == nil || hours <= 0 {
+			continue
+		}
+		points += enrollment.Grade.Points * float64(hours)
+		credits += hours
+	}
+	if credits == 0 {
+		return 0
+	}
+
+	return math.Round(points/float64(credits)*100) / 100
+}
+
+// EnrollmentListQuery filters, orders and pages a list of enrollments.
+type EnrollmentListQuery struct {
+	Statuses     []EnrollmentStatus // empty means the method's default statuses
+	TermID       int64              // 0 means every term
+	CreatedAfter *time.Time
+	SortBy       EnrollmentSortField // empty means SortByEnrollTime
+	After        *EnrollmentCursor   // nil starts from the first page
+	Limit        int
+}
+
+// EnrollmentExportQuery filters the enrollments of an export.
+type EnrollmentExportQuery struct {
+	CourseID      int64              // 0 means every course
+	Statuses      []EnrollmentStatus // empty means every status
+	CreatedFrom   *time.Time         // only enrollments created at or after this time
+	CreatedBefore *time.Time         // only enrollments created before this time
+}
+
+// EnrollmentExportRow is an exported enrollment joined with the email of its student and the name of its course.
+type EnrollmentExportRow struct {
+	Enrollment   CourseEnrollment
+	StudentEmail string
+	CourseName   string
+}
+
+// EnrollmentCursor marks the last enrollment of a page. The next page starts right after it
+// in the order given by SortBy, with the enrollment ID breaking ties.
+type EnrollmentCursor struct {
+	SortBy     EnrollmentSortField `json:"s"`
+	CourseName string              `json:"n,omitempty"`
+	CreateTime time.Time           `json:"t"`
+	ID         int64               `json:"i"`
+}
+
+// NewEnrollmentCursor returns the cursor positioned at the given enrollment of a course.
+func NewEnrollmentCursor(sortBy EnrollmentSortField, courseName string, enrollment CourseEnrollment) EnrollmentCursor {
+	cursor := EnrollmentCursor{SortBy: sortBy, ID: enrollment.ID}
+	switch sortBy {
+	case SortByName:
+		cursor.CourseName = courseName
+	default:
+		cursor.CreateTime = enrollment.CreateTime
+	}
+
+	return cursor
+}
+
+// Encode returns the cursor as an opaque URL-safe token.
+func (c EnrollmentCursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeEnrollmentCursor parses a token returned by EnrollmentCursor.Encode.
+// Returns ErrInvalidCursor if the token is malformed.
+func DecodeEnrollmentCursor(token string) (EnrollmentCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return EnrollmentCursor{}, ErrInvalidCursor
+	}
+
+	var cursor EnrollmentCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil || !cursor.SortBy.IsValid() || cursor.ID <= 0 {
+		return EnrollmentCursor{}, ErrInvalidCursor
+	}
+
+	return cursor, nil
+}
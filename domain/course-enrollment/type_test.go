@@ -0,0 +1,243 @@
+package courseenrollmentdomain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEnrollmentCursor_EncodeDecode(t *testing.T) {
+	timestamp := time.Date(2024, 8, 25, 12, 0, 0, 0, time.UTC)
+	enrollment := CourseEnrollment{ID: 7, CourseID: 101, CreateTime: timestamp}
+
+	tests := []struct {
+		name   string
+		cursor EnrollmentCursor
+	}{
+		{
+			name:   "Enroll Time",
+			cursor: NewEnrollmentCursor(SortByEnrollTime, "Mathematics", enrollment),
+		},
+		{
+			name:   "Name",
+			cursor: NewEnrollmentCursor(SortByName, "Mathematics", enrollment),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeEnrollmentCursor(tt.cursor.Encode())
+			if err != nil {
+				t.Fatalf("DecodeEnrollmentCursor() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.cursor) {
+				t.Errorf("DecodeEnrollmentCursor() = %v, want %v", got, tt.cursor)
+			}
+		})
+	}
+}
+
+func TestDecodeEnrollmentCursor_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "Not Base64", token: "%%%"},
+		{name: "Not JSON", token: "bm90LWpzb24"},
+		{name: "Unknown Sort", token: EnrollmentCursor{SortBy: "grade", ID: 1}.Encode()},
+		{name: "Missing ID", token: EnrollmentCursor{SortBy: SortByName}.Encode()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeEnrollmentCursor(tt.token); err != ErrInvalidCursor {
+				t.Errorf("DecodeEnrollmentCursor() error = %v, want %v", err, ErrInvalidCursor)
+			}
+		})
+	}
+}
+
+func TestGradingScale_Grade(t *testing.T) {
+	scale := DefaultGradingScale()
+
+	tests := []struct {
+		name        string
+		letter      string
+		want        Grade
+		wantPassing bool
+		wantErr     bool
+	}{
+		{
+			name:        "Passing Grade",
+			letter:      "B+",
+			want:        Grade{Letter: "B+", Points: 3.3},
+			wantPassing: true,
+		},
+		{
+			name:        "Lowest Passing Grade",
+			letter:      " d ",
+			want:        Grade{Letter: "D", Points: 1.0},
+			wantPassing: true,
+		},
+		{
+			name:        "Failing Grade",
+			letter:      "F",
+			want:        Grade{Letter: "F", Points: 0},
+			wantPassing: false,
+		},
+		{
+			name:    "Unknown Letter",
+			letter:  "E",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := scale.Grade(tt.letter)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GradingScale.Grade() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GradingScale.Grade() = %v, want %v", got, tt.want)
+			}
+			if passing := scale.IsPassing(got); passing != tt.wantPassing {
+				t.Errorf("GradingScale.IsPassing() = %v, want %v", passing, tt.wantPassing)
+			}
+		})
+	}
+}
+
+func TestGradePointAverage(t *testing.T) {
+	creditHours := map[int64]int{101: 3, 102: 4, 103: 0}
+
+	tests := []struct {
+		name        string
+		enrollments []CourseEnrollment
+		want        float64
+	}{
+		{
+			name: "Credit Weighted",
+			enrollments: []CourseEnrollment{
+				{CourseID: 101, Grade: &Grade{Letter: "A", Points: 4.0}},
+				{CourseID: 102, Grade: &Grade{Letter: "B-", Points: 2.7}},
+			},
+			want: 3.26,
+		},
+		{
+			name: "Ungraded And Zero Credit Courses Skipped",
+			enrollments: []CourseEnrollment{
+				{CourseID: 101, Grade: &Grade{Letter: "C", Points: 2.0}},
+				{CourseID: 102},
+				{CourseID: 103, Grade: &Grade{Letter: "F", Points: 0}},
+			},
+			want: 2.0,
+		},
+		{
+			name: "Nothing Graded",
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GradePointAverage(tt.enrollments, creditHours); got != tt.want {
+				t.Errorf("GradePointAverage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreditLoadPolicy(t *testing.T) {
+	tests := []struct {
+		name          string
+		policy        CreditLoadPolicy
+		credits       int
+		additional    int
+		wantAllows    bool
+		wantRemaining int
+		wantLimited   bool
+		wantFullTime  bool
+	}{
+		{
+			name:          "Within Limit",
+			policy:        CreditLoadPolicy{MaxCredits: 18, MinFullTimeCredits: 12},
+			credits:       12,
+			additional:    6,
+			wantAllows:    true,
+			wantRemaining: 6,
+			wantLimited:   true,
+			wantFullTime:  true,
+		},
+		{
+			name:          "Over Limit",
+			policy:        CreditLoadPolicy{MaxCredits: 18, MinFullTimeCredits: 12},
+			credits:       16,
+			additional:    3,
+			wantAllows:    false,
+			wantRemaining: 2,
+			wantLimited:   true,
+			wantFullTime:  true,
+		},
+		{
+			name:          "Above Lowered Limit",
+			policy:        CreditLoadPolicy{MaxCredits: 9},
+			credits:       12,
+			additional:    0,
+			wantAllows:    false,
+			wantRemaining: 0,
+			wantLimited:   true,
+			wantFullTime:  false,
+		},
+		{
+			name:         "Unlimited",
+			policy:       CreditLoadPolicy{},
+			credits:      30,
+			additional:   4,
+			wantAllows:   true,
+			wantLimited:  false,
+			wantFullTime: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Allows(tt.credits, tt.additional); got != tt.wantAllows {
+				t.Errorf("CreditLoadPolicy.Allows() = %v, want %v", got, tt.wantAllows)
+			}
+			remaining, limited := tt.policy.Remaining(tt.credits)
+			if remaining != tt.wantRemaining || limited != tt.wantLimited {
+				t.Errorf("CreditLoadPolicy.Remaining() = %v, %v, want %v, %v", remaining, limited, tt.wantRemaining, tt.wantLimited)
+			}
+			if got := tt.policy.IsFullTime(tt.credits); got != tt.wantFullTime {
+				t.Errorf("CreditLoadPolicy.IsFullTime() = %v, want %v", got, tt.wantFullTime)
+			}
+		})
+	}
+}
+
+func TestNewGradingScale(t *testing.T) {
+	tests := []struct {
+		name             string
+		points           map[string]float64
+		minPassingPoints float64
+		want             GradingScale
+	}{
+		{
+			name:             "Default Letters",
+			minPassingPoints: 2.0,
+			want:             GradingScale{Points: DefaultGradingScale().Points, MinPassingPoints: 2.0},
+		},
+		{
+			name:             "Configured Letters",
+			points:           map[string]float64{" pass ": 1, "fail": 0},
+			minPassingPoints: 1,
+			want:             GradingScale{Points: map[string]float64{"PASS": 1, "FAIL": 0}, MinPassingPoints: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewGradingScale(tt.points, tt.minPassingPoints); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewGradingScale() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,11 +3,29 @@ package coursedomain
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
+
+	common "github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/transaction"
 )
 
+// ErrNoRowsAffected is returned when an update does not match any course.
+var ErrNoRowsAffected = errors.New("no rows were updated")
+
 type CourseRepository interface {
 	GetCourseByID(ctx context.Context, id int64) (*Course, error)
+	GetCoursesByIDs(ctx context.Context, ids []int64) ([]Course, error)
+	CreateCourse(ctx context.Context, course Course) (Course, error)
+	UpdateCourseName(ctx context.Context, id int64, name string, updateTime time.Time) error
+	ArchiveCourse(ctx context.Context, id int64, archiveTime time.Time) error
+	GetCourses(ctx context.Context, includeArchived bool, limit, offset int) ([]Course, error)
+	SearchCoursesByName(ctx context.Context, name string, includeArchived bool, limit, offset int) ([]Course, error)
+	GetPrerequisiteIDs(ctx context.Context, courseID int64) ([]int64, error)
+	GetPrerequisiteGraph(ctx context.Context) (map[int64][]int64, error)
+	ReplacePrerequisites(ctx context.Context, courseID int64, prerequisiteIDs []int64, createTime time.Time) error
 }
 
 type CourseDB struct {
@@ -20,16 +38,17 @@ func NewSQLCourseRepository(db *sql.DB) *CourseDB {
 }
 
 // GetCourseByID retrieves a course by its ID from the database.
+// Archived courses are returned as well so existing enrollments can still be resolved.
 func (repo *CourseDB) GetCourseByID(ctx context.Context, id int64) (*Course, error) {
 	query := `
-		SELECT id, name, create_time, update_time
+		SELECT id, name, capacity, credit_hours, archive_time, create_time, update_time
 		FROM courses
 		WHERE id = ?
 	`
-	row := repo.DB.QueryRowContext(ctx, query, id)
+	row := transaction.GetExecutor(ctx, repo.DB).QueryRowContext(ctx, query, id)
 
 	var course Course
-	err := row.Scan(&course.ID, &course.Name, &course.CreateTime, &course.UpdateTime)
+	err := row.Scan(&course.ID, &course.Name, &course.Capacity, &course.CreditHours, &course.ArchiveTime, &course.CreateTime, &course.UpdateTime)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil // No course found
@@ -39,3 +58,253 @@ func (repo *CourseDB) GetCourseByID(ctx context.Context, id int64) (*Course, err
 
 	return &course, nil
 }
+
+// GetCoursesByIDs retrieves the courses with the given IDs, archived ones included.
+// IDs are queried in chunks of common.MaxInClauseIDs; unknown IDs are skipped.
+func (repo *CourseDB) GetCoursesByIDs(ctx context.Context, ids []int64) ([]Course, error) {
+	var courses []Course
+	for _, chunk := range common.ChunkIDs(ids, common.MaxInClauseIDs) {
+		placeholders, args := common.InClause(chunk)
+		query := `
+			SELECT id, name, capacity, credit_hours, archive_time, create_time, update_time
+			FROM courses
+			WHERE id IN (` + placeholders + `)
+		`
+		rows, err := transaction.GetExecutor(ctx, repo.DB).QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve courses: %w", err)
+		}
+
+		for rows.Next() {
+			var course Course
+			if err := rows.Scan(&course.ID, &course.Name, &course.Capacity, &course.CreditHours, &course.ArchiveTime, &course.CreateTime, &course.UpdateTime); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			courses = append(courses, course)
+		}
+
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return courses, nil
+}
+
+// CreateCourse inserts a new course record into the database.
+func (repo *CourseDB) CreateCourse(ctx context.Context, course Course) (Course, error) {
+	query := `
+		INSERT INTO courses (name, capacity, credit_hours, create_time, update_time)
+		VALUES (?, ?, ?, ?, ?)
+	`
+	result, err := transaction.GetExecutor(ctx, repo.DB).ExecContext(ctx, query, course.Name, course.Capacity, course.CreditHours, course.CreateTime, course.UpdateTime)
+	if err != nil {
+		return Course{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return Course{}, err
+	}
+
+	course.ID = id
+	return course, nil
+}
+
+// UpdateCourseName renames a course that has not been archived.
+// Returns ErrNoRowsAffected if no course was updated.
+func (repo *CourseDB) UpdateCourseName(ctx context.Context, id int64, name string, updateTime time.Time) error {
+	query := `
+		UPDATE courses
+		SET name = ?, update_time = ?
+		WHERE id = ? AND archive_time IS NULL
+	`
+	result, err := transaction.GetExecutor(ctx, repo.DB).ExecContext(ctx, query, name, updateTime, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// ArchiveCourse removes a course from the catalog by setting its archive time.
+// Returns ErrNoRowsAffected if the course does not exist or is already archived.
+func (repo *CourseDB) ArchiveCourse(ctx context.Context, id int64, archiveTime time.Time) error {
+	query := `
+		UPDATE courses
+		SET archive_time = ?, update_time = ?
+		WHERE id = ? AND archive_time IS NULL
+	`
+	result, err := transaction.GetExecutor(ctx, repo.DB).ExecContext(ctx, query, archiveTime, archiveTime, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// GetCourses retrieves a page of courses ordered by ID.
+func (repo *CourseDB) GetCourses(ctx context.Context, includeArchived bool, limit, offset int) ([]Course, error) {
+	query := `
+		SELECT id, name, capacity, credit_hours, archive_time, create_time, update_time
+		FROM courses
+		WHERE (? OR archive_time IS NULL)
+		ORDER BY id
+		LIMIT ? OFFSET ?
+	`
+	rows, err := transaction.GetExecutor(ctx, repo.DB).QueryContext(ctx, query, includeArchived, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanCourses(rows)
+}
+
+// SearchCoursesByName retrieves a page of courses whose name contains the given text, ordered by name.
+func (repo *CourseDB) SearchCoursesByName(ctx context.Context, name string, includeArchived bool, limit, offset int) ([]Course, error) {
+	query := `
+		SELECT id, name, capacity, credit_hours, archive_time, create_time, update_time
+		FROM courses
+		WHERE name LIKE ? AND (? OR archive_time IS NULL)
+		ORDER BY name, id
+		LIMIT ? OFFSET ?
+	`
+	rows, err := transaction.GetExecutor(ctx, repo.DB).QueryContext(ctx, query, "%"+escapeLike(name)+"%", includeArchived, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanCourses(rows)
+}
+
+// GetPrerequisiteIDs retrieves the IDs of the courses that must be completed before taking the course.
+func (repo *CourseDB) GetPrerequisiteIDs(ctx context.Context, courseID int64) ([]int64, error) {
+	query := `
+		SELECT prerequisite_course_id
+		FROM course_prerequisites
+		WHERE course_id = ?
+		ORDER BY prerequisite_course_id
+	`
+	rows, err := transaction.GetExecutor(ctx, repo.DB).QueryContext(ctx, query, courseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prerequisiteIDs []int64
+	for rows.Next() {
+		var prerequisiteID int64
+		if err := rows.Scan(&prerequisiteID); err != nil {
+			return nil, err
+		}
+		prerequisiteIDs = append(prerequisiteIDs, prerequisiteID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return prerequisiteIDs, nil
+}
+
+// GetPrerequisiteGraph retrieves every prerequisite edge keyed by course ID. Within a unit of work
+// the edges stay locked until it ends, so concurrent writers cannot close a cycle between them.
+func (repo *CourseDB) GetPrerequisiteGraph(ctx context.Context) (map[int64][]int64, error) {
+	query := `
+		SELECT course_id, prerequisite_course_id
+		FROM course_prerequisites
+		FOR UPDATE
+	`
+	rows, err := transaction.GetExecutor(ctx, repo.DB).QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	graph := make(map[int64][]int64)
+	for rows.Next() {
+		var courseID, prerequisiteID int64
+		if err := rows.Scan(&courseID, &prerequisiteID); err != nil {
+			return nil, err
+		}
+		graph[courseID] = append(graph[courseID], prerequisiteID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return graph, nil
+}
+
+// ReplacePrerequisites replaces the prerequisites of a course with the given course IDs.
+func (repo *CourseDB) ReplacePrerequisites(ctx context.Context, courseID int64, prerequisiteIDs []int64, createTime time.Time) error {
+	return transaction.Do(ctx, repo.DB, func(ctx context.Context) error {
+		executor := transaction.GetExecutor(ctx, repo.DB)
+
+		deleteQuery := `
+			DELETE FROM course_prerequisites
+			WHERE course_id = ?
+		`
+		if _, err := executor.ExecContext(ctx, deleteQuery, courseID); err != nil {
+			return err
+		}
+
+		insertQuery := `
+			INSERT INTO course_prerequisites (course_id, prerequisite_course_id, create_time)
+			VALUES (?, ?, ?)
+		`
+		for _, prerequisiteID := range prerequisiteIDs {
+			if _, err := executor.ExecContext(ctx, insertQuery, courseID, prerequisiteID, createTime); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func scanCourses(rows *sql.Rows) ([]Course, error) {
+	var courses []Course
+	for rows.Next() {
+		var course Course
+		if err := rows.Scan(&course.ID, &course.Name, &course.Capacity, &course.CreditHours, &course.ArchiveTime, &course.CreateTime, &course.UpdateTime); err != nil {
+			return nil, err
+		}
+		courses = append(courses, course)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return courses, nil
+}
+
+// escapeLike escapes the LIKE wildcards so the search text is matched literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
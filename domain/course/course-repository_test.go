@@ -7,6 +7,8 @@ import (
 	"testing"
 	"time"
 
+	common "github/rakadityas/course-management-system/common"
+
 	"github.com/DATA-DOG/go-sqlmock"
 )
 
@@ -41,9 +43,9 @@ func TestCourseDB_GetCourseByID(t *testing.T) {
 					if err != nil {
 						t.Fatalf("error creating mock database: %v", err)
 					}
-					rows := sqlmock.NewRows([]string{"id", "name", "create_time", "update_time"}).
-						AddRow(courseID, courseName, constCreateTime, constUpdateTime)
-					mock.ExpectQuery("SELECT id, name, create_time, update_time FROM courses WHERE id = ?").
+					rows := sqlmock.NewRows([]string{"id", "name", "capacity", "credit_hours", "archive_time", "create_time", "update_time"}).
+						AddRow(courseID, courseName, 0, 3, nil, constCreateTime, constUpdateTime)
+					mock.ExpectQuery("SELECT id, name, capacity, credit_hours, archive_time, create_time, update_time FROM courses WHERE id = ?").
 						WithArgs(courseID).
 						WillReturnRows(rows)
 					return db
@@ -54,10 +56,11 @@ func TestCourseDB_GetCourseByID(t *testing.T) {
 				id:  courseID,
 			},
 			want: &Course{
-				ID:         courseID,
-				Name:       courseName,
-				CreateTime: constCreateTime,
-				UpdateTime: constUpdateTime,
+				ID:          courseID,
+				Name:        courseName,
+				CreditHours: 3,
+				CreateTime:  constCreateTime,
+				UpdateTime:  constUpdateTime,
 			},
 			wantErr: false,
 		},
@@ -69,9 +72,9 @@ func TestCourseDB_GetCourseByID(t *testing.T) {
 					if err != nil {
 						t.Fatalf("error creating mock database: %v", err)
 					}
-					mock.ExpectQuery("SELECT id, name, create_time, update_time FROM courses WHERE id = ?").
+					mock.ExpectQuery("SELECT id, name, capacity, credit_hours, archive_time, create_time, update_time FROM courses WHERE id = ?").
 						WithArgs(courseID).
-						WillReturnRows(sqlmock.NewRows([]string{"id", "name", "create_time", "update_time"}))
+						WillReturnRows(sqlmock.NewRows([]string{"id", "name", "capacity", "credit_hours", "archive_time", "create_time", "update_time"}))
 					return db
 				}(),
 			},
@@ -90,7 +93,7 @@ func TestCourseDB_GetCourseByID(t *testing.T) {
 					if err != nil {
 						t.Fatalf("error creating mock database: %v", err)
 					}
-					mock.ExpectQuery("SELECT id, name, create_time, update_time FROM courses WHERE id = ?").
+					mock.ExpectQuery("SELECT id, name, capacity, credit_hours, archive_time, create_time, update_time FROM courses WHERE id = ?").
 						WithArgs(courseID).
 						WillReturnError(sql.ErrConnDone)
 					return db
@@ -121,3 +124,725 @@ func TestCourseDB_GetCourseByID(t *testing.T) {
 		})
 	}
 }
+
+func TestCourseDB_CreateCourse(t *testing.T) {
+	const courseName = "Introduction to Go"
+
+	constCreateTime := time.Date(2023, 8, 25, 0, 0, 0, 0, time.UTC)
+	constUpdateTime := time.Date(2023, 8, 25, 1, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx    context.Context
+		course Course
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    Course
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectExec("INSERT INTO courses").
+						WithArgs(courseName, 25, 4, constCreateTime, constUpdateTime).
+						WillReturnResult(sqlmock.NewResult(7, 1))
+					return db
+				}(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				course: Course{Name: courseName, Capacity: 25, CreditHours: 4, CreateTime: constCreateTime, UpdateTime: constUpdateTime},
+			},
+			want:    Course{ID: 7, Name: courseName, Capacity: 25, CreditHours: 4, CreateTime: constCreateTime, UpdateTime: constUpdateTime},
+			wantErr: false,
+		},
+		{
+			name: "Error",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectExec("INSERT INTO courses").
+						WithArgs(courseName, 0, constCreateTime, constUpdateTime).
+						WillReturnError(sql.ErrConnDone)
+					return db
+				}(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				course: Course{Name: courseName, CreateTime: constCreateTime, UpdateTime: constUpdateTime},
+			},
+			want:    Course{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &CourseDB{
+				DB: tt.fields.DB,
+			}
+			got, err := repo.CreateCourse(tt.args.ctx, tt.args.course)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CourseDB.CreateCourse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CourseDB.CreateCourse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCourseDB_UpdateCourseName(t *testing.T) {
+	const (
+		courseID   int64 = 1
+		courseName       = "Advanced Go"
+	)
+	constUpdateTime := time.Date(2023, 8, 25, 1, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx        context.Context
+		id         int64
+		name       string
+		updateTime time.Time
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectExec(`UPDATE courses SET name = \?, update_time = \? WHERE id = \? AND archive_time IS NULL`).
+						WithArgs(courseName, constUpdateTime, courseID).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					return db
+				}(),
+			},
+			args:    args{ctx: context.Background(), id: courseID, name: courseName, updateTime: constUpdateTime},
+			wantErr: false,
+		},
+		{
+			name: "No Rows Affected",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectExec(`UPDATE courses SET name = \?, update_time = \? WHERE id = \? AND archive_time IS NULL`).
+						WithArgs(courseName, constUpdateTime, courseID).
+						WillReturnResult(sqlmock.NewResult(0, 0))
+					return db
+				}(),
+			},
+			args:    args{ctx: context.Background(), id: courseID, name: courseName, updateTime: constUpdateTime},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &CourseDB{
+				DB: tt.fields.DB,
+			}
+			err := repo.UpdateCourseName(tt.args.ctx, tt.args.id, tt.args.name, tt.args.updateTime)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CourseDB.UpdateCourseName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCourseDB_ArchiveCourse(t *testing.T) {
+	const courseID int64 = 1
+	archiveTime := time.Date(2023, 8, 25, 1, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx         context.Context
+		id          int64
+		archiveTime time.Time
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectExec(`UPDATE courses SET archive_time = \?, update_time = \? WHERE id = \? AND archive_time IS NULL`).
+						WithArgs(archiveTime, archiveTime, courseID).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					return db
+				}(),
+			},
+			args:    args{ctx: context.Background(), id: courseID, archiveTime: archiveTime},
+			wantErr: false,
+		},
+		{
+			name: "Already Archived",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectExec(`UPDATE courses SET archive_time = \?, update_time = \? WHERE id = \? AND archive_time IS NULL`).
+						WithArgs(archiveTime, archiveTime, courseID).
+						WillReturnResult(sqlmock.NewResult(0, 0))
+					return db
+				}(),
+			},
+			args:    args{ctx: context.Background(), id: courseID, archiveTime: archiveTime},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &CourseDB{
+				DB: tt.fields.DB,
+			}
+			err := repo.ArchiveCourse(tt.args.ctx, tt.args.id, tt.args.archiveTime)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CourseDB.ArchiveCourse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCourseDB_GetCourses(t *testing.T) {
+	timestamp := time.Date(2024, 8, 25, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx             context.Context
+		includeArchived bool
+		limit           int
+		offset          int
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []Course
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					rows := sqlmock.NewRows([]string{"id", "name", "capacity", "credit_hours", "archive_time", "create_time", "update_time"}).
+						AddRow(1, "Mathematics 101", 30, 3, nil, timestamp, timestamp).
+						AddRow(2, "History of Art", 0, 3, timestamp, timestamp, timestamp)
+					mock.ExpectQuery(`SELECT id, name, capacity, credit_hours, archive_time, create_time, update_time FROM courses WHERE \(\? OR archive_time IS NULL\) ORDER BY id LIMIT \? OFFSET \?`).
+						WithArgs(true, 20, 0).
+						WillReturnRows(rows)
+					return db
+				}(),
+			},
+			args: args{ctx: context.Background(), includeArchived: true, limit: 20, offset: 0},
+			want: []Course{
+				{ID: 1, Name: "Mathematics 101", Capacity: 30, CreditHours: 3, CreateTime: timestamp, UpdateTime: timestamp},
+				{ID: 2, Name: "History of Art", CreditHours: 3, ArchiveTime: &timestamp, CreateTime: timestamp, UpdateTime: timestamp},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Query Error",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(`SELECT id, name, capacity, credit_hours, archive_time, create_time, update_time FROM courses`).
+						WithArgs(false, 20, 0).
+						WillReturnError(sql.ErrConnDone)
+					return db
+				}(),
+			},
+			args:    args{ctx: context.Background(), includeArchived: false, limit: 20, offset: 0},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &CourseDB{
+				DB: tt.fields.DB,
+			}
+			got, err := repo.GetCourses(tt.args.ctx, tt.args.includeArchived, tt.args.limit, tt.args.offset)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CourseDB.GetCourses() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CourseDB.GetCourses() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCourseDB_SearchCoursesByName(t *testing.T) {
+	timestamp := time.Date(2024, 8, 25, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx             context.Context
+		name            string
+		includeArchived bool
+		limit           int
+		offset          int
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []Course
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					rows := sqlmock.NewRows([]string{"id", "name", "capacity", "credit_hours", "archive_time", "create_time", "update_time"}).
+						AddRow(1, "Mathematics 101", 0, 3, nil, timestamp, timestamp)
+					mock.ExpectQuery(`SELECT id, name, capacity, credit_hours, archive_time, create_time, update_time FROM courses WHERE name LIKE \? AND \(\? OR archive_time IS NULL\) ORDER BY name, id LIMIT \? OFFSET \?`).
+						WithArgs("%math%", false, 20, 0).
+						WillReturnRows(rows)
+					return db
+				}(),
+			},
+			args: args{ctx: context.Background(), name: "math", limit: 20, offset: 0},
+			want: []Course{
+				{ID: 1, Name: "Mathematics 101", CreditHours: 3, CreateTime: timestamp, UpdateTime: timestamp},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Wildcards Escaped",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(`SELECT id, name, capacity, credit_hours, archive_time, create_time, update_time FROM courses WHERE name LIKE \?`).
+						WithArgs(`%100\%\_off%`, false, 20, 0).
+						WillReturnRows(sqlmock.NewRows([]string{"id", "name", "capacity", "credit_hours", "archive_time", "create_time", "update_time"}))
+					return db
+				}(),
+			},
+			args:    args{ctx: context.Background(), name: "100%_off", limit: 20, offset: 0},
+			want:    nil,
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &CourseDB{
+				DB: tt.fields.DB,
+			}
+			got, err := repo.SearchCoursesByName(tt.args.ctx, tt.args.name, tt.args.includeArchived, tt.args.limit, tt.args.offset)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CourseDB.SearchCoursesByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CourseDB.SearchCoursesByName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCourseDB_GetPrerequisiteIDs(t *testing.T) {
+	const prerequisiteQuery = `SELECT prerequisite_course_id FROM course_prerequisites WHERE course_id = \? ORDER BY prerequisite_course_id`
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx      context.Context
+		courseID int64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []int64
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(prerequisiteQuery).
+						WithArgs(int64(3)).
+						WillReturnRows(sqlmock.NewRows([]string{"prerequisite_course_id"}).AddRow(1).AddRow(2))
+					return db
+				}(),
+			},
+			args: args{
+				ctx:      context.Background(),
+				courseID: 3,
+			},
+			want:    []int64{1, 2},
+			wantErr: false,
+		},
+		{
+			name: "No Prerequisites",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(prerequisiteQuery).
+						WithArgs(int64(1)).
+						WillReturnRows(sqlmock.NewRows([]string{"prerequisite_course_id"}))
+					return db
+				}(),
+			},
+			args: args{
+				ctx:      context.Background(),
+				courseID: 1,
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "Query Error",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(prerequisiteQuery).
+						WithArgs(int64(3)).
+						WillReturnError(sql.ErrConnDone)
+					return db
+				}(),
+			},
+			args: args{
+				ctx:      context.Background(),
+				courseID: 3,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &CourseDB{
+				DB: tt.fields.DB,
+			}
+			got, err := repo.GetPrerequisiteIDs(tt.args.ctx, tt.args.courseID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CourseDB.GetPrerequisiteIDs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CourseDB.GetPrerequisiteIDs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCourseDB_GetPrerequisiteGraph(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error creating mock database: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT course_id, prerequisite_course_id FROM course_prerequisites FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"course_id", "prerequisite_course_id"}).AddRow(3, 1).AddRow(3, 2).AddRow(2, 1))
+
+	repo := &CourseDB{DB: db}
+	got, err := repo.GetPrerequisiteGraph(context.Background())
+	if err != nil {
+		t.Fatalf("CourseDB.GetPrerequisiteGraph() error = %v", err)
+	}
+	want := map[int64][]int64{3: {1, 2}, 2: {1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CourseDB.GetPrerequisiteGraph() = %v, want %v", got, want)
+	}
+}
+
+func TestCourseDB_ReplacePrerequisites(t *testing.T) {
+	const (
+		deleteQuery = `DELETE FROM course_prerequisites WHERE course_id = \?`
+		insertQuery = `INSERT INTO course_prerequisites \(course_id, prerequisite_course_id, create_time\) VALUES \(\?, \?, \?\)`
+	)
+	createTime := time.Now()
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx             context.Context
+		courseID        int64
+		prerequisiteIDs []int64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectBegin()
+					mock.ExpectExec(deleteQuery).
+						WithArgs(int64(3)).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectExec(insertQuery).
+						WithArgs(int64(3), int64(1), createTime).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectExec(insertQuery).
+						WithArgs(int64(3), int64(2), createTime).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectCommit()
+					return db
+				}(),
+			},
+			args: args{
+				ctx:             context.Background(),
+				courseID:        3,
+				prerequisiteIDs: []int64{1, 2},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Insert Error",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectBegin()
+					mock.ExpectExec(deleteQuery).
+						WithArgs(int64(3)).
+						WillReturnResult(sqlmock.NewResult(0, 0))
+					mock.ExpectExec(insertQuery).
+						WithArgs(int64(3), int64(1), createTime).
+						WillReturnError(sql.ErrConnDone)
+					mock.ExpectRollback()
+					return db
+				}(),
+			},
+			args: args{
+				ctx:             context.Background(),
+				courseID:        3,
+				prerequisiteIDs: []int64{1},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &CourseDB{
+				DB: tt.fields.DB,
+			}
+			err := repo.ReplacePrerequisites(tt.args.ctx, tt.args.courseID, tt.args.prerequisiteIDs, createTime)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CourseDB.ReplacePrerequisites() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCourseDB_GetCoursesByIDs(t *testing.T) {
+	const coursesQuery = `SELECT id, name, capacity, credit_hours, archive_time, create_time, update_time FROM courses WHERE id IN \(\?, \?\)`
+	timestamp := time.Date(2024, 8, 25, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx context.Context
+		ids []int64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []Course
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(coursesQuery).
+						WithArgs(int64(1), int64(2)).
+						WillReturnRows(sqlmock.NewRows([]string{"id", "name", "capacity", "credit_hours", "archive_time", "create_time", "update_time"}).
+							AddRow(1, "Mathematics", 30, 3, nil, timestamp, timestamp).
+							AddRow(2, "Physics", 0, 3, nil, timestamp, timestamp))
+					return db
+				}(),
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: []int64{1, 2},
+			},
+			want: []Course{
+				{ID: 1, Name: "Mathematics", Capacity: 30, CreditHours: 3, CreateTime: timestamp, UpdateTime: timestamp},
+				{ID: 2, Name: "Physics", CreditHours: 3, CreateTime: timestamp, UpdateTime: timestamp},
+			},
+			wantErr: false,
+		},
+		{
+			name: "No IDs",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, _, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					return db
+				}(),
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: nil,
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "Query Error",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(coursesQuery).
+						WithArgs(int64(1), int64(2)).
+						WillReturnError(sql.ErrConnDone)
+					return db
+				}(),
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: []int64{1, 2},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &CourseDB{
+				DB: tt.fields.DB,
+			}
+			got, err := repo.GetCoursesByIDs(tt.args.ctx, tt.args.ids)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CourseDB.GetCoursesByIDs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CourseDB.GetCoursesByIDs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCourseDB_GetCoursesByIDs_Chunked(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error creating mock database: %v", err)
+	}
+	defer db.Close()
+
+	ids := make([]int64, common.MaxInClauseIDs+1)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+	columns := []string{"id", "name", "capacity", "credit_hours", "archive_time", "create_time", "update_time"}
+	mock.ExpectQuery(`FROM courses WHERE id IN`).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(1, "Mathematics", 0, 3, nil, time.Time{}, time.Time{}))
+	mock.ExpectQuery(`FROM courses WHERE id IN \(\?\)`).
+		WithArgs(int64(common.MaxInClauseIDs + 1)).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(common.MaxInClauseIDs+1, "Physics", 0, 3, nil, time.Time{}, time.Time{}))
+
+	repo := &CourseDB{DB: db}
+	got, err := repo.GetCoursesByIDs(context.Background(), ids)
+	if err != nil {
+		t.Fatalf("CourseDB.GetCoursesByIDs() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Errorf("CourseDB.GetCoursesByIDs() returned %d courses, want 2", len(got))
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
@@ -1,9 +1,39 @@
 package coursedomain
 
-import "context"
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	common "github/rakadityas/course-management-system/common"
+)
+
+// maxCourseNameLength mirrors the size of the courses.name column.
+const maxCourseNameLength = 255
+
+var (
+	// ErrInvalidCourseName is returned when a course name is empty or too long.
+	ErrInvalidCourseName = errors.New("invalid course name")
+	// ErrInvalidCourseCapacity is returned when a course capacity is negative.
+	ErrInvalidCourseCapacity = errors.New("invalid course capacity")
+	// ErrInvalidCreditHours is returned when the credit hours of a course are negative.
+	ErrInvalidCreditHours = errors.New("invalid course credit hours")
+	// ErrPrerequisiteCycle is returned when prerequisites would make a course depend on itself.
+	ErrPrerequisiteCycle = errors.New("course prerequisites would form a cycle")
+)
 
 type CourseDomainItf interface {
 	GetCourseByID(ctx context.Context, id int64) (*Course, error)
+	GetCoursesByIDs(ctx context.Context, ids []int64) (map[int64]Course, error)
+	CreateCourse(ctx context.Context, name string, capacity, creditHours int) (Course, error)
+	UpdateCourseName(ctx context.Context, id int64, name string) error
+	ArchiveCourse(ctx context.Context, id int64) error
+	GetCourses(ctx context.Context, includeArchived bool, limit, offset int) ([]Course, error)
+	SearchCoursesByName(ctx context.Context, name string, includeArchived bool, limit, offset int) ([]Course, error)
+	GetPrerequisiteIDs(ctx context.Context, courseID int64) ([]int64, error)
+	SetPrerequisites(ctx context.Context, courseID int64, prerequisiteIDs []int64) ([]int64, error)
 }
 
 type CourseService struct {
@@ -17,3 +47,136 @@ func NewCourseService(repo CourseRepository) CourseDomainItf {
 func (s *CourseService) GetCourseByID(ctx context.Context, id int64) (*Course, error) {
 	return s.repo.GetCourseByID(ctx, id)
 }
+
+// GetCoursesByIDs retrieves the courses with the given IDs keyed by course ID.
+// IDs without a course are absent from the result.
+func (s *CourseService) GetCoursesByIDs(ctx context.Context, ids []int64) (map[int64]Course, error) {
+	courses, err := s.repo.GetCoursesByIDs(ctx, common.UniqueIDs(ids))
+	if err != nil {
+		return nil, err
+	}
+
+	courseByID := make(map[int64]Course, len(courses))
+	for _, course := range courses {
+		courseByID[course.ID] = course
+	}
+
+	return courseByID, nil
+}
+
+// CreateCourse validates the name and capacity and adds a new course to the catalog.
+func (s *CourseService) CreateCourse(ctx context.Context, name string, capacity, creditHours int) (Course, error) {
+	name, err := normalizeCourseName(name)
+	if err != nil {
+		return Course{}, err
+	}
+	if capacity < 0 {
+		return Course{}, ErrInvalidCourseCapacity
+	}
+	if creditHours < 0 {
+		return Course{}, ErrInvalidCreditHours
+	}
+
+	course := NewCourse(name, capacity, creditHours)
+	course.CreateTime = time.Now()
+	course.UpdateTime = time.Now()
+
+	return s.repo.CreateCourse(ctx, course)
+}
+
+// UpdateCourseName validates the name and renames the course.
+func (s *CourseService) UpdateCourseName(ctx context.Context, id int64, name string) error {
+	name, err := normalizeCourseName(name)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.UpdateCourseName(ctx, id, name, time.Now())
+}
+
+// ArchiveCourse removes the course from the catalog.
+func (s *CourseService) ArchiveCourse(ctx context.Context, id int64) error {
+	return s.repo.ArchiveCourse(ctx, id, time.Now())
+}
+
+func (s *CourseService) GetCourses(ctx context.Context, includeArchived bool, limit, offset int) ([]Course, error) {
+	return s.repo.GetCourses(ctx, includeArchived, limit, offset)
+}
+
+func (s *CourseService) SearchCoursesByName(ctx context.Context, name string, includeArchived bool, limit, offset int) ([]Course, error) {
+	return s.repo.SearchCoursesByName(ctx, strings.TrimSpace(name), includeArchived, limit, offset)
+}
+
+func (s *CourseService) GetPrerequisiteIDs(ctx context.Context, courseID int64) ([]int64, error) {
+	return s.repo.GetPrerequisiteIDs(ctx, courseID)
+}
+
+// SetPrerequisites replaces the prerequisites of a course and returns the stored, de-duplicated IDs.
+// Returns ErrPrerequisiteCycle if the course would end up depending on itself. It should run inside
+// a unit of work so the cycle check and the write see the same graph.
+func (s *CourseService) SetPrerequisites(ctx context.Context, courseID int64, prerequisiteIDs []int64) ([]int64, error) {
+	prerequisiteIDs = common.UniqueIDs(prerequisiteIDs)
+
+	graph, err := s.repo.GetPrerequisiteGraph(ctx)
+	if err != nil {
+		return nil, err
+	}
+	graph[courseID] = prerequisiteIDs
+	if hasPrerequisiteCycle(graph) {
+		return nil, ErrPrerequisiteCycle
+	}
+
+	if err := s.repo.ReplacePrerequisites(ctx, courseID, prerequisiteIDs, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return prerequisiteIDs, nil
+}
+
+func normalizeCourseName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxCourseNameLength {
+		return "", ErrInvalidCourseName
+	}
+
+	return name, nil
+}
+
+// hasPrerequisiteCycle reports whether the prerequisite graph contains a cycle, using a
+// depth-first search that marks the courses on the current path.
+func hasPrerequisiteCycle(graph map[int64][]int64) bool {
+	const (
+		unvisited = iota
+		onPath
+		done
+	)
+	state := make(map[int64]int, len(graph))
+
+	var visit func(courseID int64) bool
+	visit = func(courseID int64) bool {
+		switch state[courseID] {
+		case onPath:
+			return true
+		case done:
+			return false
+		}
+
+		state[courseID] = onPath
+		for _, prerequisiteID := range graph[courseID] {
+			if visit(prerequisiteID) {
+				return true
+			}
+		}
+		state[courseID] = done
+
+		return false
+	}
+
+	for courseID := range graph {
+		if visit(courseID) {
+			return true
+		}
+	}
+
+	return false
+}
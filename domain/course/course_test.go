@@ -0,0 +1,39 @@
+package coursedomain
+
+import "testing"
+
+func Test_hasPrerequisiteCycle(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph map[int64][]int64
+		want  bool
+	}{
+		{
+			name:  "Empty Graph",
+			graph: map[int64][]int64{},
+			want:  false,
+		},
+		{
+			name:  "Shared Prerequisite",
+			graph: map[int64][]int64{3: {1, 2}, 2: {1}},
+			want:  false,
+		},
+		{
+			name:  "Self Prerequisite",
+			graph: map[int64][]int64{1: {1}},
+			want:  true,
+		},
+		{
+			name:  "Indirect Cycle",
+			graph: map[int64][]int64{1: {2}, 2: {3}, 3: {1}},
+			want:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasPrerequisiteCycle(tt.graph); got != tt.want {
+				t.Errorf("hasPrerequisiteCycle() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
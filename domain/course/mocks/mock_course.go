@@ -35,6 +35,35 @@ func (m *MockCourseDomainItf) EXPECT() *MockCourseDomainItfMockRecorder {
 	return m.recorder
 }
 
+// ArchiveCourse mocks base method.
+func (m *MockCourseDomainItf) ArchiveCourse(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveCourse", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveCourse indicates an expected call of ArchiveCourse.
+func (mr *MockCourseDomainItfMockRecorder) ArchiveCourse(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveCourse", reflect.TypeOf((*MockCourseDomainItf)(nil).ArchiveCourse), ctx, id)
+}
+
+// CreateCourse mocks base method.
+func (m *MockCourseDomainItf) CreateCourse(ctx context.Context, name string, capacity, creditHours int) (coursedomain.Course, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCourse", ctx, name, capacity, creditHours)
+	ret0, _ := ret[0].(coursedomain.Course)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCourse indicates an expected call of CreateCourse.
+func (mr *MockCourseDomainItfMockRecorder) CreateCourse(ctx, name, capacity, creditHours interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCourse", reflect.TypeOf((*MockCourseDomainItf)(nil).CreateCourse), ctx, name, capacity, creditHours)
+}
+
 // GetCourseByID mocks base method.
 func (m *MockCourseDomainItf) GetCourseByID(ctx context.Context, id int64) (*coursedomain.Course, error) {
 	m.ctrl.T.Helper()
@@ -49,3 +78,92 @@ func (mr *MockCourseDomainItfMockRecorder) GetCourseByID(ctx, id interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCourseByID", reflect.TypeOf((*MockCourseDomainItf)(nil).GetCourseByID), ctx, id)
 }
+
+// GetCourses mocks base method.
+func (m *MockCourseDomainItf) GetCourses(ctx context.Context, includeArchived bool, limit, offset int) ([]coursedomain.Course, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCourses", ctx, includeArchived, limit, offset)
+	ret0, _ := ret[0].([]coursedomain.Course)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCourses indicates an expected call of GetCourses.
+func (mr *MockCourseDomainItfMockRecorder) GetCourses(ctx, includeArchived, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCourses", reflect.TypeOf((*MockCourseDomainItf)(nil).GetCourses), ctx, includeArchived, limit, offset)
+}
+
+// GetCoursesByIDs mocks base method.
+func (m *MockCourseDomainItf) GetCoursesByIDs(ctx context.Context, ids []int64) (map[int64]coursedomain.Course, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCoursesByIDs", ctx, ids)
+	ret0, _ := ret[0].(map[int64]coursedomain.Course)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoursesByIDs indicates an expected call of GetCoursesByIDs.
+func (mr *MockCourseDomainItfMockRecorder) GetCoursesByIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoursesByIDs", reflect.TypeOf((*MockCourseDomainItf)(nil).GetCoursesByIDs), ctx, ids)
+}
+
+// GetPrerequisiteIDs mocks base method.
+func (m *MockCourseDomainItf) GetPrerequisiteIDs(ctx context.Context, courseID int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrerequisiteIDs", ctx, courseID)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrerequisiteIDs indicates an expected call of GetPrerequisiteIDs.
+func (mr *MockCourseDomainItfMockRecorder) GetPrerequisiteIDs(ctx, courseID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrerequisiteIDs", reflect.TypeOf((*MockCourseDomainItf)(nil).GetPrerequisiteIDs), ctx, courseID)
+}
+
+// SearchCoursesByName mocks base method.
+func (m *MockCourseDomainItf) SearchCoursesByName(ctx context.Context, name string, includeArchived bool, limit, offset int) ([]coursedomain.Course, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchCoursesByName", ctx, name, includeArchived, limit, offset)
+	ret0, _ := ret[0].([]coursedomain.Course)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchCoursesByName indicates an expected call of SearchCoursesByName.
+func (mr *MockCourseDomainItfMockRecorder) SearchCoursesByName(ctx, name, includeArchived, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCoursesByName", reflect.TypeOf((*MockCourseDomainItf)(nil).SearchCoursesByName), ctx, name, includeArchived, limit, offset)
+}
+
+// SetPrerequisites mocks base method.
+func (m *MockCourseDomainItf) SetPrerequisites(ctx context.Context, courseID int64, prerequisiteIDs []int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPrerequisites", ctx, courseID, prerequisiteIDs)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPrerequisites indicates an expected call of SetPrerequisites.
+func (mr *MockCourseDomainItfMockRecorder) SetPrerequisites(ctx, courseID, prerequisiteIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrerequisites", reflect.TypeOf((*MockCourseDomainItf)(nil).SetPrerequisites), ctx, courseID, prerequisiteIDs)
+}
+
+// UpdateCourseName mocks base method.
+func (m *MockCourseDomainItf) UpdateCourseName(ctx context.Context, id int64, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCourseName", ctx, id, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCourseName indicates an expected call of UpdateCourseName.
+func (mr *MockCourseDomainItfMockRecorder) UpdateCourseName(ctx, id, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCourseName", reflect.TypeOf((*MockCourseDomainItf)(nil).UpdateCourseName), ctx, id, name)
+}
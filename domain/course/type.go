@@ -3,14 +3,27 @@ package coursedomain
 import "time"
 
 type Course struct {
-	ID         int64
-	Name       string
-	CreateTime time.Time
-	UpdateTime time.Time
+	ID          int64
+	Name        string
+	Capacity    int // default capacity of the course's new sections, 0 means unlimited
+	CreditHours int // credit hours a student earns by completing the course
+	ArchiveTime *time.Time
+	CreateTime  time.Time
+	UpdateTime  time.Time
 }
 
-func NewCourse(name string) Course {
+// DefaultCreditHours is the credit hours of a course created without them.
+const DefaultCreditHours = 3
+
+func NewCourse(name string, capacity, creditHours int) Course {
 	return Course{
-		Name: name,
+		Name:        name,
+		Capacity:    capacity,
+		CreditHours: creditHours,
 	}
 }
+
+// IsArchived reports whether the course has been removed from the catalog.
+func (c Course) IsArchived() bool {
+	return c.ArchiveTime != nil
+}
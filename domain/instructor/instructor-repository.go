@@ -0,0 +1,169 @@
+package instructordomain
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	common "github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/transaction"
+)
+
+var (
+	// ErrAlreadyAssigned is returned when the instructor already teaches the course.
+	ErrAlreadyAssigned = errors.New("instructor is already assigned to the course")
+	// ErrNotAssigned is returned when the instructor does not teach the course.
+	ErrNotAssigned = errors.New("instructor is not assigned to the course")
+)
+
+// InstructorRepository defines the interface for instructor-related database operations.
+type InstructorRepository interface {
+	GetInstructorByID(ctx context.Context, id int64) (*Instructor, error)
+	GetInstructorsByCourseIDs(ctx context.Context, courseIDs []int64) ([]CourseInstructor, error)
+	GetCourseIDsByInstructorID(ctx context.Context, instructorID int64) ([]int64, error)
+	AssignCourse(ctx context.Context, instructorID, courseID int64, createTime time.Time) error
+	UnassignCourse(ctx context.Context, instructorID, courseID int64) error
+}
+
+// InstructorDB implements the InstructorRepository interface using a SQL database.
+type InstructorDB struct {
+	DB *sql.DB
+}
+
+// NewSQLInstructorRepository creates a new InstructorDB instance with the given database connection.
+func NewSQLInstructorRepository(db *sql.DB) *InstructorDB {
+	return &InstructorDB{DB: db}
+}
+
+// GetInstructorByID retrieves an instructor by ID. Soft-deleted instructors are treated as not found.
+func (repo *InstructorDB) GetInstructorByID(ctx context.Context, id int64) (*Instructor, error) {
+	query := `
+		SELECT id, name, email, create_time, update_time
+		FROM instructors
+		WHERE id = ? AND delete_time IS NULL
+	`
+	row := transaction.GetExecutor(ctx, repo.DB).QueryRowContext(ctx, query, id)
+
+	instructor := &Instructor{}
+	err := row.Scan(&instructor.ID, &instructor.Name, &instructor.Email, &instructor.CreateTime, &instructor.UpdateTime)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // No instructor found
+		}
+		return nil, fmt.Errorf("failed to retrieve instructor: %v", err)
+	}
+
+	return instructor, nil
+}
+
+// GetInstructorsByCourseIDs retrieves the active instructors of the given courses, ordered by
+// course and instructor name. IDs are queried in chunks of common.MaxInClauseIDs.
+func (repo *InstructorDB) GetInstructorsByCourseIDs(ctx context.Context, courseIDs []int64) ([]CourseInstructor, error) {
+	var courseInstructors []CourseInstructor
+	for _, chunk := range common.ChunkIDs(courseIDs, common.MaxInClauseIDs) {
+		placeholders, args := common.InClause(chunk)
+		query := `
+			SELECT ci.course_id, i.id, i.name, i.email, i.create_time, i.update_time
+			FROM course_instructors ci
+			JOIN instructors i ON i.id = ci.instructor_id
+			WHERE ci.course_id IN (` + placeholders + `) AND i.delete_time IS NULL
+			ORDER BY ci.course_id, i.name, i.id
+		`
+		rows, err := transaction.GetExecutor(ctx, repo.DB).QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve course instructors: %v", err)
+		}
+
+		for rows.Next() {
+			var courseInstructor CourseInstructor
+			instructor := &courseInstructor.Instructor
+			if err := rows.Scan(&courseInstructor.CourseID, &instructor.ID, &instructor.Name, &instructor.Email, &instructor.CreateTime, &instructor.UpdateTime); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			courseInstructors = append(courseInstructors, courseInstructor)
+		}
+
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return courseInstructors, nil
+}
+
+// GetCourseIDsByInstructorID retrieves the IDs of the courses the instructor teaches, in ascending order.
+func (repo *InstructorDB) GetCourseIDsByInstructorID(ctx context.Context, instructorID int64) ([]int64, error) {
+	query := `
+		SELECT course_id
+		FROM course_instructors
+		WHERE instructor_id = ?
+		ORDER BY course_id
+	`
+	rows, err := transaction.GetExecutor(ctx, repo.DB).QueryContext(ctx, query, instructorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve instructor courses: %v", err)
+	}
+	defer rows.Close()
+
+	var courseIDs []int64
+	for rows.Next() {
+		var courseID int64
+		if err := rows.Scan(&courseID); err != nil {
+			return nil, err
+		}
+		courseIDs = append(courseIDs, courseID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return courseIDs, nil
+}
+
+// AssignCourse records that the instructor teaches the course.
+// Returns ErrAlreadyAssigned if the assignment already exists.
+func (repo *InstructorDB) AssignCourse(ctx context.Context, instructorID, courseID int64, createTime time.Time) error {
+	query := `
+		INSERT INTO course_instructors (course_id, instructor_id, create_time)
+		VALUES (?, ?, ?)
+	`
+	_, err := transaction.GetExecutor(ctx, repo.DB).ExecContext(ctx, query, courseID, instructorID, createTime)
+	if err != nil {
+		if common.IsDuplicateEntryError(err) {
+			return ErrAlreadyAssigned
+		}
+		return fmt.Errorf("failed to assign instructor: %v", err)
+	}
+
+	return nil
+}
+
+// UnassignCourse removes the instructor from the course.
+// Returns ErrNotAssigned if the instructor does not teach the course.
+func (repo *InstructorDB) UnassignCourse(ctx context.Context, instructorID, courseID int64) error {
+	query := `
+		DELETE FROM course_instructors
+		WHERE course_id = ? AND instructor_id = ?
+	`
+	result, err := transaction.GetExecutor(ctx, repo.DB).ExecContext(ctx, query, courseID, instructorID)
+	if err != nil {
+		return fmt.Errorf("failed to unassign instructor: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotAssigned
+	}
+
+	return nil
+}
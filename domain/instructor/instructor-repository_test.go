@@ -0,0 +1,290 @@
+package instructordomain
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+)
+
+func TestInstructorDB_GetInstructorByID(t *testing.T) {
+	timestamp := time.Date(2024, 8, 25, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx context.Context
+		id  int64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *Instructor
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(`SELECT id, name, email, create_time, update_time FROM instructors WHERE id = \? AND delete_time IS NULL`).
+						WithArgs(int64(1)).
+						WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email", "create_time", "update_time"}).
+							AddRow(1, "Ada Lovelace", "ada@example.com", timestamp, timestamp))
+					return db
+				}(),
+			},
+			args:    args{ctx: context.Background(), id: 1},
+			want:    &Instructor{ID: 1, Name: "Ada Lovelace", Email: "ada@example.com", CreateTime: timestamp, UpdateTime: timestamp},
+			wantErr: false,
+		},
+		{
+			name: "No Rows",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(`SELECT id, name, email, create_time, update_time FROM instructors WHERE id = \? AND delete_time IS NULL`).
+						WithArgs(int64(1)).
+						WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email", "create_time", "update_time"}))
+					return db
+				}(),
+			},
+			args:    args{ctx: context.Background(), id: 1},
+			want:    nil,
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &InstructorDB{
+				DB: tt.fields.DB,
+			}
+			got, err := repo.GetInstructorByID(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("InstructorDB.GetInstructorByID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InstructorDB.GetInstructorByID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInstructorDB_GetInstructorsByCourseIDs(t *testing.T) {
+	timestamp := time.Date(2024, 8, 25, 0, 0, 0, 0, time.UTC)
+	const instructorsQuery = `SELECT ci.course_id, i.id, i.name, i.email, i.create_time, i.update_time FROM course_instructors ci JOIN instructors i ON i.id = ci.instructor_id WHERE ci.course_id IN \(\?, \?\) AND i.delete_time IS NULL ORDER BY ci.course_id, i.name, i.id`
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx       context.Context
+		courseIDs []int64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []CourseInstructor
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(instructorsQuery).
+						WithArgs(int64(101), int64(102)).
+						WillReturnRows(sqlmock.NewRows([]string{"course_id", "id", "name", "email", "create_time", "update_time"}).
+							AddRow(101, 1, "Ada Lovelace", "ada@example.com", timestamp, timestamp).
+							AddRow(101, 2, "Alan Turing", "alan@example.com", timestamp, timestamp))
+					return db
+				}(),
+			},
+			args: args{ctx: context.Background(), courseIDs: []int64{101, 102}},
+			want: []CourseInstructor{
+				{CourseID: 101, Instructor: Instructor{ID: 1, Name: "Ada Lovelace", Email: "ada@example.com", CreateTime: timestamp, UpdateTime: timestamp}},
+				{CourseID: 101, Instructor: Instructor{ID: 2, Name: "Alan Turing", Email: "alan@example.com", CreateTime: timestamp, UpdateTime: timestamp}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Query Error",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(instructorsQuery).
+						WithArgs(int64(101), int64(102)).
+						WillReturnError(errors.New("db error"))
+					return db
+				}(),
+			},
+			args:    args{ctx: context.Background(), courseIDs: []int64{101, 102}},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &InstructorDB{
+				DB: tt.fields.DB,
+			}
+			got, err := repo.GetInstructorsByCourseIDs(tt.args.ctx, tt.args.courseIDs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("InstructorDB.GetInstructorsByCourseIDs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InstructorDB.GetInstructorsByCourseIDs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInstructorDB_AssignCourse(t *testing.T) {
+	createTime := time.Date(2024, 8, 25, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		DB *sql.DB
+	}
+	tests := []struct {
+		name      string
+		fields    fields
+		wantErr   bool
+		wantErrIs error
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectExec(`INSERT INTO course_instructors \(course_id, instructor_id, create_time\)`).
+						WithArgs(int64(101), int64(1), createTime).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					return db
+				}(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Already Assigned",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectExec(`INSERT INTO course_instructors \(course_id, instructor_id, create_time\)`).
+						WithArgs(int64(101), int64(1), createTime).
+						WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+					return db
+				}(),
+			},
+			wantErr:   true,
+			wantErrIs: ErrAlreadyAssigned,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &InstructorDB{
+				DB: tt.fields.DB,
+			}
+			err := repo.AssignCourse(context.Background(), 1, 101, createTime)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("InstructorDB.AssignCourse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("InstructorDB.AssignCourse() error = %v, wantErrIs %v", err, tt.wantErrIs)
+			}
+		})
+	}
+}
+
+func TestInstructorDB_UnassignCourse(t *testing.T) {
+	type fields struct {
+		DB *sql.DB
+	}
+	tests := []struct {
+		name      string
+		fields    fields
+		wantErr   bool
+		wantErrIs error
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectExec(`DELETE FROM course_instructors WHERE course_id = \? AND instructor_id = \?`).
+						WithArgs(int64(101), int64(1)).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					return db
+				}(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Not Assigned",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectExec(`DELETE FROM course_instructors WHERE course_id = \? AND instructor_id = \?`).
+						WithArgs(int64(101), int64(1)).
+						WillReturnResult(sqlmock.NewResult(0, 0))
+					return db
+				}(),
+			},
+			wantErr:   true,
+			wantErrIs: ErrNotAssigned,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &InstructorDB{
+				DB: tt.fields.DB,
+			}
+			err := repo.UnassignCourse(context.Background(), 1, 101)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("InstructorDB.UnassignCourse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("InstructorDB.UnassignCourse() error = %v, wantErrIs %v", err, tt.wantErrIs)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package instructordomain
+
+import (
+	"context"
+	"time"
+
+	common "github/rakadityas/course-management-system/common"
+)
+
+type InstructorDomainItf interface {
+	GetInstructorByID(ctx context.Context, instructorID int64) (*Instructor, error)
+	GetInstructorsByCourseIDs(ctx context.Context, courseIDs []int64) (map[int64][]Instructor, error)
+	GetCourseIDsByInstructorID(ctx context.Context, instructorID int64) ([]int64, error)
+	AssignCourse(ctx context.Context, instructorID, courseID int64) error
+	UnassignCourse(ctx context.Context, instructorID, courseID int64) error
+}
+
+type InstructorService struct {
+	repo InstructorRepository
+}
+
+func NewInstructorService(repo InstructorRepository) InstructorDomainItf {
+	return &InstructorService{repo: repo}
+}
+
+// GetInstructorByID retrieves an instructor by their ID.
+func (s *InstructorService) GetInstructorByID(ctx context.Context, id int64) (*Instructor, error) {
+	return s.repo.GetInstructorByID(ctx, id)
+}
+
+// GetInstructorsByCourseIDs retrieves the instructors of the given courses keyed by course ID,
+// each ordered by name. Courses without an instructor are absent from the result.
+func (s *InstructorService) GetInstructorsByCourseIDs(ctx context.Context, courseIDs []int64) (map[int64][]Instructor, error) {
+	courseInstructors, err := s.repo.GetInstructorsByCourseIDs(ctx, common.UniqueIDs(courseIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	instructorsByCourseID := make(map[int64][]Instructor)
+	for _, courseInstructor := range courseInstructors {
+		instructorsByCourseID[courseInstructor.CourseID] = append(instructorsByCourseID[courseInstructor.CourseID], courseInstructor.Instructor)
+	}
+
+	return instructorsByCourseID, nil
+}
+
+// GetCourseIDsByInstructorID retrieves the IDs of the courses the instructor teaches.
+func (s *InstructorService) GetCourseIDsByInstructorID(ctx context.Context, instructorID int64) ([]int64, error) {
+	return s.repo.GetCourseIDsByInstructorID(ctx, instructorID)
+}
+
+// AssignCourse assigns the instructor to teach the course.
+func (s *InstructorService) AssignCourse(ctx context.Context, instructorID, courseID int64) error {
+	return s.repo.AssignCourse(ctx, instructorID, courseID, time.Now())
+}
+
+// UnassignCourse removes the instructor from the course.
+func (s *InstructorService) UnassignCourse(ctx context.Context, instructorID, courseID int64) error {
+	return s.repo.UnassignCourse(ctx, instructorID, courseID)
+}
@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/instructor/instructor.go
+
+// Package instructordomain is a generated GoMock package.
+package instructordomain
+
+import (
+	context "context"
+	instructordomain "github/rakadityas/course-management-system/domain/instructor"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockInstructorDomainItf is a mock of InstructorDomainItf interface.
+type MockInstructorDomainItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockInstructorDomainItfMockRecorder
+}
+
+// MockInstructorDomainItfMockRecorder is the mock recorder for MockInstructorDomainItf.
+type MockInstructorDomainItfMockRecorder struct {
+	mock *MockInstructorDomainItf
+}
+
+// NewMockInstructorDomainItf creates a new mock instance.
+func NewMockInstructorDomainItf(ctrl *gomock.Controller) *MockInstructorDomainItf {
+	mock := &MockInstructorDomainItf{ctrl: ctrl}
+	mock.recorder = &MockInstructorDomainItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInstructorDomainItf) EXPECT() *MockInstructorDomainItfMockRecorder {
+	return m.recorder
+}
+
+// AssignCourse mocks base method.
+func (m *MockInstructorDomainItf) AssignCourse(ctx context.Context, instructorID, courseID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignCourse", ctx, instructorID, courseID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignCourse indicates an expected call of AssignCourse.
+func (mr *MockInstructorDomainItfMockRecorder) AssignCourse(ctx, instructorID, courseID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignCourse", reflect.TypeOf((*MockInstructorDomainItf)(nil).AssignCourse), ctx, instructorID, courseID)
+}
+
+// GetCourseIDsByInstructorID mocks base method.
+func (m *MockInstructorDomainItf) GetCourseIDsByInstructorID(ctx context.Context, instructorID int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCourseIDsByInstructorID", ctx, instructorID)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCourseIDsByInstructorID indicates an expected call of GetCourseIDsByInstructorID.
+func (mr *MockInstructorDomainItfMockRecorder) GetCourseIDsByInstructorID(ctx, instructorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCourseIDsByInstructorID", reflect.TypeOf((*MockInstructorDomainItf)(nil).GetCourseIDsByInstructorID), ctx, instructorID)
+}
+
+// GetInstructorByID mocks base method.
+func (m *MockInstructorDomainItf) GetInstructorByID(ctx context.Context, instructorID int64) (*instructordomain.Instructor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstructorByID", ctx, instructorID)
+	ret0, _ := ret[0].(*instructordomain.Instructor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstructorByID indicates an expected call of GetInstructorByID.
+func (mr *MockInstructorDomainItfMockRecorder) GetInstructorByID(ctx, instructorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstructorByID", reflect.TypeOf((*MockInstructorDomainItf)(nil).GetInstructorByID), ctx, instructorID)
+}
+
+// GetInstructorsByCourseIDs mocks base method.
+func (m *MockInstructorDomainItf) GetInstructorsByCourseIDs(ctx context.Context, courseIDs []int64) (map[int64][]instructordomain.Instructor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstructorsByCourseIDs", ctx, courseIDs)
+	ret0, _ := ret[0].(map[int64][]instructordomain.Instructor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstructorsByCourseIDs indicates an expected call of GetInstructorsByCourseIDs.
+func (mr *MockInstructorDomainItfMockRecorder) GetInstructorsByCourseIDs(ctx, courseIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstructorsByCourseIDs", reflect.TypeOf((*MockInstructorDomainItf)(nil).GetInstructorsByCourseIDs), ctx, courseIDs)
+}
+
+// UnassignCourse mocks base method.
+func (m *MockInstructorDomainItf) UnassignCourse(ctx context.Context, instructorID, courseID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignCourse", ctx, instructorID, courseID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnassignCourse indicates an expected call of UnassignCourse.
+func (mr *MockInstructorDomainItfMockRecorder) UnassignCourse(ctx, instructorID, courseID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignCourse", reflect.TypeOf((*MockInstructorDomainItf)(nil).UnassignCourse), ctx, instructorID, courseID)
+}
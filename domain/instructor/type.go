@@ -0,0 +1,17 @@
+package instructordomain
+
+import "time"
+
+type Instructor struct {
+	ID         int64
+	Name       string
+	Email      string
+	CreateTime time.Time
+	UpdateTime time.Time
+}
+
+// CourseInstructor is an instructor assigned to teach a course.
+type CourseInstructor struct {
+	CourseID   int64
+	Instructor Instructor
+}
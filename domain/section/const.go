@@ -0,0 +1,127 @@
+package sectiondomain
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+// DayOfWeek is the weekday a section meets on. The numeric values follow time.Weekday
+// and are stored in section_meetings.day_of_week.
+type DayOfWeek int
+
+const (
+	Sunday    DayOfWeek = DayOfWeek(time.Sunday)
+	Monday    DayOfWeek = DayOfWeek(time.Monday)
+	Tuesday   DayOfWeek = DayOfWeek(time.Tuesday)
+	Wednesday DayOfWeek = DayOfWeek(time.Wednesday)
+	Thursday  DayOfWeek = DayOfWeek(time.Thursday)
+	Friday    DayOfWeek = DayOfWeek(time.Friday)
+	Saturday  DayOfWeek = DayOfWeek(time.Saturday)
+)
+
+var dayNames = map[DayOfWeek]string{
+	Sunday:    "sunday",
+	Monday:    "monday",
+	Tuesday:   "tuesday",
+	Wednesday: "wednesday",
+	Thursday:  "thursday",
+	Friday:    "friday",
+	Saturday:  "saturday",
+}
+
+// IsValid reports whether the day is one of the seven weekdays.
+func (d DayOfWeek) IsValid() bool {
+	_, ok := dayNames[d]
+	return ok
+}
+
+// WeekOrder returns the position of the day in a week starting on Monday.
+func (d DayOfWeek) WeekOrder() int {
+	return (int(d) + 6) % 7
+}
+
+func (d DayOfWeek) String() string {
+	if name, ok := dayNames[d]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("unknown(%d)", int(d))
+}
+
+// MarshalText encodes the day by name, so JSON carries "monday" rather than 1.
+func (d DayOfWeek) MarshalText() ([]byte, error) {
+	if !d.IsValid() {
+		return nil, fmt.Errorf("invalid day of week %d", int(d))
+	}
+
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText decodes a day from its name.
+func (d *DayOfWeek) UnmarshalText(text []byte) error {
+	for day, name := range dayNames {
+		if name == string(text) {
+			*d = day
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid day of week %q", text)
+}
+
+// MinutesPerDay bounds a ClockTime, a meeting ends at midnight at the latest.
+const MinutesPerDay = 24 * 60
+
+// ClockTime is a time of day in minutes after midnight, written as "15:04".
+type ClockTime int
+
+// ParseClockTime parses a time of day written as "15:04" or "15:04:05"; seconds are dropped.
+func ParseClockTime(s string) (ClockTime, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		t, err = time.Parse("15:04:05", s)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q", s)
+	}
+
+	return ClockTime(t.Hour()*60 + t.Minute()), nil
+}
+
+func (c ClockTime) String() string {
+	return fmt.Sprintf("%02d:%02d", int(c)/60, int(c)%60)
+}
+
+// MarshalText encodes the time as "15:04".
+func (c ClockTime) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText decodes a time written as "15:04".
+func (c *ClockTime) UnmarshalText(text []byte) error {
+	parsed, err := ParseClockTime(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
+
+// Value stores the time in a TIME column.
+func (c ClockTime) Value() (driver.Value, error) {
+	return c.String() + ":00", nil
+}
+
+// Scan reads the time from a TIME column.
+func (c *ClockTime) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case []byte:
+		return c.UnmarshalText(value)
+	case string:
+		return c.UnmarshalText([]byte(value))
+	default:
+		return fmt.Errorf("cannot scan %T into ClockTime", src)
+	}
+}
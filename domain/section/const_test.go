@@ -0,0 +1,72 @@
+package sectiondomain
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDayOfWeek_JSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		day     DayOfWeek
+		want    string
+		wantErr bool
+	}{
+		{name: "Monday", day: Monday, want: `"monday"`},
+		{name: "Sunday", day: Sunday, want: `"sunday"`},
+		{name: "Unknown", day: DayOfWeek(7), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.day)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("json.Marshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal() = %s, want %s", got, tt.want)
+			}
+
+			var decoded DayOfWeek
+			if err := json.Unmarshal(got, &decoded); err != nil || decoded != tt.day {
+				t.Errorf("json.Unmarshal() = %v, %v, want %v", decoded, err, tt.day)
+			}
+		})
+	}
+}
+
+func TestParseClockTime(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    ClockTime
+		wantErr bool
+	}{
+		{name: "Hours And Minutes", input: "09:30", want: 9*60 + 30},
+		{name: "With Seconds", input: "13:05:00", want: 13*60 + 5},
+		{name: "Midnight", input: "00:00", want: 0},
+		{name: "Out Of Range", input: "24:00", wantErr: true},
+		{name: "Not A Time", input: "noon", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseClockTime(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseClockTime() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseClockTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClockTime_String(t *testing.T) {
+	if got := ClockTime(9*60 + 5).String(); got != "09:05" {
+		t.Errorf("ClockTime.String() = %v, want 09:05", got)
+	}
+}
@@ -0,0 +1,140 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/section/section.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	sectiondomain "github/rakadityas/course-management-system/domain/section"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSectionDomainItf is a mock of SectionDomainItf interface.
+type MockSectionDomainItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockSectionDomainItfMockRecorder
+}
+
+// MockSectionDomainItfMockRecorder is the mock recorder for MockSectionDomainItf.
+type MockSectionDomainItfMockRecorder struct {
+	mock *MockSectionDomainItf
+}
+
+// NewMockSectionDomainItf creates a new mock instance.
+func NewMockSectionDomainItf(ctrl *gomock.Controller) *MockSectionDomainItf {
+	mock := &MockSectionDomainItf{ctrl: ctrl}
+	mock.recorder = &MockSectionDomainItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSectionDomainItf) EXPECT() *MockSectionDomainItfMockRecorder {
+	return m.recorder
+}
+
+// AddMeetings mocks base method.
+func (m *MockSectionDomainItf) AddMeetings(ctx context.Context, sectionID int64, meetings []sectiondomain.Meeting) ([]sectiondomain.Meeting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMeetings", ctx, sectionID, meetings)
+	ret0, _ := ret[0].([]sectiondomain.Meeting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMeetings indicates an expected call of AddMeetings.
+func (mr *MockSectionDomainItfMockRecorder) AddMeetings(ctx, sectionID, meetings interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMeetings", reflect.TypeOf((*MockSectionDomainItf)(nil).AddMeetings), ctx, sectionID, meetings)
+}
+
+// CreateSection mocks base method.
+func (m *MockSectionDomainItf) CreateSection(ctx context.Context, courseID, termID int64, capacity int) (sectiondomain.Section, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSection", ctx, courseID, termID, capacity)
+	ret0, _ := ret[0].(sectiondomain.Section)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSection indicates an expected call of CreateSection.
+func (mr *MockSectionDomainItfMockRecorder) CreateSection(ctx, courseID, termID, capacity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSection", reflect.TypeOf((*MockSectionDomainItf)(nil).CreateSection), ctx, courseID, termID, capacity)
+}
+
+// GetMeetingsBySectionIDs mocks base method.
+func (m *MockSectionDomainItf) GetMeetingsBySectionIDs(ctx context.Context, sectionIDs []int64) (map[int64][]sectiondomain.Meeting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMeetingsBySectionIDs", ctx, sectionIDs)
+	ret0, _ := ret[0].(map[int64][]sectiondomain.Meeting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMeetingsBySectionIDs indicates an expected call of GetMeetingsBySectionIDs.
+func (mr *MockSectionDomainItfMockRecorder) GetMeetingsBySectionIDs(ctx, sectionIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMeetingsBySectionIDs", reflect.TypeOf((*MockSectionDomainItf)(nil).GetMeetingsBySectionIDs), ctx, sectionIDs)
+}
+
+// GetSectionByID mocks base method.
+func (m *MockSectionDomainItf) GetSectionByID(ctx context.Context, id int64) (*sectiondomain.Section, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSectionByID", ctx, id)
+	ret0, _ := ret[0].(*sectiondomain.Section)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSectionByID indicates an expected call of GetSectionByID.
+func (mr *MockSectionDomainItfMockRecorder) GetSectionByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSectionByID", reflect.TypeOf((*MockSectionDomainItf)(nil).GetSectionByID), ctx, id)
+}
+
+// GetSectionsByCourseID mocks base method.
+func (m *MockSectionDomainItf) GetSectionsByCourseID(ctx context.Context, courseID int64) ([]sectiondomain.Section, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSectionsByCourseID", ctx, courseID)
+	ret0, _ := ret[0].([]sectiondomain.Section)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSectionsByCourseID indicates an expected call of GetSectionsByCourseID.
+func (mr *MockSectionDomainItfMockRecorder) GetSectionsByCourseID(ctx, courseID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSectionsByCourseID", reflect.TypeOf((*MockSectionDomainItf)(nil).GetSectionsByCourseID), ctx, courseID)
+}
+
+// GetSectionsByIDs mocks base method.
+func (m *MockSectionDomainItf) GetSectionsByIDs(ctx context.Context, ids []int64) (map[int64]sectiondomain.Section, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSectionsByIDs", ctx, ids)
+	ret0, _ := ret[0].(map[int64]sectiondomain.Section)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSectionsByIDs indicates an expected call of GetSectionsByIDs.
+func (mr *MockSectionDomainItfMockRecorder) GetSectionsByIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSectionsByIDs", reflect.TypeOf((*MockSectionDomainItf)(nil).GetSectionsByIDs), ctx, ids)
+}
+
+// LockSectionByID mocks base method.
+func (m *MockSectionDomainItf) LockSectionByID(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockSectionByID", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockSectionByID indicates an expected call of LockSectionByID.
+func (mr *MockSectionDomainItfMockRecorder) LockSectionByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockSectionByID", reflect.TypeOf((*MockSectionDomainItf)(nil).LockSectionByID), ctx, id)
+}
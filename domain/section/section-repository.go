@@ -0,0 +1,210 @@
+package sectiondomain
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	common "github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/transaction"
+)
+
+// ErrNoRowsAffected is returned when a lock does not match any section.
+var ErrNoRowsAffected = errors.New("no rows were updated")
+
+// SectionRepository defines the interface for section-related database operations.
+type SectionRepository interface {
+	CreateSection(ctx context.Context, section Section) (Section, error)
+	GetSectionByID(ctx context.Context, id int64) (*Section, error)
+	GetSectionsByIDs(ctx context.Context, ids []int64) ([]Section, error)
+	GetSectionsByCourseID(ctx context.Context, courseID int64) ([]Section, error)
+	LockSectionByID(ctx context.Context, id int64) error
+	CreateMeeting(ctx context.Context, meeting Meeting) (Meeting, error)
+	GetMeetingsBySectionIDs(ctx context.Context, sectionIDs []int64) ([]Meeting, error)
+}
+
+// SectionDB implements the SectionRepository interface using a SQL database.
+type SectionDB struct {
+	DB *sql.DB
+}
+
+// NewSQLSectionRepository creates a new SectionDB instance with the given database connection.
+func NewSQLSectionRepository(db *sql.DB) *SectionDB {
+	return &SectionDB{DB: db}
+}
+
+// CreateSection inserts a new section record into the database.
+func (repo *SectionDB) CreateSection(ctx context.Context, section Section) (Section, error) {
+	query := `
+		INSERT INTO course_sections (course_id, term_id, capacity, create_time, update_time)
+		VALUES (?, ?, ?, ?, ?)
+	`
+	result, err := transaction.GetExecutor(ctx, repo.DB).ExecContext(ctx, query, section.CourseID, section.TermID, section.Capacity, section.CreateTime, section.UpdateTime)
+	if err != nil {
+		return Section{}, fmt.Errorf("failed to create section: %v", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return Section{}, err
+	}
+
+	section.ID = id
+	return section, nil
+}
+
+// GetSectionByID retrieves a section by its ID.
+func (repo *SectionDB) GetSectionByID(ctx context.Context, id int64) (*Section, error) {
+	query := `
+		SELECT id, course_id, term_id, capacity, create_time, update_time
+		FROM course_sections
+		WHERE id = ?
+	`
+	row := transaction.GetExecutor(ctx, repo.DB).QueryRowContext(ctx, query, id)
+
+	section := &Section{}
+	err := row.Scan(&section.ID, &section.CourseID, &section.TermID, &section.Capacity, &section.CreateTime, &section.UpdateTime)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // No section found
+		}
+		return nil, fmt.Errorf("failed to retrieve section: %v", err)
+	}
+
+	return section, nil
+}
+
+// GetSectionsByIDs retrieves the sections with the given IDs.
+// IDs are queried in chunks of common.MaxInClauseIDs; unknown IDs are skipped.
+func (repo *SectionDB) GetSectionsByIDs(ctx context.Context, ids []int64) ([]Section, error) {
+	var sections []Section
+	for _, chunk := range common.ChunkIDs(ids, common.MaxInClauseIDs) {
+		placeholders, args := common.InClause(chunk)
+		query := `
+			SELECT id, course_id, term_id, capacity, create_time, update_time
+			FROM course_sections
+			WHERE id IN (` + placeholders + `)
+		`
+		chunkSections, err := repo.querySections(ctx, query, args...)
+		if err != nil {
+			return nil, err
+		}
+		sections = append(sections, chunkSections...)
+	}
+
+	return sections, nil
+}
+
+// GetSectionsByCourseID retrieves the sections of a course ordered by term and ID.
+func (repo *SectionDB) GetSectionsByCourseID(ctx context.Context, courseID int64) ([]Section, error) {
+	query := `
+		SELECT id, course_id, term_id, capacity, create_time, update_time
+		FROM course_sections
+		WHERE course_id = ?
+		ORDER BY term_id, id
+	`
+
+	return repo.querySections(ctx, query, courseID)
+}
+
+// LockSectionByID takes a row lock on the section until the surrounding transaction ends,
+// serializing concurrent sign-ups for the same section.
+// Returns ErrNoRowsAffected if the section does not exist.
+func (repo *SectionDB) LockSectionByID(ctx context.Context, id int64) error {
+	query := `
+		SELECT id
+		FROM course_sections
+		WHERE id = ?
+		FOR UPDATE
+	`
+	var sectionID int64
+	err := transaction.GetExecutor(ctx, repo.DB).QueryRowContext(ctx, query, id).Scan(&sectionID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrNoRowsAffected
+		}
+		return err
+	}
+
+	return nil
+}
+
+// CreateMeeting inserts a new meeting of a section into the database.
+func (repo *SectionDB) CreateMeeting(ctx context.Context, meeting Meeting) (Meeting, error) {
+	query := `
+		INSERT INTO section_meetings (section_id, day_of_week, start_time, end_time, room)
+		VALUES (?, ?, ?, ?, ?)
+	`
+	result, err := transaction.GetExecutor(ctx, repo.DB).ExecContext(ctx, query, meeting.SectionID, meeting.DayOfWeek, meeting.StartTime, meeting.EndTime, meeting.Room)
+	if err != nil {
+		return Meeting{}, fmt.Errorf("failed to create meeting: %v", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return Meeting{}, err
+	}
+
+	meeting.ID = id
+	return meeting, nil
+}
+
+// GetMeetingsBySectionIDs retrieves the meetings of the given sections ordered by section, day and start time.
+// IDs are queried in chunks of common.MaxInClauseIDs.
+func (repo *SectionDB) GetMeetingsBySectionIDs(ctx context.Context, sectionIDs []int64) ([]Meeting, error) {
+	var meetings []Meeting
+	for _, chunk := range common.ChunkIDs(sectionIDs, common.MaxInClauseIDs) {
+		placeholders, args := common.InClause(chunk)
+		query := `
+			SELECT id, section_id, day_of_week, start_time, end_time, room
+			FROM section_meetings
+			WHERE section_id IN (` + placeholders + `)
+			ORDER BY section_id, day_of_week, start_time
+		`
+		rows, err := transaction.GetExecutor(ctx, repo.DB).QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve meetings: %v", err)
+		}
+
+		for rows.Next() {
+			var meeting Meeting
+			if err := rows.Scan(&meeting.ID, &meeting.SectionID, &meeting.DayOfWeek, &meeting.StartTime, &meeting.EndTime, &meeting.Room); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			meetings = append(meetings, meeting)
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return meetings, nil
+}
+
+// querySections runs a query selecting every column of the course_sections table.
+func (repo *SectionDB) querySections(ctx context.Context, query string, args ...interface{}) ([]Section, error) {
+	rows, err := transaction.GetExecutor(ctx, repo.DB).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve sections: %v", err)
+	}
+	defer rows.Close()
+
+	var sections []Section
+	for rows.Next() {
+		var section Section
+		if err := rows.Scan(&section.ID, &section.CourseID, &section.TermID, &section.Capacity, &section.CreateTime, &section.UpdateTime); err != nil {
+			return nil, err
+		}
+		sections = append(sections, section)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return sections, nil
+}
@@ -0,0 +1,256 @@
+package sectiondomain
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestSectionDB_GetSectionByID(t *testing.T) {
+	const sectionQuery = `SELECT id, course_id, term_id, capacity, create_time, update_time FROM course_sections WHERE id = \?`
+
+	timestamp := time.Date(2024, time.August, 1, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx context.Context
+		id  int64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *Section
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(sectionQuery).
+						WithArgs(int64(1)).
+						WillReturnRows(sqlmock.NewRows([]string{"id", "course_id", "term_id", "capacity", "create_time", "update_time"}).
+							AddRow(1, 101, 2, 30, timestamp, timestamp))
+					return db
+				}(),
+			},
+			args:    args{ctx: context.Background(), id: 1},
+			want:    &Section{ID: 1, CourseID: 101, TermID: 2, Capacity: 30, CreateTime: timestamp, UpdateTime: timestamp},
+			wantErr: false,
+		},
+		{
+			name: "Section Not Found",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(sectionQuery).
+						WithArgs(int64(1)).
+						WillReturnError(sql.ErrNoRows)
+					return db
+				}(),
+			},
+			args:    args{ctx: context.Background(), id: 1},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "Query Error",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(sectionQuery).
+						WithArgs(int64(1)).
+						WillReturnError(sql.ErrConnDone)
+					return db
+				}(),
+			},
+			args:    args{ctx: context.Background(), id: 1},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &SectionDB{
+				DB: tt.fields.DB,
+			}
+			got, err := repo.GetSectionByID(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SectionDB.GetSectionByID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SectionDB.GetSectionByID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSectionDB_GetSectionsByCourseID(t *testing.T) {
+	const sectionsQuery = `SELECT id, course_id, term_id, capacity, create_time, update_time FROM course_sections WHERE course_id = \? ORDER BY term_id, id`
+
+	timestamp := time.Date(2024, time.August, 1, 0, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error creating mock database: %v", err)
+	}
+	defer db.Close()
+	mock.ExpectQuery(sectionsQuery).
+		WithArgs(int64(101)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "course_id", "term_id", "capacity", "create_time", "update_time"}).
+			AddRow(1, 101, 1, 30, timestamp, timestamp).
+			AddRow(4, 101, 2, 0, timestamp, timestamp))
+
+	repo := &SectionDB{DB: db}
+	got, err := repo.GetSectionsByCourseID(context.Background(), 101)
+	if err != nil {
+		t.Fatalf("SectionDB.GetSectionsByCourseID() error = %v", err)
+	}
+	want := []Section{
+		{ID: 1, CourseID: 101, TermID: 1, Capacity: 30, CreateTime: timestamp, UpdateTime: timestamp},
+		{ID: 4, CourseID: 101, TermID: 2, Capacity: 0, CreateTime: timestamp, UpdateTime: timestamp},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SectionDB.GetSectionsByCourseID() = %v, want %v", got, want)
+	}
+}
+
+func TestSectionDB_GetMeetingsBySectionIDs(t *testing.T) {
+	const meetingsQuery = `SELECT id, section_id, day_of_week, start_time, end_time, room FROM section_meetings WHERE section_id IN \(\?, \?\) ORDER BY section_id, day_of_week, start_time`
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error creating mock database: %v", err)
+	}
+	defer db.Close()
+	mock.ExpectQuery(meetingsQuery).
+		WithArgs(int64(1), int64(4)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "section_id", "day_of_week", "start_time", "end_time", "room"}).
+			AddRow(1, 1, 1, []byte("09:00:00"), []byte("10:30:00"), "B-101").
+			AddRow(2, 4, 3, []byte("13:00:00"), []byte("14:00:00"), ""))
+
+	repo := &SectionDB{DB: db}
+	got, err := repo.GetMeetingsBySectionIDs(context.Background(), []int64{1, 4})
+	if err != nil {
+		t.Fatalf("SectionDB.GetMeetingsBySectionIDs() error = %v", err)
+	}
+	want := []Meeting{
+		{ID: 1, SectionID: 1, DayOfWeek: Monday, StartTime: 9 * 60, EndTime: 10*60 + 30, Room: "B-101"},
+		{ID: 2, SectionID: 4, DayOfWeek: Wednesday, StartTime: 13 * 60, EndTime: 14 * 60},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SectionDB.GetMeetingsBySectionIDs() = %v, want %v", got, want)
+	}
+}
+
+func TestSectionDB_CreateMeeting(t *testing.T) {
+	const insertQuery = `INSERT INTO section_meetings \(section_id, day_of_week, start_time, end_time, room\) VALUES \(\?, \?, \?, \?, \?\)`
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error creating mock database: %v", err)
+	}
+	defer db.Close()
+	mock.ExpectExec(insertQuery).
+		WithArgs(int64(1), int64(1), "09:00:00", "10:30:00", "B-101").
+		WillReturnResult(sqlmock.NewResult(5, 1))
+
+	repo := &SectionDB{DB: db}
+	got, err := repo.CreateMeeting(context.Background(), Meeting{SectionID: 1, DayOfWeek: Monday, StartTime: 9 * 60, EndTime: 10*60 + 30, Room: "B-101"})
+	if err != nil {
+		t.Fatalf("SectionDB.CreateMeeting() error = %v", err)
+	}
+	want := Meeting{ID: 5, SectionID: 1, DayOfWeek: Monday, StartTime: 9 * 60, EndTime: 10*60 + 30, Room: "B-101"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SectionDB.CreateMeeting() = %v, want %v", got, want)
+	}
+}
+
+func TestSectionDB_LockSectionByID(t *testing.T) {
+	const lockQuery = `SELECT id FROM course_sections WHERE id = \? FOR UPDATE`
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx context.Context
+		id  int64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(lockQuery).
+						WithArgs(int64(1)).
+						WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+					return db
+				}(),
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  1,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Section Not Found",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(lockQuery).
+						WithArgs(int64(1)).
+						WillReturnError(sql.ErrNoRows)
+					return db
+				}(),
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  1,
+			},
+			wantErr: ErrNoRowsAffected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &SectionDB{
+				DB: tt.fields.DB,
+			}
+			err := repo.LockSectionByID(tt.args.ctx, tt.args.id)
+			if err != tt.wantErr {
+				t.Errorf("SectionDB.LockSectionByID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
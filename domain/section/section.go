@@ -0,0 +1,121 @@
+package sectiondomain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	common "github/rakadityas/course-management-system/common"
+)
+
+var (
+	// ErrInvalidSectionCapacity is returned when a section capacity is negative.
+	ErrInvalidSectionCapacity = errors.New("invalid section capacity")
+	// ErrInvalidMeeting is returned when a meeting has an unknown day or does not end after it starts.
+	ErrInvalidMeeting = errors.New("invalid meeting time")
+	// ErrOverlappingMeetings is returned when two meetings of a section take place at the same time.
+	ErrOverlappingMeetings = errors.New("section meetings overlap")
+)
+
+type SectionDomainItf interface {
+	CreateSection(ctx context.Context, courseID, termID int64, capacity int) (Section, error)
+	GetSectionByID(ctx context.Context, id int64) (*Section, error)
+	GetSectionsByIDs(ctx context.Context, ids []int64) (map[int64]Section, error)
+	GetSectionsByCourseID(ctx context.Context, courseID int64) ([]Section, error)
+	LockSectionByID(ctx context.Context, id int64) error
+	AddMeetings(ctx context.Context, sectionID int64, meetings []Meeting) ([]Meeting, error)
+	GetMeetingsBySectionIDs(ctx context.Context, sectionIDs []int64) (map[int64][]Meeting, error)
+}
+
+type SectionService struct {
+	repo SectionRepository
+}
+
+func NewSectionService(repo SectionRepository) SectionDomainItf {
+	return &SectionService{repo: repo}
+}
+
+// CreateSection validates the capacity and offers the course in the term.
+func (s *SectionService) CreateSection(ctx context.Context, courseID, termID int64, capacity int) (Section, error) {
+	if capacity < 0 {
+		return Section{}, ErrInvalidSectionCapacity
+	}
+
+	section := NewSection(courseID, termID, capacity)
+	section.CreateTime = time.Now()
+	section.UpdateTime = section.CreateTime
+
+	return s.repo.CreateSection(ctx, section)
+}
+
+// GetSectionByID retrieves a section by its ID.
+func (s *SectionService) GetSectionByID(ctx context.Context, id int64) (*Section, error) {
+	return s.repo.GetSectionByID(ctx, id)
+}
+
+// GetSectionsByIDs retrieves the sections with the given IDs keyed by section ID.
+// IDs without a section are absent from the result.
+func (s *SectionService) GetSectionsByIDs(ctx context.Context, ids []int64) (map[int64]Section, error) {
+	sections, err := s.repo.GetSectionsByIDs(ctx, common.UniqueIDs(ids))
+	if err != nil {
+		return nil, err
+	}
+
+	sectionByID := make(map[int64]Section, len(sections))
+	for _, section := range sections {
+		sectionByID[section.ID] = section
+	}
+
+	return sectionByID, nil
+}
+
+// GetSectionsByCourseID retrieves the sections of a course ordered by term.
+func (s *SectionService) GetSectionsByCourseID(ctx context.Context, courseID int64) ([]Section, error) {
+	return s.repo.GetSectionsByCourseID(ctx, courseID)
+}
+
+// LockSectionByID locks the section for the rest of the surrounding unit of work.
+func (s *SectionService) LockSectionByID(ctx context.Context, id int64) error {
+	return s.repo.LockSectionByID(ctx, id)
+}
+
+// AddMeetings validates the weekly meetings of a section and stores them.
+// Meetings must not overlap each other; call it inside a unit of work to store all or none.
+func (s *SectionService) AddMeetings(ctx context.Context, sectionID int64, meetings []Meeting) ([]Meeting, error) {
+	for i, meeting := range meetings {
+		if !meeting.IsValid() {
+			return nil, ErrInvalidMeeting
+		}
+		if _, ok := FindOverlap(meetings[:i], []Meeting{meeting}); ok {
+			return nil, ErrOverlappingMeetings
+		}
+	}
+
+	created := make([]Meeting, 0, len(meetings))
+	for _, meeting := range meetings {
+		meeting.SectionID = sectionID
+		meeting, err := s.repo.CreateMeeting(ctx, meeting)
+		if err != nil {
+			return nil, err
+		}
+		created = append(created, meeting)
+	}
+
+	return created, nil
+}
+
+// GetMeetingsBySectionIDs retrieves the meetings of the given sections keyed by section ID,
+// ordered by day and start time. Sections without meetings are absent from the result.
+func (s *SectionService) GetMeetingsBySectionIDs(ctx context.Context, sectionIDs []int64) (map[int64][]Meeting, error) {
+	meetings, err := s.repo.GetMeetingsBySectionIDs(ctx, common.UniqueIDs(sectionIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	meetingsBySectionID := make(map[int64][]Meeting)
+	for _, meeting := range meetings {
+		meetingsBySectionID[meeting.SectionID] = append(meetingsBySectionID[meeting.SectionID], meeting)
+	}
+
+	return meetingsBySectionID, nil
+}
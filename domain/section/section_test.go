@@ -0,0 +1,47 @@
+package sectiondomain
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSectionService_AddMeetings_Validation(t *testing.T) {
+	tests := []struct {
+		name     string
+		meetings []Meeting
+		wantErr  error
+	}{
+		{
+			name:     "Unknown Day",
+			meetings: []Meeting{{DayOfWeek: DayOfWeek(9), StartTime: 9 * 60, EndTime: 10 * 60}},
+			wantErr:  ErrInvalidMeeting,
+		},
+		{
+			name:     "Ends Before It Starts",
+			meetings: []Meeting{{DayOfWeek: Monday, StartTime: 10 * 60, EndTime: 9 * 60}},
+			wantErr:  ErrInvalidMeeting,
+		},
+		{
+			name:     "Ends After Midnight",
+			meetings: []Meeting{{DayOfWeek: Monday, StartTime: 23 * 60, EndTime: MinutesPerDay + 30}},
+			wantErr:  ErrInvalidMeeting,
+		},
+		{
+			name: "Meetings Overlap",
+			meetings: []Meeting{
+				{DayOfWeek: Monday, StartTime: 9 * 60, EndTime: 11 * 60},
+				{DayOfWeek: Monday, StartTime: 10 * 60, EndTime: 12 * 60},
+			},
+			wantErr: ErrOverlappingMeetings,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &SectionService{}
+			if _, err := service.AddMeetings(context.Background(), 1, tt.meetings); err != tt.wantErr {
+				t.Errorf("SectionService.AddMeetings() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
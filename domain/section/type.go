@@ -0,0 +1,60 @@
+package sectiondomain
+
+import "time"
+
+// Section is an offering of a course in a term. Students enroll in a section.
+type Section struct {
+	ID         int64
+	CourseID   int64
+	TermID     int64
+	Capacity   int // maximum active enrollments, 0 means unlimited
+	CreateTime time.Time
+	UpdateTime time.Time
+}
+
+func NewSection(courseID, termID int64, capacity int) Section {
+	return Section{
+		CourseID: courseID,
+		TermID:   termID,
+		Capacity: capacity,
+	}
+}
+
+// IsFull reports whether the given number of active enrollments has reached the section capacity.
+func (s Section) IsFull(activeEnrollments int) bool {
+	return s.Capacity > 0 && activeEnrollments >= s.Capacity
+}
+
+// Meeting is a weekly class meeting of a section, from StartTime until EndTime.
+type Meeting struct {
+	ID        int64
+	SectionID int64
+	DayOfWeek DayOfWeek
+	StartTime ClockTime
+	EndTime   ClockTime
+	Room      string
+}
+
+// IsValid reports whether the meeting falls on a weekday and ends after it starts on the same day.
+func (m Meeting) IsValid() bool {
+	return m.DayOfWeek.IsValid() && m.StartTime >= 0 && m.StartTime < m.EndTime && m.EndTime <= MinutesPerDay
+}
+
+// Overlaps reports whether the two meetings take place at the same time.
+// A meeting may start at the time another one ends.
+func (m Meeting) Overlaps(other Meeting) bool {
+	return m.DayOfWeek == other.DayOfWeek && m.StartTime < other.EndTime && other.StartTime < m.EndTime
+}
+
+// FindOverlap returns the first meeting of others that overlaps any of meetings.
+func FindOverlap(meetings, others []Meeting) (Meeting, bool) {
+	for _, other := range others {
+		for _, meeting := range meetings {
+			if meeting.Overlaps(other) {
+				return other, true
+			}
+		}
+	}
+
+	return Meeting{}, false
+}
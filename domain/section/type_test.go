@@ -0,0 +1,31 @@
+package sectiondomain
+
+import "testing"
+
+func TestMeeting_Overlaps(t *testing.T) {
+	monday := Meeting{DayOfWeek: Monday, StartTime: 9 * 60, EndTime: 10*60 + 30}
+
+	tests := []struct {
+		name  string
+		other Meeting
+		want  bool
+	}{
+		{name: "Same Time", other: monday, want: true},
+		{name: "Starts During", other: Meeting{DayOfWeek: Monday, StartTime: 10 * 60, EndTime: 11 * 60}, want: true},
+		{name: "Contains", other: Meeting{DayOfWeek: Monday, StartTime: 8 * 60, EndTime: 12 * 60}, want: true},
+		{name: "Starts When It Ends", other: Meeting{DayOfWeek: Monday, StartTime: 10*60 + 30, EndTime: 11 * 60}, want: false},
+		{name: "Ends When It Starts", other: Meeting{DayOfWeek: Monday, StartTime: 8 * 60, EndTime: 9 * 60}, want: false},
+		{name: "Another Day", other: Meeting{DayOfWeek: Tuesday, StartTime: 9 * 60, EndTime: 10*60 + 30}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := monday.Overlaps(tt.other); got != tt.want {
+				t.Errorf("Meeting.Overlaps() = %v, want %v", got, tt.want)
+			}
+			if got := tt.other.Overlaps(monday); got != tt.want {
+				t.Errorf("Meeting.Overlaps() reversed = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
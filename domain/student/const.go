@@ -0,0 +1,27 @@
+package studentdomain
+
+// Visibility controls who may see a student on course listings. The values are stored in
+// students.visibility.
+type Visibility string
+
+const (
+	// VisibilityClassmates lists the student and their email to classmates and instructors.
+	VisibilityClassmates Visibility = "classmates"
+	// VisibilityInstructors lists the student and their email to instructors only.
+	VisibilityInstructors Visibility = "instructors"
+	// VisibilityHidden never lists the student to classmates and withholds their email from instructors.
+	VisibilityHidden Visibility = "hidden"
+)
+
+// DefaultVisibility applies to students who never changed their privacy settings.
+const DefaultVisibility = VisibilityClassmates
+
+// IsValid reports whether v is a known visibility.
+func (v Visibility) IsValid() bool {
+	return v == VisibilityClassmates || v == VisibilityInstructors || v == VisibilityHidden
+}
+
+// VisibleToClassmates reports whether the student may be listed to classmates.
+func (v Visibility) VisibleToClassmates() bool {
+	return v == VisibilityClassmates
+}
@@ -6,7 +6,7 @@ package studentdomain
 
 import (
 	context "context"
-	student "github/rakadityas/course-management-system/domain/student"
+	studentdomain "github/rakadityas/course-management-system/domain/student"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -35,11 +35,40 @@ func (m *MockStudentDomainItf) EXPECT() *MockStudentDomainItfMockRecorder {
 	return m.recorder
 }
 
+// CreateStudent mocks base method.
+func (m *MockStudentDomainItf) CreateStudent(ctx context.Context, email string) (studentdomain.Student, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStudent", ctx, email)
+	ret0, _ := ret[0].(studentdomain.Student)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStudent indicates an expected call of CreateStudent.
+func (mr *MockStudentDomainItfMockRecorder) CreateStudent(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStudent", reflect.TypeOf((*MockStudentDomainItf)(nil).CreateStudent), ctx, email)
+}
+
+// DeleteStudent mocks base method.
+func (m *MockStudentDomainItf) DeleteStudent(ctx context.Context, studentID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStudent", ctx, studentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteStudent indicates an expected call of DeleteStudent.
+func (mr *MockStudentDomainItfMockRecorder) DeleteStudent(ctx, studentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStudent", reflect.TypeOf((*MockStudentDomainItf)(nil).DeleteStudent), ctx, studentID)
+}
+
 // GetStudentByID mocks base method.
-func (m *MockStudentDomainItf) GetStudentByID(ctx context.Context, studentID int64) (*student.Student, error) {
+func (m *MockStudentDomainItf) GetStudentByID(ctx context.Context, studentID int64) (*studentdomain.Student, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetStudentByID", ctx, studentID)
-	ret0, _ := ret[0].(*student.Student)
+	ret0, _ := ret[0].(*studentdomain.Student)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -49,3 +78,75 @@ func (mr *MockStudentDomainItfMockRecorder) GetStudentByID(ctx, studentID interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStudentByID", reflect.TypeOf((*MockStudentDomainItf)(nil).GetStudentByID), ctx, studentID)
 }
+
+// GetStudents mocks base method.
+func (m *MockStudentDomainItf) GetStudents(ctx context.Context, limit, offset int) ([]studentdomain.Student, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStudents", ctx, limit, offset)
+	ret0, _ := ret[0].([]studentdomain.Student)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStudents indicates an expected call of GetStudents.
+func (mr *MockStudentDomainItfMockRecorder) GetStudents(ctx, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStudents", reflect.TypeOf((*MockStudentDomainItf)(nil).GetStudents), ctx, limit, offset)
+}
+
+// GetStudentsByIDs mocks base method.
+func (m *MockStudentDomainItf) GetStudentsByIDs(ctx context.Context, studentIDs []int64) (map[int64]studentdomain.Student, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStudentsByIDs", ctx, studentIDs)
+	ret0, _ := ret[0].(map[int64]studentdomain.Student)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStudentsByIDs indicates an expected call of GetStudentsByIDs.
+func (mr *MockStudentDomainItfMockRecorder) GetStudentsByIDs(ctx, studentIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStudentsByIDs", reflect.TypeOf((*MockStudentDomainItf)(nil).GetStudentsByIDs), ctx, studentIDs)
+}
+
+// LockStudentByID mocks base method.
+func (m *MockStudentDomainItf) LockStudentByID(ctx context.Context, studentID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockStudentByID", ctx, studentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockStudentByID indicates an expected call of LockStudentByID.
+func (mr *MockStudentDomainItfMockRecorder) LockStudentByID(ctx, studentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockStudentByID", reflect.TypeOf((*MockStudentDomainItf)(nil).LockStudentByID), ctx, studentID)
+}
+
+// UpdatePrivacySettings mocks base method.
+func (m *MockStudentDomainItf) UpdatePrivacySettings(ctx context.Context, studentID int64, settings studentdomain.PrivacySettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePrivacySettings", ctx, studentID, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePrivacySettings indicates an expected call of UpdatePrivacySettings.
+func (mr *MockStudentDomainItfMockRecorder) UpdatePrivacySettings(ctx, studentID, settings interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePrivacySettings", reflect.TypeOf((*MockStudentDomainItf)(nil).UpdatePrivacySettings), ctx, studentID, settings)
+}
+
+// UpdateStudentEmail mocks base method.
+func (m *MockStudentDomainItf) UpdateStudentEmail(ctx context.Context, studentID int64, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStudentEmail", ctx, studentID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStudentEmail indicates an expected call of UpdateStudentEmail.
+func (mr *MockStudentDomainItfMockRecorder) UpdateStudentEmail(ctx, studentID, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStudentEmail", reflect.TypeOf((*MockStudentDomainItf)(nil).UpdateStudentEmail), ctx, studentID, email)
+}
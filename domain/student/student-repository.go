@@ -3,12 +3,31 @@ package studentdomain
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"time"
+
+	common "github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/transaction"
+)
+
+var (
+	// ErrNoRowsAffected is returned when an update does not match any active student.
+	ErrNoRowsAffected = errors.New("no rows were updated")
+	// ErrEmailAlreadyExists is returned when another live student has the email, deleted students release theirs.
+	ErrEmailAlreadyExists = errors.New("email is already registered")
 )
 
 // StudentRepository defines the interface for student-related database operations.
 type StudentRepository interface {
 	GetStudentByID(ctx context.Context, id int64) (*Student, error)
+	GetStudentsByIDs(ctx context.Context, ids []int64) ([]Student, error)
+	LockStudentByID(ctx context.Context, id int64) error
+	CreateStudent(ctx context.Context, student Student) (Student, error)
+	UpdateStudentEmail(ctx context.Context, id int64, email string, updateTime time.Time) error
+	UpdatePrivacySettings(ctx context.Context, id int64, settings PrivacySettings, updateTime time.Time) error
+	GetStudents(ctx context.Context, limit, offset int) ([]Student, error)
+	DeleteStudent(ctx context.Context, id int64, deleteTime time.Time) error
 }
 
 // StudentDB implements the StudentRepository interface using a SQL database.
@@ -22,16 +41,17 @@ func NewSQLStudentRepository(db *sql.DB) *StudentDB {
 }
 
 // GetStudentByID retrieves a student from the database by their ID.
+// Soft-deleted students are treated as not found.
 func (repo *StudentDB) GetStudentByID(ctx context.Context, id int64) (*Student, error) {
 	query := `
-		SELECT id, email, create_time, update_time
+		SELECT id, email, visibility, show_email, create_time, update_time
 		FROM students
-		WHERE id = ?
+		WHERE id = ? AND delete_time IS NULL
 	`
-	row := repo.DB.QueryRowContext(ctx, query, id)
+	row := transaction.GetExecutor(ctx, repo.DB).QueryRowContext(ctx, query, id)
 
 	student := &Student{}
-	err := row.Scan(&student.ID, &student.Email, &student.CreateTime, &student.UpdateTime)
+	err := row.Scan(&student.ID, &student.Email, &student.Privacy.Visibility, &student.Privacy.ShowEmail, &student.CreateTime, &student.UpdateTime)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil // No student found
@@ -41,3 +61,192 @@ func (repo *StudentDB) GetStudentByID(ctx context.Context, id int64) (*Student,
 
 	return student, nil
 }
+
+// GetStudentsByIDs retrieves the students with the given IDs.
+// IDs are queried in chunks of common.MaxInClauseIDs; unknown and soft-deleted students are skipped.
+func (repo *StudentDB) GetStudentsByIDs(ctx context.Context, ids []int64) ([]Student, error) {
+	var students []Student
+	for _, chunk := range common.ChunkIDs(ids, common.MaxInClauseIDs) {
+		placeholders, args := common.InClause(chunk)
+		query := `
+			SELECT id, email, visibility, show_email, create_time, update_time
+			FROM students
+			WHERE id IN (` + placeholders + `) AND delete_time IS NULL
+		`
+		rows, err := transaction.GetExecutor(ctx, repo.DB).QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve students: %v", err)
+		}
+
+		for rows.Next() {
+			var student Student
+			if err := rows.Scan(&student.ID, &student.Email, &student.Privacy.Visibility, &student.Privacy.ShowEmail, &student.CreateTime, &student.UpdateTime); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			students = append(students, student)
+		}
+
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return students, nil
+}
+
+// LockStudentByID takes a row lock on the student until the surrounding transaction ends,
+// serializing concurrent sign-ups of the same student.
+// Returns ErrNoRowsAffected if the student does not exist or is deleted.
+func (repo *StudentDB) LockStudentByID(ctx context.Context, id int64) error {
+	query := `
+		SELECT id
+		FROM students
+		WHERE id = ? AND delete_time IS NULL
+		FOR UPDATE
+	`
+	var studentID int64
+	err := transaction.GetExecutor(ctx, repo.DB).QueryRowContext(ctx, query, id).Scan(&studentID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrNoRowsAffected
+		}
+		return err
+	}
+	return nil
+}
+
+// CreateStudent inserts a new student record into the database.
+// Returns ErrEmailAlreadyExists if the email is already taken.
+func (repo *StudentDB) CreateStudent(ctx context.Context, student Student) (Student, error) {
+	query := `
+		INSERT INTO students (email, create_time, update_time)
+		VALUES (?, ?, ?)
+	`
+	result, err := transaction.GetExecutor(ctx, repo.DB).ExecContext(ctx, query, student.Email, student.CreateTime, student.UpdateTime)
+	if err != nil {
+		if common.IsDuplicateEntryError(err) {
+			return Student{}, ErrEmailAlreadyExists
+		}
+		return Student{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return Student{}, err
+	}
+
+	student.ID = id
+	return student, nil
+}
+
+// UpdateStudentEmail updates the email of an active student.
+// Returns ErrEmailAlreadyExists if the email is already taken and ErrNoRowsAffected if no student was updated.
+func (repo *StudentDB) UpdateStudentEmail(ctx context.Context, id int64, email string, updateTime time.Time) error {
+	query := `
+		UPDATE students
+		SET email = ?, update_time = ?
+		WHERE id = ? AND delete_time IS NULL
+	`
+	result, err := transaction.GetExecutor(ctx, repo.DB).ExecContext(ctx, query, email, updateTime, id)
+	if err != nil {
+		if common.IsDuplicateEntryError(err) {
+			return ErrEmailAlreadyExists
+		}
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// UpdatePrivacySettings replaces the privacy settings of an active student.
+// Returns ErrNoRowsAffected if no student was updated.
+func (repo *StudentDB) UpdatePrivacySettings(ctx context.Context, id int64, settings PrivacySettings, updateTime time.Time) error {
+	query := `
+		UPDATE students
+		SET visibility = ?, show_email = ?, update_time = ?
+		WHERE id = ? AND delete_time IS NULL
+	`
+	result, err := transaction.GetExecutor(ctx, repo.DB).ExecContext(ctx, query, settings.Visibility, settings.ShowEmail, updateTime, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// GetStudents retrieves a page of active students ordered by ID.
+func (repo *StudentDB) GetStudents(ctx context.Context, limit, offset int) ([]Student, error) {
+	query := `
+		SELECT id, email, visibility, show_email, create_time, update_time
+		FROM students
+		WHERE delete_time IS NULL
+		ORDER BY id
+		LIMIT ? OFFSET ?
+	`
+	rows, err := transaction.GetExecutor(ctx, repo.DB).QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var students []Student
+	for rows.Next() {
+		var student Student
+		if err := rows.Scan(&student.ID, &student.Email, &student.Privacy.Visibility, &student.Privacy.ShowEmail, &student.CreateTime, &student.UpdateTime); err != nil {
+			return nil, err
+		}
+		students = append(students, student)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return students, nil
+}
+
+// DeleteStudent soft-deletes a student by setting its delete time.
+// Returns ErrNoRowsAffected if the student does not exist or is already deleted.
+func (repo *StudentDB) DeleteStudent(ctx context.Context, id int64, deleteTime time.Time) error {
+	query := `
+		UPDATE students
+		SET delete_time = ?, update_time = ?
+		WHERE id = ? AND delete_time IS NULL
+	`
+	result, err := transaction.GetExecutor(ctx, repo.DB).ExecContext(ctx, query, deleteTime, deleteTime, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
@@ -3,11 +3,13 @@ package studentdomain
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
 )
 
 func TestStudentDB_GetStudentByID(t *testing.T) {
@@ -41,9 +43,9 @@ func TestStudentDB_GetStudentByID(t *testing.T) {
 					if err != nil {
 						t.Fatalf("error creating mock database: %v", err)
 					}
-					rows := sqlmock.NewRows([]string{"id", "email", "create_time", "update_time"}).
-						AddRow(studentID, studentEmail, constCreateTime, constUpdateTime)
-					mock.ExpectQuery("SELECT id, email, create_time, update_time FROM students WHERE id = ?").
+					rows := sqlmock.NewRows([]string{"id", "email", "visibility", "show_email", "create_time", "update_time"}).
+						AddRow(studentID, studentEmail, "classmates", false, constCreateTime, constUpdateTime)
+					mock.ExpectQuery("SELECT id, email, visibility, show_email, create_time, update_time FROM students WHERE id = ?").
 						WithArgs(studentID).
 						WillReturnRows(rows)
 					return db
@@ -56,6 +58,7 @@ func TestStudentDB_GetStudentByID(t *testing.T) {
 			want: &Student{
 				ID:         studentID,
 				Email:      studentEmail,
+				Privacy:    PrivacySettings{Visibility: VisibilityClassmates},
 				CreateTime: constCreateTime,
 				UpdateTime: constUpdateTime,
 			},
@@ -69,9 +72,9 @@ func TestStudentDB_GetStudentByID(t *testing.T) {
 					if err != nil {
 						t.Fatalf("error creating mock database: %v", err)
 					}
-					mock.ExpectQuery("SELECT id, email, create_time, update_time FROM students WHERE id = ?").
+					mock.ExpectQuery("SELECT id, email, visibility, show_email, create_time, update_time FROM students WHERE id = ?").
 						WithArgs(studentID).
-						WillReturnRows(sqlmock.NewRows([]string{"id", "email", "create_time", "update_time"}))
+						WillReturnRows(sqlmock.NewRows([]string{"id", "email", "visibility", "show_email", "create_time", "update_time"}))
 					return db
 				}(),
 			},
@@ -90,7 +93,7 @@ func TestStudentDB_GetStudentByID(t *testing.T) {
 					if err != nil {
 						t.Fatalf("error creating mock database: %v", err)
 					}
-					mock.ExpectQuery("SELECT id, email, create_time, update_time FROM students WHERE id = ?").
+					mock.ExpectQuery("SELECT id, email, visibility, show_email, create_time, update_time FROM students WHERE id = ?").
 						WithArgs(studentID).
 						WillReturnError(sql.ErrConnDone)
 					return db
@@ -121,3 +124,628 @@ func TestStudentDB_GetStudentByID(t *testing.T) {
 		})
 	}
 }
+
+func TestStudentDB_CreateStudent(t *testing.T) {
+	const studentEmail = "test@example.com"
+
+	constCreateTime := time.Date(2023, 8, 25, 0, 0, 0, 0, time.UTC)
+	constUpdateTime := time.Date(2023, 8, 25, 1, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx     context.Context
+		student Student
+	}
+	tests := []struct {
+		name      string
+		fields    fields
+		args      args
+		want      Student
+		wantErr   bool
+		wantErrIs error
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectExec("INSERT INTO students").
+						WithArgs(studentEmail, constCreateTime, constUpdateTime).
+						WillReturnResult(sqlmock.NewResult(1, 1))
+					return db
+				}(),
+			},
+			args: args{
+				ctx:     context.Background(),
+				student: Student{Email: studentEmail, CreateTime: constCreateTime, UpdateTime: constUpdateTime},
+			},
+			want:    Student{ID: 1, Email: studentEmail, CreateTime: constCreateTime, UpdateTime: constUpdateTime},
+			wantErr: false,
+		},
+		{
+			name: "Duplicate Email",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectExec("INSERT INTO students").
+						WithArgs(studentEmail, constCreateTime, constUpdateTime).
+						WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'test@example.com' for key 'students.email'"})
+					return db
+				}(),
+			},
+			args: args{
+				ctx:     context.Background(),
+				student: Student{Email: studentEmail, CreateTime: constCreateTime, UpdateTime: constUpdateTime},
+			},
+			want:      Student{},
+			wantErr:   true,
+			wantErrIs: ErrEmailAlreadyExists,
+		},
+		{
+			name: "Error",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectExec("INSERT INTO students").
+						WithArgs(studentEmail, constCreateTime, constUpdateTime).
+						WillReturnError(sql.ErrConnDone)
+					return db
+				}(),
+			},
+			args: args{
+				ctx:     context.Background(),
+				student: Student{Email: studentEmail, CreateTime: constCreateTime, UpdateTime: constUpdateTime},
+			},
+			want:    Student{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &StudentDB{
+				DB: tt.fields.DB,
+			}
+			got, err := repo.CreateStudent(tt.args.ctx, tt.args.student)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StudentDB.CreateStudent() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("StudentDB.CreateStudent() error = %v, wantErrIs %v", err, tt.wantErrIs)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StudentDB.CreateStudent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStudentDB_UpdateStudentEmail(t *testing.T) {
+	const (
+		studentID    int64 = 1
+		studentEmail       = "new@example.com"
+	)
+	constUpdateTime := time.Date(2023, 8, 25, 1, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx        context.Context
+		id         int64
+		email      string
+		updateTime time.Time
+	}
+	tests := []struct {
+		name      string
+		fields    fields
+		args      args
+		wantErr   bool
+		wantErrIs error
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectExec(`UPDATE students SET email = \?, update_time = \? WHERE id = \? AND delete_time IS NULL`).
+						WithArgs(studentEmail, constUpdateTime, studentID).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					return db
+				}(),
+			},
+			args:    args{ctx: context.Background(), id: studentID, email: studentEmail, updateTime: constUpdateTime},
+			wantErr: false,
+		},
+		{
+			name: "No Rows Affected",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectExec(`UPDATE students SET email = \?, update_time = \? WHERE id = \? AND delete_time IS NULL`).
+						WithArgs(studentEmail, constUpdateTime, studentID).
+						WillReturnResult(sqlmock.NewResult(0, 0))
+					return db
+				}(),
+			},
+			args:      args{ctx: context.Background(), id: studentID, email: studentEmail, updateTime: constUpdateTime},
+			wantErr:   true,
+			wantErrIs: ErrNoRowsAffected,
+		},
+		{
+			name: "Duplicate Email",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectExec(`UPDATE students SET email = \?, update_time = \? WHERE id = \? AND delete_time IS NULL`).
+						WithArgs(studentEmail, constUpdateTime, studentID).
+						WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+					return db
+				}(),
+			},
+			args:      args{ctx: context.Background(), id: studentID, email: studentEmail, updateTime: constUpdateTime},
+			wantErr:   true,
+			wantErrIs: ErrEmailAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &StudentDB{
+				DB: tt.fields.DB,
+			}
+			err := repo.UpdateStudentEmail(tt.args.ctx, tt.args.id, tt.args.email, tt.args.updateTime)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StudentDB.UpdateStudentEmail() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("StudentDB.UpdateStudentEmail() error = %v, wantErrIs %v", err, tt.wantErrIs)
+			}
+		})
+	}
+}
+
+func TestStudentDB_UpdatePrivacySettings(t *testing.T) {
+	const studentID int64 = 1
+	constUpdateTime := time.Date(2023, 8, 25, 1, 0, 0, 0, time.UTC)
+	settings := PrivacySettings{Visibility: VisibilityInstructors, ShowEmail: true}
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx        context.Context
+		id         int64
+		settings   PrivacySettings
+		updateTime time.Time
+	}
+	tests := []struct {
+		name      string
+		fields    fields
+		args      args
+		wantErr   bool
+		wantErrIs error
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectExec(`UPDATE students SET visibility = \?, show_email = \?, update_time = \? WHERE id = \? AND delete_time IS NULL`).
+						WithArgs("instructors", true, constUpdateTime, studentID).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					return db
+				}(),
+			},
+			args:    args{ctx: context.Background(), id: studentID, settings: settings, updateTime: constUpdateTime},
+			wantErr: false,
+		},
+		{
+			name: "No Rows Affected",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectExec(`UPDATE students SET visibility = \?, show_email = \?, update_time = \? WHERE id = \? AND delete_time IS NULL`).
+						WithArgs("instructors", true, constUpdateTime, studentID).
+						WillReturnResult(sqlmock.NewResult(0, 0))
+					return db
+				}(),
+			},
+			args:      args{ctx: context.Background(), id: studentID, settings: settings, updateTime: constUpdateTime},
+			wantErr:   true,
+			wantErrIs: ErrNoRowsAffected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &StudentDB{
+				DB: tt.fields.DB,
+			}
+			err := repo.UpdatePrivacySettings(tt.args.ctx, tt.args.id, tt.args.settings, tt.args.updateTime)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StudentDB.UpdatePrivacySettings() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("StudentDB.UpdatePrivacySettings() error = %v, wantErrIs %v", err, tt.wantErrIs)
+			}
+		})
+	}
+}
+
+func TestStudentDB_GetStudents(t *testing.T) {
+	timestamp := time.Date(2024, 8, 25, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx    context.Context
+		limit  int
+		offset int
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []Student
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					rows := sqlmock.NewRows([]string{"id", "email", "visibility", "show_email", "create_time", "update_time"}).
+						AddRow(1, "student1@example.com", "classmates", true, timestamp, timestamp).
+						AddRow(2, "student2@example.com", "hidden", false, timestamp, timestamp)
+					mock.ExpectQuery(`SELECT id, email, visibility, show_email, create_time, update_time FROM students WHERE delete_time IS NULL ORDER BY id LIMIT \? OFFSET \?`).
+						WithArgs(2, 0).
+						WillReturnRows(rows)
+					return db
+				}(),
+			},
+			args: args{ctx: context.Background(), limit: 2, offset: 0},
+			want: []Student{
+				{ID: 1, Email: "student1@example.com", Privacy: PrivacySettings{Visibility: VisibilityClassmates, ShowEmail: true}, CreateTime: timestamp, UpdateTime: timestamp},
+				{ID: 2, Email: "student2@example.com", Privacy: PrivacySettings{Visibility: VisibilityHidden}, CreateTime: timestamp, UpdateTime: timestamp},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Query Error",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(`SELECT id, email, visibility, show_email, create_time, update_time FROM students WHERE delete_time IS NULL ORDER BY id LIMIT \? OFFSET \?`).
+						WithArgs(2, 0).
+						WillReturnError(sql.ErrConnDone)
+					return db
+				}(),
+			},
+			args:    args{ctx: context.Background(), limit: 2, offset: 0},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &StudentDB{
+				DB: tt.fields.DB,
+			}
+			got, err := repo.GetStudents(tt.args.ctx, tt.args.limit, tt.args.offset)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StudentDB.GetStudents() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StudentDB.GetStudents() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStudentDB_DeleteStudent(t *testing.T) {
+	const studentID int64 = 1
+	deleteTime := time.Date(2024, 8, 25, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx        context.Context
+		id         int64
+		deleteTime time.Time
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectExec(`UPDATE students SET delete_time = \?, update_time = \? WHERE id = \? AND delete_time IS NULL`).
+						WithArgs(deleteTime, deleteTime, studentID).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					return db
+				}(),
+			},
+			args:    args{ctx: context.Background(), id: studentID, deleteTime: deleteTime},
+			wantErr: false,
+		},
+		{
+			name: "Already Deleted",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectExec(`UPDATE students SET delete_time = \?, update_time = \? WHERE id = \? AND delete_time IS NULL`).
+						WithArgs(deleteTime, deleteTime, studentID).
+						WillReturnResult(sqlmock.NewResult(0, 0))
+					return db
+				}(),
+			},
+			args:    args{ctx: context.Background(), id: studentID, deleteTime: deleteTime},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &StudentDB{
+				DB: tt.fields.DB,
+			}
+			err := repo.DeleteStudent(tt.args.ctx, tt.args.id, tt.args.deleteTime)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StudentDB.DeleteStudent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStudentDB_GetStudentsByIDs(t *testing.T) {
+	const studentsQuery = `SELECT id, email, visibility, show_email, create_time, update_time FROM students WHERE id IN \(\?, \?\) AND delete_time IS NULL`
+	timestamp := time.Date(2024, 8, 25, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx context.Context
+		ids []int64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []Student
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(studentsQuery).
+						WithArgs(int64(2), int64(3)).
+						WillReturnRows(sqlmock.NewRows([]string{"id", "email", "visibility", "show_email", "create_time", "update_time"}).
+							AddRow(2, "student2@example.com", "hidden", false, timestamp, timestamp))
+					return db
+				}(),
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: []int64{2, 3},
+			},
+			want: []Student{
+				{ID: 2, Email: "student2@example.com", Privacy: PrivacySettings{Visibility: VisibilityHidden}, CreateTime: timestamp, UpdateTime: timestamp},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Query Error",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(studentsQuery).
+						WithArgs(int64(2), int64(3)).
+						WillReturnError(errors.New("db error"))
+					return db
+				}(),
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: []int64{2, 3},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &StudentDB{
+				DB: tt.fields.DB,
+			}
+			got, err := repo.GetStudentsByIDs(tt.args.ctx, tt.args.ids)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StudentDB.GetStudentsByIDs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StudentDB.GetStudentsByIDs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStudentDB_DeleteThenCreateStudent(t *testing.T) {
+	const (
+		studentEmail       = "test@example.com"
+		studentID    int64 = 1
+	)
+	deleteTime := time.Date(2024, 8, 25, 0, 0, 0, 0, time.UTC)
+	createTime := time.Date(2024, 8, 26, 0, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error creating mock database: %v", err)
+	}
+	defer db.Close()
+	repo := &StudentDB{DB: db}
+	student := Student{Email: studentEmail, CreateTime: createTime, UpdateTime: createTime}
+
+	// The email is taken while its student is live
+	mock.ExpectExec("INSERT INTO students").
+		WithArgs(studentEmail, createTime, createTime).
+		WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'test@example.com' for key 'students.uq_students_live_email'"})
+	if _, err := repo.CreateStudent(context.Background(), student); !errors.Is(err, ErrEmailAlreadyExists) {
+		t.Fatalf("StudentDB.CreateStudent() error = %v, want %v", err, ErrEmailAlreadyExists)
+	}
+
+	// Deleting the student only sets delete_time, which releases the email
+	mock.ExpectExec(`UPDATE students SET delete_time = \?, update_time = \? WHERE id = \? AND delete_time IS NULL`).
+		WithArgs(deleteTime, deleteTime, studentID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	if err := repo.DeleteStudent(context.Background(), studentID, deleteTime); err != nil {
+		t.Fatalf("StudentDB.DeleteStudent() error = %v", err)
+	}
+
+	mock.ExpectExec("INSERT INTO students").
+		WithArgs(studentEmail, createTime, createTime).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	got, err := repo.CreateStudent(context.Background(), student)
+	if err != nil {
+		t.Fatalf("StudentDB.CreateStudent() error = %v", err)
+	}
+	want := Student{ID: 2, Email: studentEmail, CreateTime: createTime, UpdateTime: createTime}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("StudentDB.CreateStudent() = %v, want %v", got, want)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestStudentDB_LockStudentByID(t *testing.T) {
+	const lockQuery = `SELECT id FROM students WHERE id = \? AND delete_time IS NULL FOR UPDATE`
+
+	type fields struct {
+		DB *sql.DB
+	}
+	type args struct {
+		ctx context.Context
+		id  int64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(lockQuery).
+						WithArgs(int64(1)).
+						WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+					return db
+				}(),
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  1,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Student Not Found Or Deleted",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectQuery(lockQuery).
+						WithArgs(int64(1)).
+						WillReturnError(sql.ErrNoRows)
+					return db
+				}(),
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  1,
+			},
+			wantErr: ErrNoRowsAffected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &StudentDB{
+				DB: tt.fields.DB,
+			}
+			err := repo.LockStudentByID(tt.args.ctx, tt.args.id)
+			if err != tt.wantErr {
+				t.Errorf("StudentDB.LockStudentByID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
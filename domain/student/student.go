@@ -1,9 +1,32 @@
 package studentdomain
 
-import "context"
+import (
+	"context"
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	common "github/rakadityas/course-management-system/common"
+)
+
+var (
+	// ErrInvalidEmail is returned when the given email is not a valid address.
+	ErrInvalidEmail = errors.New("invalid email address")
+	// ErrInvalidVisibility is returned when the given visibility is not a known value.
+	ErrInvalidVisibility = errors.New("invalid visibility")
+)
 
 type StudentDomainItf interface {
 	GetStudentByID(ctx context.Context, studentID int64) (*Student, error)
+	GetStudentsByIDs(ctx context.Context, studentIDs []int64) (map[int64]Student, error)
+	LockStudentByID(ctx context.Context, studentID int64) error
+	CreateStudent(ctx context.Context, email string) (Student, error)
+	UpdateStudentEmail(ctx context.Context, studentID int64, email string) error
+	UpdatePrivacySettings(ctx context.Context, studentID int64, settings PrivacySettings) error
+	GetStudents(ctx context.Context, limit, offset int) ([]Student, error)
+	DeleteStudent(ctx context.Context, studentID int64) error
 }
 
 type StudentService struct {
@@ -18,3 +41,94 @@ func NewStudentService(repo StudentRepository) StudentDomainItf {
 func (s *StudentService) GetStudentByID(ctx context.Context, id int64) (*Student, error) {
 	return s.repo.GetStudentByID(ctx, id)
 }
+
+// GetStudentsByIDs retrieves the students with the given IDs keyed by student ID.
+// IDs without an active student are absent from the result.
+func (s *StudentService) GetStudentsByIDs(ctx context.Context, ids []int64) (map[int64]Student, error) {
+	students, err := s.repo.GetStudentsByIDs(ctx, common.UniqueIDs(ids))
+	if err != nil {
+		return nil, err
+	}
+
+	studentByID := make(map[int64]Student, len(students))
+	for _, student := range students {
+		studentByID[student.ID] = student
+	}
+
+	return studentByID, nil
+}
+
+// LockStudentByID locks the student for the rest of the surrounding unit of work.
+func (s *StudentService) LockStudentByID(ctx context.Context, id int64) error {
+	return s.repo.LockStudentByID(ctx, id)
+}
+
+// CreateStudent validates the email and registers a new student.
+func (s *StudentService) CreateStudent(ctx context.Context, email string) (Student, error) {
+	email, err := normalizeEmail(email)
+	if err != nil {
+		return Student{}, err
+	}
+
+	student := Student{
+		Email:      email,
+		Privacy:    PrivacySettings{Visibility: DefaultVisibility},
+		CreateTime: time.Now(),
+		UpdateTime: time.Now(),
+	}
+
+	return s.repo.CreateStudent(ctx, student)
+}
+
+// UpdateStudentEmail validates the email and replaces the student's current one.
+func (s *StudentService) UpdateStudentEmail(ctx context.Context, id int64, email string) error {
+	email, err := normalizeEmail(email)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.UpdateStudentEmail(ctx, id, email, time.Now())
+}
+
+// UpdatePrivacySettings validates and replaces the student's privacy settings.
+func (s *StudentService) UpdatePrivacySettings(ctx context.Context, id int64, settings PrivacySettings) error {
+	if !settings.Visibility.IsValid() {
+		return ErrInvalidVisibility
+	}
+
+	return s.repo.UpdatePrivacySettings(ctx, id, settings, time.Now())
+}
+
+// GetStudents retrieves a page of active students.
+func (s *StudentService) GetStudents(ctx context.Context, limit, offset int) ([]Student, error) {
+	return s.repo.GetStudents(ctx, limit, offset)
+}
+
+// DeleteStudent soft-deletes a student.
+func (s *StudentService) DeleteStudent(ctx context.Context, id int64) error {
+	return s.repo.DeleteStudent(ctx, id, time.Now())
+}
+
+// normalizeEmail trims and lowercases the email, rejecting anything that is not a bare address.
+func normalizeEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return "", ErrInvalidEmail
+	}
+
+	return email, nil
+}
+
+// MaskEmail hides all but the first character of the email's local part, so "jane@example.com"
+// becomes "j***@example.com". The domain is kept so classmates can still tell institutions apart.
+func MaskEmail(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at <= 0 {
+		return "***"
+	}
+
+	first, _ := utf8.DecodeRuneInString(email)
+	return string(first) + "***" + email[at:]
+}
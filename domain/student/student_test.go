@@ -0,0 +1,39 @@
+package studentdomain
+
+import "testing"
+
+func TestMaskEmail(t *testing.T) {
+	tests := []struct {
+		name  string
+		email string
+		want  string
+	}{
+		{
+			name:  "Regular Address",
+			email: "jane.doe@example.com",
+			want:  "j***@example.com",
+		},
+		{
+			name:  "Single Character Local Part",
+			email: "j@example.com",
+			want:  "j***@example.com",
+		},
+		{
+			name:  "Multibyte First Character",
+			email: "élodie@example.com",
+			want:  "é***@example.com",
+		},
+		{
+			name:  "Not An Address",
+			email: "jane",
+			want:  "***",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MaskEmail(tt.email); got != tt.want {
+				t.Errorf("MaskEmail() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
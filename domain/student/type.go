@@ -5,6 +5,14 @@ import "time"
 type Student struct {
 	ID         int64
 	Email      string
+	Privacy    PrivacySettings
 	CreateTime time.Time
 	UpdateTime time.Time
 }
+
+// PrivacySettings are the student's choices about what others see of them.
+type PrivacySettings struct {
+	Visibility Visibility
+	// ShowEmail opts in to showing the full email where the student is visible; it is masked otherwise.
+	ShowEmail bool
+}
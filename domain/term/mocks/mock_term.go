@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/term/term.go
+
+// Package termdomain is a generated GoMock package.
+package termdomain
+
+import (
+	context "context"
+	termdomain "github/rakadityas/course-management-system/domain/term"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTermDomainItf is a mock of TermDomainItf interface.
+type MockTermDomainItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockTermDomainItfMockRecorder
+}
+
+// MockTermDomainItfMockRecorder is the mock recorder for MockTermDomainItf.
+type MockTermDomainItfMockRecorder struct {
+	mock *MockTermDomainItf
+}
+
+// NewMockTermDomainItf creates a new mock instance.
+func NewMockTermDomainItf(ctrl *gomock.Controller) *MockTermDomainItf {
+	mock := &MockTermDomainItf{ctrl: ctrl}
+	mock.recorder = &MockTermDomainItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTermDomainItf) EXPECT() *MockTermDomainItfMockRecorder {
+	return m.recorder
+}
+
+// CreateTerm mocks base method.
+func (m *MockTermDomainItf) CreateTerm(ctx context.Context, term termdomain.Term) (termdomain.Term, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTerm", ctx, term)
+	ret0, _ := ret[0].(termdomain.Term)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTerm indicates an expected call of CreateTerm.
+func (mr *MockTermDomainItfMockRecorder) CreateTerm(ctx, term interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTerm", reflect.TypeOf((*MockTermDomainItf)(nil).CreateTerm), ctx, term)
+}
+
+// GetTermByID mocks base method.
+func (m *MockTermDomainItf) GetTermByID(ctx context.Context, id int64) (*termdomain.Term, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTermByID", ctx, id)
+	ret0, _ := ret[0].(*termdomain.Term)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTermByID indicates an expected call of GetTermByID.
+func (mr *MockTermDomainItfMockRecorder) GetTermByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTermByID", reflect.TypeOf((*MockTermDomainItf)(nil).GetTermByID), ctx, id)
+}
+
+// GetTerms mocks base method.
+func (m *MockTermDomainItf) GetTerms(ctx context.Context) ([]termdomain.Term, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTerms", ctx)
+	ret0, _ := ret[0].([]termdomain.Term)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTerms indicates an expected call of GetTerms.
+func (mr *MockTermDomainItfMockRecorder) GetTerms(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTerms", reflect.TypeOf((*MockTermDomainItf)(nil).GetTerms), ctx)
+}
+
+// GetTermsByIDs mocks base method.
+func (m *MockTermDomainItf) GetTermsByIDs(ctx context.Context, ids []int64) (map[int64]termdomain.Term, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTermsByIDs", ctx, ids)
+	ret0, _ := ret[0].(map[int64]termdomain.Term)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTermsByIDs indicates an expected call of GetTermsByIDs.
+func (mr *MockTermDomainItfMockRecorder) GetTermsByIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTermsByIDs", reflect.TypeOf((*MockTermDomainItf)(nil).GetTermsByIDs), ctx, ids)
+}
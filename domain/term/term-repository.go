@@ -0,0 +1,133 @@
+package termdomain
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	common "github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/transaction"
+)
+
+// ErrTermAlreadyExists is returned when another term has the same name.
+var ErrTermAlreadyExists = errors.New("term already exists")
+
+// TermRepository defines the interface for term-related database operations.
+type TermRepository interface {
+	CreateTerm(ctx context.Context, term Term) (Term, error)
+	GetTermByID(ctx context.Context, id int64) (*Term, error)
+	GetTermsByIDs(ctx context.Context, ids []int64) ([]Term, error)
+	GetTerms(ctx context.Context) ([]Term, error)
+}
+
+// TermDB implements the TermRepository interface using a SQL database.
+type TermDB struct {
+	DB *sql.DB
+}
+
+// NewSQLTermRepository creates a new TermDB instance with the given database connection.
+func NewSQLTermRepository(db *sql.DB) *TermDB {
+	return &TermDB{DB: db}
+}
+
+// CreateTerm inserts a new term record into the database.
+// Returns ErrTermAlreadyExists if another term has the same name.
+func (repo *TermDB) CreateTerm(ctx context.Context, term Term) (Term, error) {
+	query := `
+		INSERT INTO terms (name, start_date, end_date, enrollment_open_time, enrollment_close_time, drop_deadline, create_time, update_time)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	result, err := transaction.GetExecutor(ctx, repo.DB).ExecContext(ctx, query, term.Name, term.StartDate, term.EndDate, term.EnrollmentOpenTime, term.EnrollmentCloseTime, term.DropDeadline, term.CreateTime, term.UpdateTime)
+	if err != nil {
+		if common.IsDuplicateEntryError(err) {
+			return Term{}, ErrTermAlreadyExists
+		}
+		return Term{}, fmt.Errorf("failed to create term: %v", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return Term{}, err
+	}
+
+	term.ID = id
+	return term, nil
+}
+
+// GetTermByID retrieves a term by its ID.
+func (repo *TermDB) GetTermByID(ctx context.Context, id int64) (*Term, error) {
+	query := `
+		SELECT id, name, start_date, end_date, enrollment_open_time, enrollment_close_time, drop_deadline, create_time, update_time
+		FROM terms
+		WHERE id = ?
+	`
+	row := transaction.GetExecutor(ctx, repo.DB).QueryRowContext(ctx, query, id)
+
+	term := &Term{}
+	err := row.Scan(&term.ID, &term.Name, &term.StartDate, &term.EndDate, &term.EnrollmentOpenTime, &term.EnrollmentCloseTime, &term.DropDeadline, &term.CreateTime, &term.UpdateTime)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // No term found
+		}
+		return nil, fmt.Errorf("failed to retrieve term: %v", err)
+	}
+
+	return term, nil
+}
+
+// GetTermsByIDs retrieves the terms with the given IDs.
+// IDs are queried in chunks of common.MaxInClauseIDs; unknown IDs are skipped.
+func (repo *TermDB) GetTermsByIDs(ctx context.Context, ids []int64) ([]Term, error) {
+	var terms []Term
+	for _, chunk := range common.ChunkIDs(ids, common.MaxInClauseIDs) {
+		placeholders, args := common.InClause(chunk)
+		query := `
+			SELECT id, name, start_date, end_date, enrollment_open_time, enrollment_close_time, drop_deadline, create_time, update_time
+			FROM terms
+			WHERE id IN (` + placeholders + `)
+		`
+		chunkTerms, err := repo.queryTerms(ctx, query, args...)
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, chunkTerms...)
+	}
+
+	return terms, nil
+}
+
+// GetTerms retrieves every term, the most recent first.
+func (repo *TermDB) GetTerms(ctx context.Context) ([]Term, error) {
+	query := `
+		SELECT id, name, start_date, end_date, enrollment_open_time, enrollment_close_time, drop_deadline, create_time, update_time
+		FROM terms
+		ORDER BY start_date DESC, id DESC
+	`
+
+	return repo.queryTerms(ctx, query)
+}
+
+// queryTerms runs a query selecting every column of the terms table.
+func (repo *TermDB) queryTerms(ctx context.Context, query string, args ...interface{}) ([]Term, error) {
+	rows, err := transaction.GetExecutor(ctx, repo.DB).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve terms: %v", err)
+	}
+	defer rows.Close()
+
+	var terms []Term
+	for rows.Next() {
+		var term Term
+		if err := rows.Scan(&term.ID, &term.Name, &term.StartDate, &term.EndDate, &term.EnrollmentOpenTime, &term.EnrollmentCloseTime, &term.DropDeadline, &term.CreateTime, &term.UpdateTime); err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return terms, nil
+}
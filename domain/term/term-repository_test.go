@@ -0,0 +1,121 @@
+package termdomain
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+)
+
+func TestTermDB_CreateTerm(t *testing.T) {
+	const insertQuery = `INSERT INTO terms \(name, start_date, end_date, enrollment_open_time, enrollment_close_time, drop_deadline, create_time, update_time\)`
+
+	timestamp := time.Date(2024, time.August, 1, 0, 0, 0, 0, time.UTC)
+	term := Term{
+		Name:       "2025 Spring",
+		StartDate:  time.Date(2025, time.January, 13, 0, 0, 0, 0, time.UTC),
+		EndDate:    time.Date(2025, time.May, 9, 0, 0, 0, 0, time.UTC),
+		CreateTime: timestamp,
+		UpdateTime: timestamp,
+	}
+
+	type fields struct {
+		DB *sql.DB
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    Term
+		wantErr error
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectExec(insertQuery).
+						WithArgs(term.Name, term.StartDate, term.EndDate, term.EnrollmentOpenTime, term.EnrollmentCloseTime, term.DropDeadline, timestamp, timestamp).
+						WillReturnResult(sqlmock.NewResult(3, 1))
+					return db
+				}(),
+			},
+			want: func() Term {
+				created := term
+				created.ID = 3
+				return created
+			}(),
+			wantErr: nil,
+		},
+		{
+			name: "Duplicate Name",
+			fields: fields{
+				DB: func() *sql.DB {
+					db, mock, err := sqlmock.New()
+					if err != nil {
+						t.Fatalf("error creating mock database: %v", err)
+					}
+					mock.ExpectExec(insertQuery).
+						WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+					return db
+				}(),
+			},
+			want:    Term{},
+			wantErr: ErrTermAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &TermDB{
+				DB: tt.fields.DB,
+			}
+			got, err := repo.CreateTerm(context.Background(), term)
+			if err != tt.wantErr {
+				t.Errorf("TermDB.CreateTerm() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TermDB.CreateTerm() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTermDB_GetTerms(t *testing.T) {
+	const termsQuery = `SELECT id, name, start_date, end_date, enrollment_open_time, enrollment_close_time, drop_deadline, create_time, update_time FROM terms ORDER BY start_date DESC, id DESC`
+
+	timestamp := time.Date(2024, time.August, 1, 0, 0, 0, 0, time.UTC)
+	startDate := time.Date(2025, time.January, 13, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, time.May, 9, 0, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error creating mock database: %v", err)
+	}
+	defer db.Close()
+	mock.ExpectQuery(termsQuery).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "start_date", "end_date", "enrollment_open_time", "enrollment_close_time", "drop_deadline", "create_time", "update_time"}).
+			AddRow(2, "2025 Spring", startDate, endDate, timestamp, nil, nil, timestamp, timestamp))
+
+	repo := &TermDB{DB: db}
+	got, err := repo.GetTerms(context.Background())
+	if err != nil {
+		t.Fatalf("TermDB.GetTerms() error = %v", err)
+	}
+	want := []Term{{ID: 2, Name: "2025 Spring", StartDate: startDate, EndDate: endDate, EnrollmentOpenTime: &timestamp, CreateTime: timestamp, UpdateTime: timestamp}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TermDB.GetTerms() = %v, want %v", got, want)
+	}
+
+	mock.ExpectQuery(termsQuery).WillReturnError(sql.ErrConnDone)
+	if _, err := repo.GetTerms(context.Background()); err == nil {
+		t.Errorf("TermDB.GetTerms() expected query error")
+	}
+}
@@ -0,0 +1,94 @@
+package termdomain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	common "github/rakadityas/course-management-system/common"
+)
+
+// maxTermNameLength mirrors the size of the terms.name column.
+const maxTermNameLength = 64
+
+var (
+	// ErrInvalidTermName is returned when a term name is empty or too long.
+	ErrInvalidTermName = errors.New("invalid term name")
+	// ErrInvalidTermDates is returned when a term does not end after it starts.
+	ErrInvalidTermDates = errors.New("term must end after it starts")
+	// ErrInvalidEnrollmentWindow is returned when an enrollment window does not close after it opens.
+	ErrInvalidEnrollmentWindow = errors.New("enrollment window must close after it opens")
+	// ErrInvalidDropDeadline is returned when a drop deadline is not after the enrollment window opens.
+	ErrInvalidDropDeadline = errors.New("drop deadline must be after enrollment opens")
+	// ErrEnrollmentNotOpen is returned when signing up before the enrollment window opens.
+	ErrEnrollmentNotOpen = errors.New("enrollment window has not opened")
+	// ErrEnrollmentClosed is returned when signing up after the enrollment window closes.
+	ErrEnrollmentClosed = errors.New("enrollment window has closed")
+	// ErrDropDeadlinePassed is returned when cancelling an active enrollment after the drop deadline.
+	ErrDropDeadlinePassed = errors.New("drop deadline has passed")
+)
+
+type TermDomainItf interface {
+	CreateTerm(ctx context.Context, term Term) (Term, error)
+	GetTermByID(ctx context.Context, id int64) (*Term, error)
+	GetTermsByIDs(ctx context.Context, ids []int64) (map[int64]Term, error)
+	GetTerms(ctx context.Context) ([]Term, error)
+}
+
+type TermService struct {
+	repo TermRepository
+}
+
+func NewTermService(repo TermRepository) TermDomainItf {
+	return &TermService{repo: repo}
+}
+
+// CreateTerm validates the name, dates, enrollment window and drop deadline and adds a new term.
+func (s *TermService) CreateTerm(ctx context.Context, term Term) (Term, error) {
+	term.Name = strings.TrimSpace(term.Name)
+	if term.Name == "" || utf8.RuneCountInString(term.Name) > maxTermNameLength {
+		return Term{}, ErrInvalidTermName
+	}
+	if !term.EndDate.After(term.StartDate) {
+		return Term{}, ErrInvalidTermDates
+	}
+	if term.EnrollmentOpenTime != nil && term.EnrollmentCloseTime != nil && !term.EnrollmentCloseTime.After(*term.EnrollmentOpenTime) {
+		return Term{}, ErrInvalidEnrollmentWindow
+	}
+	if term.DropDeadline != nil && term.EnrollmentOpenTime != nil && !term.DropDeadline.After(*term.EnrollmentOpenTime) {
+		return Term{}, ErrInvalidDropDeadline
+	}
+
+	term.CreateTime = time.Now()
+	term.UpdateTime = term.CreateTime
+
+	return s.repo.CreateTerm(ctx, term)
+}
+
+// GetTermByID retrieves a term by its ID.
+func (s *TermService) GetTermByID(ctx context.Context, id int64) (*Term, error) {
+	return s.repo.GetTermByID(ctx, id)
+}
+
+// GetTermsByIDs retrieves the terms with the given IDs keyed by term ID.
+// IDs without a term are absent from the result.
+func (s *TermService) GetTermsByIDs(ctx context.Context, ids []int64) (map[int64]Term, error) {
+	terms, err := s.repo.GetTermsByIDs(ctx, common.UniqueIDs(ids))
+	if err != nil {
+		return nil, err
+	}
+
+	termByID := make(map[int64]Term, len(terms))
+	for _, term := range terms {
+		termByID[term.ID] = term
+	}
+
+	return termByID, nil
+}
+
+// GetTerms retrieves every term, the most recent first.
+func (s *TermService) GetTerms(ctx context.Context) ([]Term, error) {
+	return s.repo.GetTerms(ctx)
+}
@@ -0,0 +1,49 @@
+package termdomain
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTermService_CreateTerm_Validation(t *testing.T) {
+	startDate := time.Date(2025, time.January, 13, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, time.May, 9, 0, 0, 0, 0, time.UTC)
+	openTime := time.Date(2024, time.November, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		term    Term
+		wantErr error
+	}{
+		{
+			name:    "Empty Name",
+			term:    Term{Name: "  ", StartDate: startDate, EndDate: endDate},
+			wantErr: ErrInvalidTermName,
+		},
+		{
+			name:    "Ends Before It Starts",
+			term:    Term{Name: "2025 Spring", StartDate: endDate, EndDate: startDate},
+			wantErr: ErrInvalidTermDates,
+		},
+		{
+			name:    "Window Closes Before It Opens",
+			term:    Term{Name: "2025 Spring", StartDate: startDate, EndDate: endDate, EnrollmentOpenTime: &openTime, EnrollmentCloseTime: &openTime},
+			wantErr: ErrInvalidEnrollmentWindow,
+		},
+		{
+			name:    "Drop Deadline Before Window Opens",
+			term:    Term{Name: "2025 Spring", StartDate: startDate, EndDate: endDate, EnrollmentOpenTime: &openTime, DropDeadline: &openTime},
+			wantErr: ErrInvalidDropDeadline,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &TermService{}
+			if _, err := service.CreateTerm(context.Background(), tt.term); err != tt.wantErr {
+				t.Errorf("TermService.CreateTerm() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package termdomain
+
+import "time"
+
+// Term is an academic term courses are offered in.
+type Term struct {
+	ID        int64
+	Name      string
+	StartDate time.Time
+	EndDate   time.Time
+	// EnrollmentOpenTime and EnrollmentCloseTime bound the enrollment window, nil leaves that side open.
+	EnrollmentOpenTime  *time.Time
+	EnrollmentCloseTime *time.Time
+	// DropDeadline is the time from which active enrollments can no longer be cancelled, nil for none.
+	DropDeadline *time.Time
+	CreateTime   time.Time
+	UpdateTime   time.Time
+}
+
+// CheckEnrollmentWindow reports whether students may sign up for the term at the given time.
+// The window opens at EnrollmentOpenTime and closes at EnrollmentCloseTime.
+func (t Term) CheckEnrollmentWindow(now time.Time) error {
+	if t.EnrollmentOpenTime != nil && now.Before(*t.EnrollmentOpenTime) {
+		return ErrEnrollmentNotOpen
+	}
+	if t.EnrollmentCloseTime != nil && !now.Before(*t.EnrollmentCloseTime) {
+		return ErrEnrollmentClosed
+	}
+
+	return nil
+}
+
+// CheckDropDeadline reports whether an active enrollment in the term may be cancelled at the given time.
+func (t Term) CheckDropDeadline(now time.Time) error {
+	if t.DropDeadline != nil && !now.Before(*t.DropDeadline) {
+		return ErrDropDeadlinePassed
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package termdomain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTerm_CheckEnrollmentWindow(t *testing.T) {
+	openTime := time.Date(2024, time.November, 1, 0, 0, 0, 0, time.UTC)
+	closeTime := time.Date(2025, time.January, 24, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		term    Term
+		now     time.Time
+		wantErr error
+	}{
+		{
+			name:    "No Window",
+			term:    Term{},
+			now:     openTime,
+			wantErr: nil,
+		},
+		{
+			name:    "Before Window Opens",
+			term:    Term{EnrollmentOpenTime: &openTime, EnrollmentCloseTime: &closeTime},
+			now:     openTime.Add(-time.Second),
+			wantErr: ErrEnrollmentNotOpen,
+		},
+		{
+			name:    "When Window Opens",
+			term:    Term{EnrollmentOpenTime: &openTime, EnrollmentCloseTime: &closeTime},
+			now:     openTime,
+			wantErr: nil,
+		},
+		{
+			name:    "Just Before Window Closes",
+			term:    Term{EnrollmentOpenTime: &openTime, EnrollmentCloseTime: &closeTime},
+			now:     closeTime.Add(-time.Second),
+			wantErr: nil,
+		},
+		{
+			name:    "When Window Closes",
+			term:    Term{EnrollmentOpenTime: &openTime, EnrollmentCloseTime: &closeTime},
+			now:     closeTime,
+			wantErr: ErrEnrollmentClosed,
+		},
+		{
+			name:    "Open Ended Window",
+			term:    Term{EnrollmentOpenTime: &openTime},
+			now:     closeTime.AddDate(1, 0, 0),
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.term.CheckEnrollmentWindow(tt.now); err != tt.wantErr {
+				t.Errorf("Term.CheckEnrollmentWindow() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTerm_CheckDropDeadline(t *testing.T) {
+	dropDeadline := time.Date(2025, time.February, 7, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		term    Term
+		now     time.Time
+		wantErr error
+	}{
+		{
+			name:    "No Deadline",
+			term:    Term{},
+			now:     dropDeadline,
+			wantErr: nil,
+		},
+		{
+			name:    "Just Before Deadline",
+			term:    Term{DropDeadline: &dropDeadline},
+			now:     dropDeadline.Add(-time.Second),
+			wantErr: nil,
+		},
+		{
+			name:    "At Deadline",
+			term:    Term{DropDeadline: &dropDeadline},
+			now:     dropDeadline,
+			wantErr: ErrDropDeadlinePassed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.term.CheckDropDeadline(tt.now); err != tt.wantErr {
+				t.Errorf("Term.CheckDropDeadline() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
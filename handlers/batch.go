@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	common "github/rakadityas/course-management-system/common"
+	enrollmentUseCase "github/rakadityas/course-management-system/use-case/enrollment"
+)
+
+// BatchCourseSignUpHandler handles signing a list of students up for their sections in one request.
+func (h *Handler) BatchCourseSignUpHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var requestPayload enrollmentUseCase.BatchCourseSignUpRequest
+		if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid request payload"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+		errMessage := validateBatch(requestPayload.Mode, len(requestPayload.Items))
+		for i, item := range requestPayload.Items {
+			if errMessage != "" {
+				break
+			}
+			if item.StudentID == 0 || item.SectionID == 0 {
+				errMessage = "Item " + strconv.Itoa(i+1) + " requires student_id and section_id"
+			}
+		}
+		if errMessage != "" {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: errMessage})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+
+		resp, err := h.EnrollmentUseCase.BatchCourseSignUp(ctx, requestPayload)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), enrollmentErrorStatusCode(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// BatchCancelCourseHandler handles cancelling a list of course enrollments in one request.
+func (h *Handler) BatchCancelCourseHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var requestPayload enrollmentUseCase.BatchCancelCourseRequest
+		if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid request payload"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+		errMessage := validateBatch(requestPayload.Mode, len(requestPayload.Items))
+		for i, item := range requestPayload.Items {
+			if errMessage != "" {
+				break
+			}
+			if item.StudentID == 0 || item.SectionID == 0 {
+				errMessage = "Item " + strconv.Itoa(i+1) + " requires student_id and section_id"
+			}
+		}
+		if errMessage != "" {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: errMessage})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+
+		resp, err := h.EnrollmentUseCase.BatchCancelCourse(ctx, requestPayload)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), enrollmentErrorStatusCode(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// validateBatch checks the mode and size of a batch request. Returns a client facing message if they are invalid.
+func validateBatch(mode enrollmentUseCase.BatchMode, items int) string {
+	switch {
+	case mode != "" && !mode.IsValid():
+		return "Invalid mode"
+	case items == 0:
+		return "Request Data is empty"
+	case items > enrollmentUseCase.MaxBatchItems:
+		return "Too many items, at most " + strconv.Itoa(enrollmentUseCase.MaxBatchItems) + " are allowed"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,218 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"github/rakadityas/course-management-system/common"
+	enrollmentUseCase "github/rakadityas/course-management-system/use-case/enrollment"
+	enrollmentUseCaseMock "github/rakadityas/course-management-system/use-case/enrollment/mocks"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestHandler_BatchCourseSignUpHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	type fields struct {
+		EnrollmentUseCase enrollmentUseCase.EnrollmentUseCaseItf
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		requestBody    string
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name: "Best Effort",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().BatchCourseSignUp(gomock.Any(), enrollmentUseCase.BatchCourseSignUpRequest{
+						Mode:  enrollmentUseCase.BatchModeBestEffort,
+						Items: []enrollmentUseCase.CourseSignUpRequest{{StudentID: 1, SectionID: 201}, {StudentID: 2, SectionID: 201}},
+					}).Return(enrollmentUseCase.BatchCourseSignUpResp{
+						Status:    common.StatusSuccess,
+						Message:   "1 of 2 items failed",
+						Mode:      enrollmentUseCase.BatchModeBestEffort,
+						Succeeded: 1,
+						Failed:    1,
+						Results: []enrollmentUseCase.CourseSignUpResp{
+							{Status: common.StatusSuccess, Message: "course is full, student has been waitlisted"},
+							{Status: common.StatusFailure, Message: "student data not found"},
+						},
+					}, nil)
+					return mockEnrollmentUC
+				}(),
+			},
+			requestBody:    `{"mode":"best_effort","items":[{"student_id":1,"section_id":201},{"student_id":2,"section_id":201}]}`,
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"status":"success","message":"1 of 2 items failed","mode":"best_effort","succeeded":1,"failed":1,"results":[{"status":"success","message":"course is full, student has been waitlisted"},{"status":"failure","message":"student data not found"}]}`,
+		},
+		{
+			name: "Unit Of Work Error",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().BatchCourseSignUp(gomock.Any(), enrollmentUseCase.BatchCourseSignUpRequest{
+						Items: []enrollmentUseCase.CourseSignUpRequest{{StudentID: 1, SectionID: 201}},
+					}).Return(enrollmentUseCase.BatchCourseSignUpResp{
+						Status:  common.StatusFailure,
+						Message: "failed to sign up courses",
+						Mode:    enrollmentUseCase.BatchModeAllOrNothing,
+					}, errors.New("commit error"))
+					return mockEnrollmentUC
+				}(),
+			},
+			requestBody:    `{"items":[{"student_id":1,"section_id":201}]}`,
+			wantStatusCode: http.StatusInternalServerError,
+			wantBody:       `{"status":"failure","message":"failed to sign up courses","mode":"all_or_nothing","succeeded":0,"failed":0,"results":null}`,
+		},
+		{
+			name:           "Invalid Mode",
+			requestBody:    `{"mode":"some","items":[{"student_id":1,"section_id":201}]}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Invalid mode"}`,
+		},
+		{
+			name:           "Empty Batch",
+			requestBody:    `{"mode":"best_effort","items":[]}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Request Data is empty"}`,
+		},
+		{
+			name:           "Too Many Items",
+			requestBody:    `{"items":[` + strings.TrimSuffix(strings.Repeat(`{"student_id":1,"section_id":201},`, enrollmentUseCase.MaxBatchItems+1), ",") + `]}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Too many items, at most 100 are allowed"}`,
+		},
+		{
+			name:           "Item Without Student",
+			requestBody:    `{"items":[{"student_id":1,"section_id":201},{"section_id":201}]}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Item 2 requires student_id and section_id"}`,
+		},
+		{
+			name:           "Invalid Request Payload",
+			requestBody:    `{"items":`,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Invalid request payload"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				EnrollmentUseCase: tt.fields.EnrollmentUseCase,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/signup/batch", strings.NewReader(tt.requestBody))
+			rec := httptest.NewRecorder()
+
+			handler := h.BatchCourseSignUpHandler()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
+
+func TestHandler_BatchCancelCourseHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	type fields struct {
+		EnrollmentUseCase enrollmentUseCase.EnrollmentUseCaseItf
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		requestBody    string
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name: "Rolled Back",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().BatchCancelCourse(gomock.Any(), enrollmentUseCase.BatchCancelCourseRequest{
+						Mode:  enrollmentUseCase.BatchModeAllOrNothing,
+						Items: []enrollmentUseCase.CancelCourseRequest{{StudentID: 1, SectionID: 11}, {StudentID: 2, SectionID: 11}},
+					}).Return(enrollmentUseCase.BatchCancelCourseResp{
+						Status:  common.StatusFailure,
+						Message: "item 2 failed, the batch was rolled back",
+						Mode:    enrollmentUseCase.BatchModeAllOrNothing,
+						Failed:  2,
+						Results: []enrollmentUseCase.CancelCourseResp{
+							{Status: common.StatusFailure, Message: "rolled back, item 2 of the batch failed"},
+							{Status: common.StatusFailure, Message: "the drop deadline for this term has passed"},
+						},
+					}, nil)
+					return mockEnrollmentUC
+				}(),
+			},
+			requestBody:    `{"mode":"all_or_nothing","items":[{"student_id":1,"section_id":11},{"student_id":2,"section_id":11}]}`,
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"status":"failure","message":"item 2 failed, the batch was rolled back","mode":"all_or_nothing","succeeded":0,"failed":2,"results":[{"status":"failure","message":"rolled back, item 2 of the batch failed"},{"status":"failure","message":"the drop deadline for this term has passed"}]}`,
+		},
+		{
+			name:           "Item Without Section",
+			requestBody:    `{"items":[{"student_id":1}]}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Item 1 requires student_id and section_id"}`,
+		},
+		{
+			name:           "Empty Batch",
+			requestBody:    `{}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Request Data is empty"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				EnrollmentUseCase: tt.fields.EnrollmentUseCase,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/cancel/batch", strings.NewReader(tt.requestBody))
+			rec := httptest.NewRecorder()
+
+			handler := h.BatchCancelCourseHandler()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,426 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	common "github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/auth"
+	courseDomain "github/rakadityas/course-management-system/domain/course"
+	instructorDomain "github/rakadityas/course-management-system/domain/instructor"
+	sectionDomain "github/rakadityas/course-management-system/domain/section"
+	termDomain "github/rakadityas/course-management-system/domain/term"
+	catalogUseCase "github/rakadityas/course-management-system/use-case/catalog"
+
+	"github.com/gorilla/mux"
+)
+
+// CreateCourseHandler handles adding a new course to the catalog.
+func (h *Handler) CreateCourseHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var requestPayload catalogUseCase.CreateCourseRequest
+		if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid request payload"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+		if requestPayload.Name == "" {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Request Data is empty"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+
+		resp, err := h.CatalogUseCase.CreateCourse(ctx, requestPayload)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), courseErrorStatusCode(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// ListCatalogHandler handles listing and searching the course catalog.
+func (h *Handler) ListCatalogHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		query := r.URL.Query()
+		requestPayload := catalogUseCase.ListCatalogRequest{
+			Name: query.Get("name"),
+		}
+		if includeArchivedParam := query.Get("include_archived"); includeArchivedParam != "" {
+			includeArchived, err := strconv.ParseBool(includeArchivedParam)
+			if err != nil {
+				statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid include_archived"})
+				http.Error(w, string(statusByte), http.StatusBadRequest)
+				return
+			}
+			requestPayload.IncludeArchived = includeArchived
+		}
+		if limitParam := query.Get("limit"); limitParam != "" {
+			limit, err := strconv.Atoi(limitParam)
+			if err != nil || limit < 0 {
+				statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid limit"})
+				http.Error(w, string(statusByte), http.StatusBadRequest)
+				return
+			}
+			requestPayload.Limit = limit
+		}
+		if offsetParam := query.Get("offset"); offsetParam != "" {
+			offset, err := strconv.Atoi(offsetParam)
+			if err != nil || offset < 0 {
+				statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid offset"})
+				http.Error(w, string(statusByte), http.StatusBadRequest)
+				return
+			}
+			requestPayload.Offset = offset
+		}
+
+		resp, err := h.CatalogUseCase.ListCatalog(ctx, requestPayload)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// RenameCourseHandler handles renaming a course in the catalog.
+func (h *Handler) RenameCourseHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		courseID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+		if err != nil || courseID == 0 {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid course ID"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+
+		var requestPayload catalogUseCase.RenameCourseRequest
+		if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid request payload"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+		if requestPayload.Name == "" {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Request Data is empty"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+		requestPayload.CourseID = courseID
+
+		resp, err := h.CatalogUseCase.RenameCourse(ctx, requestPayload)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), courseErrorStatusCode(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// SetPrerequisitesHandler handles replacing the prerequisites of a course.
+func (h *Handler) SetPrerequisitesHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		courseID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+		if err != nil || courseID == 0 {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid course ID"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+
+		var requestPayload catalogUseCase.SetPrerequisitesRequest
+		if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid request payload"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+		requestPayload.CourseID = courseID
+
+		resp, err := h.CatalogUseCase.SetPrerequisites(ctx, requestPayload)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), courseErrorStatusCode(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// ArchiveCourseHandler handles removing a course from the catalog.
+func (h *Handler) ArchiveCourseHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		courseID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+		if err != nil || courseID == 0 {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid course ID"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+
+		resp, err := h.CatalogUseCase.ArchiveCourse(ctx, courseID)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// AssignInstructorHandler handles assigning an instructor to teach a course.
+func (h *Handler) AssignInstructorHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		requestPayload, ok := courseInstructorRequest(w, r)
+		if !ok {
+			return
+		}
+
+		resp, err := h.CatalogUseCase.AssignInstructor(ctx, requestPayload)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), courseErrorStatusCode(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// UnassignInstructorHandler handles removing an instructor from a course.
+func (h *Handler) UnassignInstructorHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		requestPayload, ok := courseInstructorRequest(w, r)
+		if !ok {
+			return
+		}
+
+		resp, err := h.CatalogUseCase.UnassignInstructor(ctx, requestPayload)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), courseErrorStatusCode(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// ListInstructorCoursesHandler handles listing the courses an instructor teaches.
+func (h *Handler) ListInstructorCoursesHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		instructorID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+		if err != nil || instructorID == 0 {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid instructor ID"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+
+		resp, err := h.CatalogUseCase.ListInstructorCourses(ctx, instructorID)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), courseErrorStatusCode(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// CreateTermHandler handles adding a new academic term.
+func (h *Handler) CreateTermHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var requestPayload catalogUseCase.CreateTermRequest
+		if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid request payload"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+		if requestPayload.Name == "" || requestPayload.StartDate.IsZero() || requestPayload.EndDate.IsZero() {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Request Data is empty"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+
+		resp, err := h.CatalogUseCase.CreateTerm(ctx, requestPayload)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), courseErrorStatusCode(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// ListTermsHandler handles listing the academic terms.
+func (h *Handler) ListTermsHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		resp, err := h.CatalogUseCase.ListTerms(ctx)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// CreateSectionHandler handles offering a course in a term.
+func (h *Handler) CreateSectionHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		courseID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+		if err != nil || courseID == 0 {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid course ID"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+
+		var requestPayload catalogUseCase.CreateSectionRequest
+		if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid request payload"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+		if requestPayload.TermID == 0 {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Request Data is empty"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+		requestPayload.CourseID = courseID
+
+		resp, err := h.CatalogUseCase.CreateSection(ctx, requestPayload)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), courseErrorStatusCode(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// ListCourseSectionsHandler handles listing the sections a course is offered in.
+func (h *Handler) ListCourseSectionsHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		courseID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+		if err != nil || courseID == 0 {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid course ID"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+
+		resp, err := h.CatalogUseCase.ListCourseSections(ctx, courseID)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// courseInstructorRequest reads the course and instructor IDs from the path, writing a 400 response
+// and returning false when either is invalid.
+func courseInstructorRequest(w http.ResponseWriter, r *http.Request) (catalogUseCase.CourseInstructorRequest, bool) {
+	vars := mux.Vars(r)
+
+	courseID, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil || courseID == 0 {
+		statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid course ID"})
+		http.Error(w, string(statusByte), http.StatusBadRequest)
+		return catalogUseCase.CourseInstructorRequest{}, false
+	}
+	instructorID, err := strconv.ParseInt(vars["instructor_id"], 10, 64)
+	if err != nil || instructorID == 0 {
+		statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid instructor ID"})
+		http.Error(w, string(statusByte), http.StatusBadRequest)
+		return catalogUseCase.CourseInstructorRequest{}, false
+	}
+
+	return catalogUseCase.CourseInstructorRequest{CourseID: courseID, InstructorID: instructorID}, true
+}
+
+// courseErrorStatusCode maps course domain errors to the HTTP status code returned to the client.
+func courseErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, courseDomain.ErrInvalidCourseName),
+		errors.Is(err, courseDomain.ErrInvalidCourseCapacity),
+		errors.Is(err, courseDomain.ErrInvalidCreditHours),
+		errors.Is(err, courseDomain.ErrPrerequisiteCycle),
+		errors.Is(err, termDomain.ErrInvalidTermName),
+		errors.Is(err, termDomain.ErrInvalidTermDates),
+		errors.Is(err, termDomain.ErrInvalidEnrollmentWindow),
+		errors.Is(err, termDomain.ErrInvalidDropDeadline),
+		errors.Is(err, sectionDomain.ErrInvalidSectionCapacity),
+		errors.Is(err, sectionDomain.ErrInvalidMeeting),
+		errors.Is(err, sectionDomain.ErrOverlappingMeetings):
+		return http.StatusBadRequest
+	case errors.Is(err, instructorDomain.ErrAlreadyAssigned),
+		errors.Is(err, termDomain.ErrTermAlreadyExists):
+		return http.StatusConflict
+	case errors.Is(err, instructorDomain.ErrNotAssigned):
+		return http.StatusNotFound
+	case errors.Is(err, auth.ErrUnauthenticated):
+		return http.StatusUnauthorized
+	case errors.Is(err, auth.ErrForbidden):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
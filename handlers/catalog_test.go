@@ -0,0 +1,686 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github/rakadityas/course-management-system/common"
+	courseDomain "github/rakadityas/course-management-system/domain/course"
+	instructorDomain "github/rakadityas/course-management-system/domain/instructor"
+	sectionDomain "github/rakadityas/course-management-system/domain/section"
+	catalogUseCase "github/rakadityas/course-management-system/use-case/catalog"
+	catalogUseCaseMock "github/rakadityas/course-management-system/use-case/catalog/mocks"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+)
+
+func TestHandler_CreateCourseHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const courseName = "Mathematics 101"
+	type fields struct {
+		CatalogUseCase catalogUseCase.CatalogUseCaseItf
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		requestPayload catalogUseCase.CreateCourseRequest
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				CatalogUseCase: func() catalogUseCase.CatalogUseCaseItf {
+					mockCatalogUC := catalogUseCaseMock.NewMockCatalogUseCaseItf(ctrl)
+					mockCatalogUC.EXPECT().CreateCourse(gomock.Any(), catalogUseCase.CreateCourseRequest{Name: courseName}).Return(catalogUseCase.CourseResp{
+						Status:     common.StatusSuccess,
+						CourseData: &catalogUseCase.CatalogCourse{CourseID: 1, CourseName: courseName},
+					}, nil)
+					return mockCatalogUC
+				}(),
+			},
+			requestPayload: catalogUseCase.CreateCourseRequest{Name: courseName},
+			wantStatusCode: http.StatusCreated,
+			wantBody:       `{"status":"success","course_data":{"course_id":1,"course_name":"Mathematics 101","capacity":0,"credit_hours":0,"archived":false,"create_time":"0001-01-01T00:00:00Z","update_time":"0001-01-01T00:00:00Z"}}`,
+		},
+		{
+			name: "Empty Request Data",
+			fields: fields{
+				CatalogUseCase: nil,
+			},
+			requestPayload: catalogUseCase.CreateCourseRequest{},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Request Data is empty"}`,
+		},
+		{
+			name: "Invalid Course Name",
+			fields: fields{
+				CatalogUseCase: func() catalogUseCase.CatalogUseCaseItf {
+					mockCatalogUC := catalogUseCaseMock.NewMockCatalogUseCaseItf(ctrl)
+					mockCatalogUC.EXPECT().CreateCourse(gomock.Any(), catalogUseCase.CreateCourseRequest{Name: " "}).Return(catalogUseCase.CourseResp{
+						Status:  common.StatusFailure,
+						Message: "invalid course name",
+					}, courseDomain.ErrInvalidCourseName)
+					return mockCatalogUC
+				}(),
+			},
+			requestPayload: catalogUseCase.CreateCourseRequest{Name: " "},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"invalid course name"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				CatalogUseCase: tt.fields.CatalogUseCase,
+			}
+
+			body, _ := json.Marshal(tt.requestPayload)
+			req := httptest.NewRequest(http.MethodPost, "/courses/catalog", bytes.NewReader(body))
+			rec := httptest.NewRecorder()
+
+			handler := h.CreateCourseHandler()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
+
+func TestHandler_ListCatalogHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	type fields struct {
+		CatalogUseCase catalogUseCase.CatalogUseCaseItf
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		query          string
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				CatalogUseCase: func() catalogUseCase.CatalogUseCaseItf {
+					mockCatalogUC := catalogUseCaseMock.NewMockCatalogUseCaseItf(ctrl)
+					mockCatalogUC.EXPECT().ListCatalog(gomock.Any(), catalogUseCase.ListCatalogRequest{Name: "math", Limit: 10}).Return(catalogUseCase.ListCatalogResp{
+						Status: common.StatusSuccess,
+						Courses: []catalogUseCase.CatalogCourse{
+							{CourseID: 1, CourseName: "Mathematics 101"},
+						},
+						Limit: 10,
+					}, nil)
+					return mockCatalogUC
+				}(),
+			},
+			query:          "?name=math&limit=10",
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"status":"success","courses":[{"course_id":1,"course_name":"Mathematics 101","capacity":0,"credit_hours":0,"archived":false,"create_time":"0001-01-01T00:00:00Z","update_time":"0001-01-01T00:00:00Z"}],"limit":10,"offset":0}`,
+		},
+		{
+			name: "Invalid include_archived",
+			fields: fields{
+				CatalogUseCase: nil,
+			},
+			query:          "?include_archived=maybe",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Invalid include_archived"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				CatalogUseCase: tt.fields.CatalogUseCase,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/courses/catalog"+tt.query, nil)
+			rec := httptest.NewRecorder()
+
+			handler := h.ListCatalogHandler()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
+
+func TestHandler_RenameCourseHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const courseID int64 = 1
+	type fields struct {
+		CatalogUseCase catalogUseCase.CatalogUseCaseItf
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		pathID         string
+		requestPayload catalogUseCase.RenameCourseRequest
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				CatalogUseCase: func() catalogUseCase.CatalogUseCaseItf {
+					mockCatalogUC := catalogUseCaseMock.NewMockCatalogUseCaseItf(ctrl)
+					mockCatalogUC.EXPECT().RenameCourse(gomock.Any(), catalogUseCase.RenameCourseRequest{CourseID: courseID, Name: "Mathematics 102"}).Return(catalogUseCase.CourseResp{
+						Status:     common.StatusSuccess,
+						CourseData: &catalogUseCase.CatalogCourse{CourseID: courseID, CourseName: "Mathematics 102"},
+					}, nil)
+					return mockCatalogUC
+				}(),
+			},
+			pathID:         "1",
+			requestPayload: catalogUseCase.RenameCourseRequest{Name: "Mathematics 102"},
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"status":"success","course_data":{"course_id":1,"course_name":"Mathematics 102","capacity":0,"credit_hours":0,"archived":false,"create_time":"0001-01-01T00:00:00Z","update_time":"0001-01-01T00:00:00Z"}}`,
+		},
+		{
+			name: "Invalid Course ID",
+			fields: fields{
+				CatalogUseCase: nil,
+			},
+			pathID:         "abc",
+			requestPayload: catalogUseCase.RenameCourseRequest{Name: "Mathematics 102"},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Invalid course ID"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				CatalogUseCase: tt.fields.CatalogUseCase,
+			}
+
+			body, _ := json.Marshal(tt.requestPayload)
+			req := httptest.NewRequest(http.MethodPatch, "/courses/catalog/"+tt.pathID, bytes.NewReader(body))
+			req = mux.SetURLVars(req, map[string]string{"id": tt.pathID})
+			rec := httptest.NewRecorder()
+
+			handler := h.RenameCourseHandler()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
+
+func TestHandler_SetPrerequisitesHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const courseID int64 = 3
+	type fields struct {
+		CatalogUseCase catalogUseCase.CatalogUseCaseItf
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		pathID         string
+		requestPayload catalogUseCase.SetPrerequisitesRequest
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				CatalogUseCase: func() catalogUseCase.CatalogUseCaseItf {
+					mockCatalogUC := catalogUseCaseMock.NewMockCatalogUseCaseItf(ctrl)
+					mockCatalogUC.EXPECT().SetPrerequisites(gomock.Any(), catalogUseCase.SetPrerequisitesRequest{CourseID: courseID, PrerequisiteCourseIDs: []int64{1, 2}}).Return(catalogUseCase.CourseResp{
+						Status:     common.StatusSuccess,
+						CourseData: &catalogUseCase.CatalogCourse{CourseID: courseID, CourseName: "Calculus", PrerequisiteCourseIDs: []int64{1, 2}},
+					}, nil)
+					return mockCatalogUC
+				}(),
+			},
+			pathID:         "3",
+			requestPayload: catalogUseCase.SetPrerequisitesRequest{PrerequisiteCourseIDs: []int64{1, 2}},
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"status":"success","course_data":{"course_id":3,"course_name":"Calculus","capacity":0,"credit_hours":0,"archived":false,"prerequisite_course_ids":[1,2],"create_time":"0001-01-01T00:00:00Z","update_time":"0001-01-01T00:00:00Z"}}`,
+		},
+		{
+			name: "Invalid Course ID",
+			fields: fields{
+				CatalogUseCase: nil,
+			},
+			pathID:         "abc",
+			requestPayload: catalogUseCase.SetPrerequisitesRequest{PrerequisiteCourseIDs: []int64{1}},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Invalid course ID"}`,
+		},
+		{
+			name: "Prerequisites Form A Cycle",
+			fields: fields{
+				CatalogUseCase: func() catalogUseCase.CatalogUseCaseItf {
+					mockCatalogUC := catalogUseCaseMock.NewMockCatalogUseCaseItf(ctrl)
+					mockCatalogUC.EXPECT().SetPrerequisites(gomock.Any(), catalogUseCase.SetPrerequisitesRequest{CourseID: courseID, PrerequisiteCourseIDs: []int64{3}}).Return(catalogUseCase.CourseResp{
+						Status:  common.StatusFailure,
+						Message: "course prerequisites would form a cycle",
+					}, courseDomain.ErrPrerequisiteCycle)
+					return mockCatalogUC
+				}(),
+			},
+			pathID:         "3",
+			requestPayload: catalogUseCase.SetPrerequisitesRequest{PrerequisiteCourseIDs: []int64{3}},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"course prerequisites would form a cycle"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				CatalogUseCase: tt.fields.CatalogUseCase,
+			}
+
+			body, _ := json.Marshal(tt.requestPayload)
+			req := httptest.NewRequest(http.MethodPut, "/courses/catalog/"+tt.pathID+"/prerequisites", bytes.NewReader(body))
+			req = mux.SetURLVars(req, map[string]string{"id": tt.pathID})
+			rec := httptest.NewRecorder()
+
+			handler := h.SetPrerequisitesHandler()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
+
+func TestHandler_ArchiveCourseHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const courseID int64 = 1
+	type fields struct {
+		CatalogUseCase catalogUseCase.CatalogUseCaseItf
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		pathID         string
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				CatalogUseCase: func() catalogUseCase.CatalogUseCaseItf {
+					mockCatalogUC := catalogUseCaseMock.NewMockCatalogUseCaseItf(ctrl)
+					mockCatalogUC.EXPECT().ArchiveCourse(gomock.Any(), courseID).Return(catalogUseCase.ArchiveCourseResp{Status: common.StatusSuccess}, nil)
+					return mockCatalogUC
+				}(),
+			},
+			pathID:         "1",
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"status":"success"}`,
+		},
+		{
+			name: "Error From UseCase",
+			fields: fields{
+				CatalogUseCase: func() catalogUseCase.CatalogUseCaseItf {
+					mockCatalogUC := catalogUseCaseMock.NewMockCatalogUseCaseItf(ctrl)
+					mockCatalogUC.EXPECT().ArchiveCourse(gomock.Any(), courseID).Return(catalogUseCase.ArchiveCourseResp{
+						Status:  common.StatusFailure,
+						Message: "failed to archive course",
+					}, errors.New("some error"))
+					return mockCatalogUC
+				}(),
+			},
+			pathID:         "1",
+			wantStatusCode: http.StatusInternalServerError,
+			wantBody:       `{"status":"failure","message":"failed to archive course"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				CatalogUseCase: tt.fields.CatalogUseCase,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/courses/catalog/"+tt.pathID+"/archive", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.pathID})
+			rec := httptest.NewRecorder()
+
+			handler := h.ArchiveCourseHandler()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
+
+func TestHandler_AssignInstructorHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	request := catalogUseCase.CourseInstructorRequest{CourseID: 101, InstructorID: 1}
+	type fields struct {
+		CatalogUseCase catalogUseCase.CatalogUseCaseItf
+	}
+	tests := []struct {
+		name             string
+		fields           fields
+		pathID           string
+		pathInstructorID string
+		wantStatusCode   int
+		wantBody         string
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				CatalogUseCase: func() catalogUseCase.CatalogUseCaseItf {
+					mockCatalogUC := catalogUseCaseMock.NewMockCatalogUseCaseItf(ctrl)
+					mockCatalogUC.EXPECT().AssignInstructor(gomock.Any(), request).Return(catalogUseCase.CourseInstructorsResp{
+						Status:      common.StatusSuccess,
+						CourseID:    101,
+						Instructors: []catalogUseCase.InstructorDetail{{InstructorID: 1, Name: "Ada Lovelace", Email: "ada@example.com"}},
+					}, nil)
+					return mockCatalogUC
+				}(),
+			},
+			pathID:           "101",
+			pathInstructorID: "1",
+			wantStatusCode:   http.StatusOK,
+			wantBody:         `{"status":"success","course_id":101,"instructors":[{"instructor_id":1,"name":"Ada Lovelace","email":"ada@example.com"}]}`,
+		},
+		{
+			name: "Invalid Instructor ID",
+			fields: fields{
+				CatalogUseCase: nil,
+			},
+			pathID:           "101",
+			pathInstructorID: "0",
+			wantStatusCode:   http.StatusBadRequest,
+			wantBody:         `{"status":"failure","message":"Invalid instructor ID"}`,
+		},
+		{
+			name: "Already Assigned",
+			fields: fields{
+				CatalogUseCase: func() catalogUseCase.CatalogUseCaseItf {
+					mockCatalogUC := catalogUseCaseMock.NewMockCatalogUseCaseItf(ctrl)
+					mockCatalogUC.EXPECT().AssignInstructor(gomock.Any(), request).Return(catalogUseCase.CourseInstructorsResp{
+						Status:  common.StatusFailure,
+						Message: "instructor is already assigned to the course",
+					}, instructorDomain.ErrAlreadyAssigned)
+					return mockCatalogUC
+				}(),
+			},
+			pathID:           "101",
+			pathInstructorID: "1",
+			wantStatusCode:   http.StatusConflict,
+			wantBody:         `{"status":"failure","message":"instructor is already assigned to the course"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				CatalogUseCase: tt.fields.CatalogUseCase,
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/courses/catalog/"+tt.pathID+"/instructors/"+tt.pathInstructorID, nil)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.pathID, "instructor_id": tt.pathInstructorID})
+			rec := httptest.NewRecorder()
+
+			handler := h.AssignInstructorHandler()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
+
+func TestHandler_UnassignInstructorHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	request := catalogUseCase.CourseInstructorRequest{CourseID: 101, InstructorID: 1}
+	type fields struct {
+		CatalogUseCase catalogUseCase.CatalogUseCaseItf
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				CatalogUseCase: func() catalogUseCase.CatalogUseCaseItf {
+					mockCatalogUC := catalogUseCaseMock.NewMockCatalogUseCaseItf(ctrl)
+					mockCatalogUC.EXPECT().UnassignInstructor(gomock.Any(), request).Return(catalogUseCase.CourseInstructorsResp{Status: common.StatusSuccess, CourseID: 101}, nil)
+					return mockCatalogUC
+				}(),
+			},
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"status":"success","course_id":101}`,
+		},
+		{
+			name: "Not Assigned",
+			fields: fields{
+				CatalogUseCase: func() catalogUseCase.CatalogUseCaseItf {
+					mockCatalogUC := catalogUseCaseMock.NewMockCatalogUseCaseItf(ctrl)
+					mockCatalogUC.EXPECT().UnassignInstructor(gomock.Any(), request).Return(catalogUseCase.CourseInstructorsResp{
+						Status:  common.StatusFailure,
+						Message: "instructor is not assigned to the course",
+					}, instructorDomain.ErrNotAssigned)
+					return mockCatalogUC
+				}(),
+			},
+			wantStatusCode: http.StatusNotFound,
+			wantBody:       `{"status":"failure","message":"instructor is not assigned to the course"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				CatalogUseCase: tt.fields.CatalogUseCase,
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/courses/catalog/101/instructors/1", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": "101", "instructor_id": "1"})
+			rec := httptest.NewRecorder()
+
+			handler := h.UnassignInstructorHandler()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
+
+func TestHandler_CreateSectionHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const courseID int64 = 3
+	type fields struct {
+		CatalogUseCase catalogUseCase.CatalogUseCaseItf
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		pathID         string
+		requestBody    string
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				CatalogUseCase: func() catalogUseCase.CatalogUseCaseItf {
+					mockCatalogUC := catalogUseCaseMock.NewMockCatalogUseCaseItf(ctrl)
+					mockCatalogUC.EXPECT().CreateSection(gomock.Any(), catalogUseCase.CreateSectionRequest{CourseID: courseID, TermID: 2}).Return(catalogUseCase.SectionResp{
+						Status:      common.StatusSuccess,
+						SectionData: &catalogUseCase.SectionDetail{SectionID: 7, CourseID: courseID, TermID: 2, TermName: "2025 Spring", Capacity: 30},
+					}, nil)
+					return mockCatalogUC
+				}(),
+			},
+			pathID:         "3",
+			requestBody:    `{"term_id":2}`,
+			wantStatusCode: http.StatusCreated,
+			wantBody:       `{"status":"success","section_data":{"section_id":7,"course_id":3,"term_id":2,"term_name":"2025 Spring","capacity":30}}`,
+		},
+		{
+			name: "Missing Term ID",
+			fields: fields{
+				CatalogUseCase: nil,
+			},
+			pathID:         "3",
+			requestBody:    `{"capacity":10}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Request Data is empty"}`,
+		},
+		{
+			name: "Invalid Section Capacity",
+			fields: fields{
+				CatalogUseCase: func() catalogUseCase.CatalogUseCaseItf {
+					capacity := -1
+					mockCatalogUC := catalogUseCaseMock.NewMockCatalogUseCaseItf(ctrl)
+					mockCatalogUC.EXPECT().CreateSection(gomock.Any(), catalogUseCase.CreateSectionRequest{CourseID: courseID, TermID: 2, Capacity: &capacity}).Return(catalogUseCase.SectionResp{
+						Status:  common.StatusFailure,
+						Message: "invalid section capacity",
+					}, sectionDomain.ErrInvalidSectionCapacity)
+					return mockCatalogUC
+				}(),
+			},
+			pathID:         "3",
+			requestBody:    `{"term_id":2,"capacity":-1}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"invalid section capacity"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				CatalogUseCase: tt.fields.CatalogUseCase,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/courses/catalog/"+tt.pathID+"/sections", strings.NewReader(tt.requestBody))
+			req = mux.SetURLVars(req, map[string]string{"id": tt.pathID})
+			rec := httptest.NewRecorder()
+
+			handler := h.CreateSectionHandler()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
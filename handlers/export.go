@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	common "github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/auth"
+	"github/rakadityas/course-management-system/common/logger"
+	exportUseCase "github/rakadityas/course-management-system/use-case/export"
+)
+
+// ExportEnrollmentsHandler handles downloading the enrollments as CSV or JSON Lines. Rows are
+// streamed as they are read, so the response has no length and may run past the server's write timeout.
+func (h *Handler) ExportEnrollmentsHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		requestPayload, errMessage := parseExportOptions(r)
+		if errMessage != "" {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: errMessage})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+
+		// A large export outlasts the write timeout, the request context still ends it when the client leaves
+		http.NewResponseController(w).SetWriteDeadline(time.Time{})
+
+		w.Header().Set("Content-Type", requestPayload.Format.ContentType())
+		w.Header().Set("Content-Disposition", `attachment; filename="enrollments.`+string(requestPayload.Format)+`"`)
+		writer := &exportResponseWriter{writer: w}
+		resp, err := h.ExportUseCase.ExportEnrollments(ctx, requestPayload, writer)
+		if err == nil {
+			return
+		}
+		if !writer.written {
+			w.Header().Del("Content-Disposition")
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), exportErrorStatusCode(err))
+			return
+		}
+
+		// The status line is already sent, abort so the client sees a broken download instead of a short one
+		logger.Errorf("enrollment export failed after %d rows: %v", resp.Rows, err)
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// parseExportOptions reads the format and filter parameters of an export.
+// Returns a client facing message if a parameter is invalid.
+func parseExportOptions(r *http.Request) (exportUseCase.ExportEnrollmentsRequest, string) {
+	query := r.URL.Query()
+	requestPayload := exportUseCase.ExportEnrollmentsRequest{Format: exportUseCase.FormatCSV}
+
+	if formatParam := query.Get("format"); formatParam != "" {
+		requestPayload.Format = exportUseCase.ExportFormat(formatParam)
+		if !requestPayload.Format.IsValid() {
+			return exportUseCase.ExportEnrollmentsRequest{}, "Invalid format"
+		}
+	}
+	if courseIDParam := query.Get("course_id"); courseIDParam != "" {
+		courseID, err := strconv.ParseInt(courseIDParam, 10, 64)
+		if err != nil || courseID <= 0 {
+			return exportUseCase.ExportEnrollmentsRequest{}, "Invalid course_id"
+		}
+		requestPayload.CourseID = courseID
+	}
+	statuses, ok := parseStatuses(query["status"])
+	if !ok {
+		return exportUseCase.ExportEnrollmentsRequest{}, "Invalid status"
+	}
+	requestPayload.Statuses = statuses
+	if fromParam := query.Get("from"); fromParam != "" {
+		from, err := time.Parse(time.RFC3339, fromParam)
+		if err != nil {
+			return exportUseCase.ExportEnrollmentsRequest{}, "Invalid from"
+		}
+		requestPayload.CreatedFrom = &from
+	}
+	if toParam := query.Get("to"); toParam != "" {
+		to, err := time.Parse(time.RFC3339, toParam)
+		if err != nil {
+			return exportUseCase.ExportEnrollmentsRequest{}, "Invalid to"
+		}
+		requestPayload.CreatedBefore = &to
+	}
+
+	return requestPayload, ""
+}
+
+// exportResponseWriter records whether any of the export reached the response.
+type exportResponseWriter struct {
+	writer  io.Writer
+	written bool
+}
+
+func (e *exportResponseWriter) Write(p []byte) (int, error) {
+	e.written = true
+	return e.writer.Write(p)
+}
+
+// exportErrorStatusCode maps export errors to the HTTP status code returned to the client.
+func exportErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, auth.ErrUnauthenticated):
+		return http.StatusUnauthorized
+	case errors.Is(err, auth.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, exportUseCase.ErrInvalidFormat), errors.Is(err, exportUseCase.ErrInvalidDateRange):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
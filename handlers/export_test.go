@@ -0,0 +1,206 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/auth"
+	courseEnrollmentDomain "github/rakadityas/course-management-system/domain/course-enrollment"
+	exportUseCase "github/rakadityas/course-management-system/use-case/export"
+	exportUseCaseMock "github/rakadityas/course-management-system/use-case/export/mocks"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestHandler_ExportEnrollmentsHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	from := time.Date(2024, time.August, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, time.September, 1, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		ExportUseCase exportUseCase.ExportUseCaseItf
+	}
+	tests := []struct {
+		name            string
+		fields          fields
+		query           string
+		wantStatusCode  int
+		wantContentType string
+		wantBody        string
+		wantJSON        bool
+		wantAbort       bool
+	}{
+		{
+			name: "CSV With Filters",
+			fields: fields{
+				ExportUseCase: func() exportUseCase.ExportUseCaseItf {
+					mockExportUC := exportUseCaseMock.NewMockExportUseCaseItf(ctrl)
+					mockExportUC.EXPECT().ExportEnrollments(gomock.Any(), exportUseCase.ExportEnrollmentsRequest{
+						Format:        exportUseCase.FormatCSV,
+						CourseID:      101,
+						Statuses:      []courseEnrollmentDomain.EnrollmentStatus{courseEnrollmentDomain.StatusActive, courseEnrollmentDomain.StatusCompleted},
+						CreatedFrom:   &from,
+						CreatedBefore: &to,
+					}, gomock.Any()).DoAndReturn(func(ctx context.Context, req exportUseCase.ExportEnrollmentsRequest, w io.Writer) (exportUseCase.ExportResp, error) {
+						io.WriteString(w, "enrollment_id,student_id\n1,1\n")
+						return exportUseCase.ExportResp{Status: common.StatusSuccess, Rows: 1}, nil
+					})
+					return mockExportUC
+				}(),
+			},
+			query:           "?course_id=101&status=active,completed&from=2024-08-01T00:00:00Z&to=2024-09-01T00:00:00Z",
+			wantStatusCode:  http.StatusOK,
+			wantContentType: "text/csv; charset=utf-8",
+			wantBody:        "enrollment_id,student_id\n1,1\n",
+		},
+		{
+			name: "JSON Lines",
+			fields: fields{
+				ExportUseCase: func() exportUseCase.ExportUseCaseItf {
+					mockExportUC := exportUseCaseMock.NewMockExportUseCaseItf(ctrl)
+					mockExportUC.EXPECT().ExportEnrollments(gomock.Any(), exportUseCase.ExportEnrollmentsRequest{Format: exportUseCase.FormatJSONL}, gomock.Any()).DoAndReturn(func(ctx context.Context, req exportUseCase.ExportEnrollmentsRequest, w io.Writer) (exportUseCase.ExportResp, error) {
+						io.WriteString(w, "{\"enrollment_id\":1}\n")
+						return exportUseCase.ExportResp{Status: common.StatusSuccess, Rows: 1}, nil
+					})
+					return mockExportUC
+				}(),
+			},
+			query:           "?format=jsonl",
+			wantStatusCode:  http.StatusOK,
+			wantContentType: "application/x-ndjson",
+			wantBody:        "{\"enrollment_id\":1}\n",
+		},
+		{
+			name: "Forbidden",
+			fields: fields{
+				ExportUseCase: func() exportUseCase.ExportUseCaseItf {
+					mockExportUC := exportUseCaseMock.NewMockExportUseCaseItf(ctrl)
+					mockExportUC.EXPECT().ExportEnrollments(gomock.Any(), gomock.Any(), gomock.Any()).Return(exportUseCase.ExportResp{
+						Status:  common.StatusFailure,
+						Message: "permission denied",
+					}, auth.ErrForbidden)
+					return mockExportUC
+				}(),
+			},
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       `{"status":"failure","message":"permission denied","rows":0}`,
+			wantJSON:       true,
+		},
+		{
+			name: "Invalid Date Range",
+			fields: fields{
+				ExportUseCase: func() exportUseCase.ExportUseCaseItf {
+					mockExportUC := exportUseCaseMock.NewMockExportUseCaseItf(ctrl)
+					mockExportUC.EXPECT().ExportEnrollments(gomock.Any(), gomock.Any(), gomock.Any()).Return(exportUseCase.ExportResp{
+						Status:  common.StatusFailure,
+						Message: "invalid date range",
+					}, exportUseCase.ErrInvalidDateRange)
+					return mockExportUC
+				}(),
+			},
+			query:          "?from=2024-09-01T00:00:00Z&to=2024-08-01T00:00:00Z",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"invalid date range","rows":0}`,
+			wantJSON:       true,
+		},
+		{
+			name: "Failed After Rows",
+			fields: fields{
+				ExportUseCase: func() exportUseCase.ExportUseCaseItf {
+					mockExportUC := exportUseCaseMock.NewMockExportUseCaseItf(ctrl)
+					mockExportUC.EXPECT().ExportEnrollments(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, req exportUseCase.ExportEnrollmentsRequest, w io.Writer) (exportUseCase.ExportResp, error) {
+						io.WriteString(w, "enrollment_id,student_id\n1,1\n")
+						return exportUseCase.ExportResp{Status: common.StatusFailure, Message: "failed to export enrollments", Rows: 1}, errors.New("connection lost")
+					})
+					return mockExportUC
+				}(),
+			},
+			wantAbort: true,
+		},
+		{
+			name:           "Invalid Format",
+			query:          "?format=xlsx",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Invalid format"}`,
+			wantJSON:       true,
+		},
+		{
+			name:           "Invalid Course ID",
+			query:          "?course_id=abc",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Invalid course_id"}`,
+			wantJSON:       true,
+		},
+		{
+			name:           "Invalid Status",
+			query:          "?status=active,unknown",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Invalid status"}`,
+			wantJSON:       true,
+		},
+		{
+			name:           "Invalid From",
+			query:          "?from=2024-08-01",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Invalid from"}`,
+			wantJSON:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				ExportUseCase: tt.fields.ExportUseCase,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/exports/enrollments"+tt.query, nil)
+			rec := httptest.NewRecorder()
+
+			handler := h.ExportEnrollmentsHandler()
+			if tt.wantAbort {
+				defer func() {
+					if recovered := recover(); recovered != http.ErrAbortHandler {
+						t.Errorf("recovered %v, want %v", recovered, http.ErrAbortHandler)
+					}
+				}()
+			}
+			handler.ServeHTTP(rec, req)
+			if tt.wantAbort {
+				t.Fatalf("handler returned, want it to abort the response")
+			}
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			if !tt.wantJSON {
+				if got := rec.Header().Get("Content-Type"); got != tt.wantContentType {
+					t.Errorf("Content-Type = %v, want %v", got, tt.wantContentType)
+				}
+				if got := rec.Body.String(); got != tt.wantBody {
+					t.Errorf("Response body = %q, want %q", got, tt.wantBody)
+				}
+				return
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
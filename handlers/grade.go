@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	common "github/rakadityas/course-management-system/common"
+	enrollmentUseCase "github/rakadityas/course-management-system/use-case/enrollment"
+
+	"github.com/gorilla/mux"
+)
+
+// RecordGradeHandler handles recording the final grade of a student in a section of a course.
+func (h *Handler) RecordGradeHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		courseID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+		if err != nil || courseID == 0 {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid course ID"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+
+		studentID, err := strconv.ParseInt(mux.Vars(r)["student_id"], 10, 64)
+		if err != nil || studentID == 0 {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid student ID"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+
+		var requestPayload enrollmentUseCase.RecordGradeRequest
+		if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid request payload"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+		if requestPayload.SectionID == 0 {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid request payload (empty)"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+		requestPayload.CourseID = courseID
+		requestPayload.StudentID = studentID
+
+		resp, err := h.EnrollmentUseCase.RecordGrade(ctx, requestPayload)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), enrollmentErrorStatusCode(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// StudentTranscriptHandler handles reading the graded courses, credit totals and GPA of a student.
+func (h *Handler) StudentTranscriptHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		studentID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+		if err != nil || studentID == 0 {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid student ID"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+
+		resp, err := h.EnrollmentUseCase.GetTranscript(ctx, studentID)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), enrollmentErrorStatusCode(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
@@ -0,0 +1,265 @@
+package handlers
+
+import (
+	"encoding/json"
+	"github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/auth"
+	courseEnrollmentDomain "github/rakadityas/course-management-system/domain/course-enrollment"
+	enrollmentUseCase "github/rakadityas/course-management-system/use-case/enrollment"
+	enrollmentUseCaseMock "github/rakadityas/course-management-system/use-case/enrollment/mocks"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+)
+
+func TestHandler_RecordGradeHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gradeTime := time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		EnrollmentUseCase enrollmentUseCase.EnrollmentUseCaseItf
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		courseID       string
+		studentID      string
+		requestBody    string
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().RecordGrade(gomock.Any(), enrollmentUseCase.RecordGradeRequest{CourseID: 101, StudentID: 1, SectionID: 201, Grade: "B+"}).Return(enrollmentUseCase.RecordGradeResp{
+						Status: common.StatusSuccess,
+						GradeData: &enrollmentUseCase.GradeDetail{
+							StudentID:   1,
+							CourseID:    101,
+							SectionID:   201,
+							Status:      courseEnrollmentDomain.StatusCompleted,
+							Grade:       "B+",
+							GradePoints: 3.3,
+							GradeTime:   gradeTime,
+						},
+					}, nil)
+					return mockEnrollmentUC
+				}(),
+			},
+			courseID:       "101",
+			studentID:      "1",
+			requestBody:    `{"section_id":201,"grade":"B+"}`,
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"status":"success","grade_data":{"student_id":1,"course_id":101,"section_id":201,"status":"completed","grade":"B+","grade_points":3.3,"grade_time":"2024-12-20T00:00:00Z"}}`,
+		},
+		{
+			name: "Invalid Grade",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().RecordGrade(gomock.Any(), enrollmentUseCase.RecordGradeRequest{CourseID: 101, StudentID: 1, SectionID: 201, Grade: "E"}).Return(enrollmentUseCase.RecordGradeResp{
+						Status:  common.StatusFailure,
+						Message: "grade is not on the grading scale",
+					}, courseEnrollmentDomain.ErrInvalidGrade)
+					return mockEnrollmentUC
+				}(),
+			},
+			courseID:       "101",
+			studentID:      "1",
+			requestBody:    `{"section_id":201,"grade":"E"}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"grade is not on the grading scale"}`,
+		},
+		{
+			name: "Enrollment Not Active",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().RecordGrade(gomock.Any(), enrollmentUseCase.RecordGradeRequest{CourseID: 101, StudentID: 1, SectionID: 201, Grade: "A"}).Return(enrollmentUseCase.RecordGradeResp{
+						Status:  common.StatusFailure,
+						Message: "enrollment status does not allow this change",
+					}, courseEnrollmentDomain.ErrInvalidStatusTransition)
+					return mockEnrollmentUC
+				}(),
+			},
+			courseID:       "101",
+			studentID:      "1",
+			requestBody:    `{"section_id":201,"grade":"A"}`,
+			wantStatusCode: http.StatusConflict,
+			wantBody:       `{"status":"failure","message":"enrollment status does not allow this change"}`,
+		},
+		{
+			name: "Not The Course Instructor",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().RecordGrade(gomock.Any(), enrollmentUseCase.RecordGradeRequest{CourseID: 101, StudentID: 1, SectionID: 201, Grade: "A"}).Return(enrollmentUseCase.RecordGradeResp{
+						Status:  common.StatusFailure,
+						Message: "permission denied",
+					}, auth.ErrForbidden)
+					return mockEnrollmentUC
+				}(),
+			},
+			courseID:       "101",
+			studentID:      "1",
+			requestBody:    `{"section_id":201,"grade":"A"}`,
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       `{"status":"failure","message":"permission denied"}`,
+		},
+		{
+			name:           "Invalid Request Payload",
+			courseID:       "101",
+			studentID:      "1",
+			requestBody:    `{"grade":`,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Invalid request payload"}`,
+		},
+		{
+			name:           "Missing Section",
+			courseID:       "101",
+			studentID:      "1",
+			requestBody:    `{"grade":"A"}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Invalid request payload (empty)"}`,
+		},
+		{
+			name:           "Invalid Student ID",
+			courseID:       "101",
+			studentID:      "0",
+			requestBody:    `{"section_id":201,"grade":"A"}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Invalid student ID"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				EnrollmentUseCase: tt.fields.EnrollmentUseCase,
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/courses/"+tt.courseID+"/grades/"+tt.studentID, strings.NewReader(tt.requestBody))
+			req = mux.SetURLVars(req, map[string]string{"id": tt.courseID, "student_id": tt.studentID})
+			rec := httptest.NewRecorder()
+
+			handler := h.RecordGradeHandler()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
+
+func TestHandler_StudentTranscriptHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gradeTime := time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		EnrollmentUseCase enrollmentUseCase.EnrollmentUseCaseItf
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		studentID      string
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().GetTranscript(gomock.Any(), int64(1)).Return(enrollmentUseCase.TranscriptResp{
+						Status:    common.StatusSuccess,
+						StudentID: 1,
+						Courses: []enrollmentUseCase.TranscriptCourse{
+							{CourseID: 101, CourseName: "Course A", TermID: 2, TermName: "2024 Fall", Status: courseEnrollmentDomain.StatusCompleted, Grade: "A", GradePoints: 4, CreditHours: 3, CreditsEarned: 3, GradeTime: gradeTime},
+						},
+						CreditsAttempted: 3,
+						CreditsEarned:    3,
+						GPA:              4,
+					}, nil)
+					return mockEnrollmentUC
+				}(),
+			},
+			studentID:      "1",
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"status":"success","student_id":1,"courses":[{"course_id":101,"course_name":"Course A","term_id":2,"term_name":"2024 Fall","status":"completed","grade":"A","grade_points":4,"credit_hours":3,"credits_earned":3,"grade_time":"2024-12-20T00:00:00Z"}],"credits_attempted":3,"credits_earned":3,"gpa":4}`,
+		},
+		{
+			name: "Forbidden",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().GetTranscript(gomock.Any(), int64(1)).Return(enrollmentUseCase.TranscriptResp{
+						Status:  common.StatusFailure,
+						Message: "permission denied",
+					}, auth.ErrForbidden)
+					return mockEnrollmentUC
+				}(),
+			},
+			studentID:      "1",
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       `{"status":"failure","message":"permission denied","credits_attempted":0,"credits_earned":0,"gpa":0}`,
+		},
+		{
+			name:           "Invalid Student ID",
+			studentID:      "0",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Invalid student ID"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				EnrollmentUseCase: tt.fields.EnrollmentUseCase,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/students/"+tt.studentID+"/transcript", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.studentID})
+			rec := httptest.NewRecorder()
+
+			handler := h.StudentTranscriptHandler()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
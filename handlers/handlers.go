@@ -2,22 +2,48 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
 	common "github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/auth"
+	"github/rakadityas/course-management-system/common/health"
+	courseEnrollmentDomain "github/rakadityas/course-management-system/domain/course-enrollment"
+	termDomain "github/rakadityas/course-management-system/domain/term"
+	catalogUseCase "github/rakadityas/course-management-system/use-case/catalog"
 	enrollmentUseCase "github/rakadityas/course-management-system/use-case/enrollment"
+	exportUseCase "github/rakadityas/course-management-system/use-case/export"
+	importUseCase "github/rakadityas/course-management-system/use-case/import"
+	studentUseCase "github/rakadityas/course-management-system/use-case/student"
 )
 
 // Handler struct holds the services required for handling requests.
 type Handler struct {
 	EnrollmentUseCase enrollmentUseCase.EnrollmentUseCaseItf
+	StudentUseCase    studentUseCase.StudentUseCaseItf
+	CatalogUseCase    catalogUseCase.CatalogUseCaseItf
+	ImportUseCase     importUseCase.ImportUseCaseItf
+	ExportUseCase     exportUseCase.ExportUseCaseItf
+	ReadinessChecker  health.Checker
+	TokenVerifier     auth.TokenVerifier
+	PrincipalResolver auth.PrincipalResolver
 }
 
 // NewHandler creates a new Handler instance with the provided services.
-func NewHandler(enrollmentUC enrollmentUseCase.EnrollmentUseCaseItf) *Handler {
+func NewHandler(enrollmentUC enrollmentUseCase.EnrollmentUseCaseItf, studentUC studentUseCase.StudentUseCaseItf, catalogUC catalogUseCase.CatalogUseCaseItf, importUC importUseCase.ImportUseCaseItf, exportUC exportUseCase.ExportUseCaseItf, readinessChecker health.Checker, tokenVerifier auth.TokenVerifier, principalResolver auth.PrincipalResolver) *Handler {
 	return &Handler{
 		EnrollmentUseCase: enrollmentUC,
+		StudentUseCase:    studentUC,
+		CatalogUseCase:    catalogUC,
+		ImportUseCase:     importUC,
+		ExportUseCase:     exportUC,
+		ReadinessChecker:  readinessChecker,
+		TokenVerifier:     tokenVerifier,
+		PrincipalResolver: principalResolver,
 	}
 }
 
@@ -32,16 +58,21 @@ func (h *Handler) CourseSignUpHandler() http.HandlerFunc {
 			http.Error(w, string(statusByte), http.StatusBadRequest)
 			return
 		}
-		if requestPayload.StudentID == 0 || requestPayload.CourseID == 0 {
+		if requestPayload.SectionID == 0 {
 			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Request Data is empty"})
 			http.Error(w, string(statusByte), http.StatusBadRequest)
 			return
 		}
+		studentID, ok := requestStudentID(w, r, requestPayload.StudentID)
+		if !ok {
+			return
+		}
+		requestPayload.StudentID = studentID
 
 		resp, err := h.EnrollmentUseCase.CourseSignUp(ctx, requestPayload)
 		if err != nil {
 			respByte, _ := json.Marshal(resp)
-			http.Error(w, string(respByte), http.StatusInternalServerError)
+			http.Error(w, string(respByte), enrollmentErrorStatusCode(err))
 			return
 		}
 
@@ -56,23 +87,32 @@ func (h *Handler) ListCoursesHandler() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 
-		studentIDParam := r.URL.Query().Get("student_id")
-		studentID, err := strconv.ParseInt(studentIDParam, 10, 64)
-		if err != nil {
-			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid student ID"})
-			http.Error(w, string(statusByte), http.StatusBadRequest)
+		var requestedID int64
+		if studentIDParam := r.URL.Query().Get("student_id"); studentIDParam != "" {
+			var err error
+			requestedID, err = strconv.ParseInt(studentIDParam, 10, 64)
+			if err != nil {
+				statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid student ID"})
+				http.Error(w, string(statusByte), http.StatusBadRequest)
+				return
+			}
+		}
+		studentID, ok := requestStudentID(w, r, requestedID)
+		if !ok {
 			return
 		}
-		if studentID == 0 {
-			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Student ID Zero"})
+
+		listOptions, errMessage := parseListOptions(r.URL.Query())
+		if errMessage != "" {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: errMessage})
 			http.Error(w, string(statusByte), http.StatusBadRequest)
 			return
 		}
 
-		resp, err := h.EnrollmentUseCase.ListCourses(ctx, studentID)
+		resp, err := h.EnrollmentUseCase.ListCourses(ctx, enrollmentUseCase.ListCoursesRequest{StudentID: studentID, ListOptions: listOptions})
 		if err != nil {
 			respByte, _ := json.Marshal(resp)
-			http.Error(w, string(respByte), http.StatusInternalServerError)
+			http.Error(w, string(respByte), enrollmentErrorStatusCode(err))
 			return
 		}
 
@@ -93,16 +133,20 @@ func (h *Handler) CancelCourseHandler() http.HandlerFunc {
 			http.Error(w, string(statusByte), http.StatusBadRequest)
 			return
 		}
-		if requestPayload.CourseID == 0 || requestPayload.StudentID == 0 {
+		if requestPayload.SectionID == 0 {
 			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid request payload (empty)"})
 			http.Error(w, string(statusByte), http.StatusBadRequest)
 			return
 		}
+		studentID, ok := requestStudentID(w, r, requestPayload.StudentID)
+		if !ok {
+			return
+		}
 
-		resp, err := h.EnrollmentUseCase.CancelCourse(ctx, requestPayload.StudentID, requestPayload.CourseID)
+		resp, err := h.EnrollmentUseCase.CancelCourse(ctx, studentID, requestPayload.SectionID)
 		if err != nil {
 			statusResp, _ := json.Marshal(resp)
-			http.Error(w, string(statusResp), http.StatusInternalServerError)
+			http.Error(w, string(statusResp), enrollmentErrorStatusCode(err))
 			return
 		}
 
@@ -117,29 +161,32 @@ func (h *Handler) ListClassmatesHandler() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 
-		studentIDStr := r.URL.Query().Get("student_id")
-		if studentIDStr == "" {
-			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "student_id is required"})
-			http.Error(w, string(statusByte), http.StatusBadRequest)
-			return
+		var requestedID int64
+		if studentIDStr := r.URL.Query().Get("student_id"); studentIDStr != "" {
+			var err error
+			requestedID, err = strconv.ParseInt(studentIDStr, 10, 64)
+			if err != nil {
+				statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid student_id"})
+				http.Error(w, string(statusByte), http.StatusBadRequest)
+				return
+			}
 		}
-
-		studentID, err := strconv.ParseInt(studentIDStr, 10, 64)
-		if err != nil {
-			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid student_id"})
-			http.Error(w, string(statusByte), http.StatusBadRequest)
+		studentID, ok := requestStudentID(w, r, requestedID)
+		if !ok {
 			return
 		}
-		if studentID == 0 {
-			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid request payload (empty)"})
+
+		listOptions, errMessage := parseListOptions(r.URL.Query())
+		if errMessage != "" {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: errMessage})
 			http.Error(w, string(statusByte), http.StatusBadRequest)
 			return
 		}
 
-		resp, err := h.EnrollmentUseCase.ListClassmates(ctx, studentID)
+		resp, err := h.EnrollmentUseCase.ListClassmates(ctx, enrollmentUseCase.ListClassmatesRequest{StudentID: studentID, ListOptions: listOptions})
 		if err != nil {
 			respByte, _ := json.Marshal(resp)
-			http.Error(w, string(respByte), http.StatusInternalServerError)
+			http.Error(w, string(respByte), enrollmentErrorStatusCode(err))
 			return
 		}
 
@@ -148,3 +195,86 @@ func (h *Handler) ListClassmatesHandler() http.HandlerFunc {
 		json.NewEncoder(w).Encode(resp)
 	}
 }
+
+// enrollmentErrorStatusCode maps course enrollment domain errors to the HTTP status code returned to the client.
+func enrollmentErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, courseEnrollmentDomain.ErrEnrollmentAlreadyExists),
+		errors.Is(err, courseEnrollmentDomain.ErrReEnrollmentCooldown),
+		errors.Is(err, courseEnrollmentDomain.ErrReEnrollmentLimitReached),
+		errors.Is(err, courseEnrollmentDomain.ErrInvalidStatusTransition),
+		errors.Is(err, termDomain.ErrEnrollmentNotOpen),
+		errors.Is(err, termDomain.ErrEnrollmentClosed),
+		errors.Is(err, termDomain.ErrDropDeadlinePassed):
+		return http.StatusConflict
+	case errors.Is(err, courseEnrollmentDomain.ErrInvalidCursor),
+		errors.Is(err, courseEnrollmentDomain.ErrInvalidGrade):
+		return http.StatusBadRequest
+	case errors.Is(err, auth.ErrUnauthenticated):
+		return http.StatusUnauthorized
+	case errors.Is(err, auth.ErrForbidden):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// parseListOptions reads the filter, sort and page parameters of the enrollment list endpoints.
+// status may be repeated or comma separated. Returns a client facing message if a parameter is invalid.
+func parseListOptions(query url.Values) (enrollmentUseCase.ListOptions, string) {
+	var listOptions enrollmentUseCase.ListOptions
+
+	statuses, ok := parseStatuses(query["status"])
+	if !ok {
+		return enrollmentUseCase.ListOptions{}, "Invalid status"
+	}
+	listOptions.Statuses = statuses
+	if createdAfterParam := query.Get("created_after"); createdAfterParam != "" {
+		createdAfter, err := time.Parse(time.RFC3339, createdAfterParam)
+		if err != nil {
+			return enrollmentUseCase.ListOptions{}, "Invalid created_after"
+		}
+		listOptions.CreatedAfter = &createdAfter
+	}
+	if termIDParam := query.Get("term_id"); termIDParam != "" {
+		termID, err := strconv.ParseInt(termIDParam, 10, 64)
+		if err != nil || termID <= 0 {
+			return enrollmentUseCase.ListOptions{}, "Invalid term_id"
+		}
+		listOptions.TermID = termID
+	}
+	if sortParam := query.Get("sort"); sortParam != "" {
+		sortBy := courseEnrollmentDomain.EnrollmentSortField(sortParam)
+		if !sortBy.IsValid() {
+			return enrollmentUseCase.ListOptions{}, "Invalid sort"
+		}
+		listOptions.SortBy = sortBy
+	}
+	if limitParam := query.Get("limit"); limitParam != "" {
+		limit, err := strconv.Atoi(limitParam)
+		if err != nil || limit < 0 {
+			return enrollmentUseCase.ListOptions{}, "Invalid limit"
+		}
+		listOptions.Limit = limit
+	}
+	listOptions.Cursor = query.Get("cursor")
+
+	return listOptions, ""
+}
+
+// parseStatuses reads enrollment status names, each value may hold several separated by commas.
+// Returns false if a name is not a status.
+func parseStatuses(values []string) ([]courseEnrollmentDomain.EnrollmentStatus, bool) {
+	var statuses []courseEnrollmentDomain.EnrollmentStatus
+	for _, value := range values {
+		for _, name := range strings.Split(value, ",") {
+			var status courseEnrollmentDomain.EnrollmentStatus
+			if err := status.UnmarshalText([]byte(strings.TrimSpace(name))); err != nil {
+				return nil, false
+			}
+			statuses = append(statuses, status)
+		}
+	}
+
+	return statuses, true
+}
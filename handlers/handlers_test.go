@@ -6,6 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/auth"
+	courseEnrollmentDomain "github/rakadityas/course-management-system/domain/course-enrollment"
+	termDomain "github/rakadityas/course-management-system/domain/term"
 	enrollmentUseCase "github/rakadityas/course-management-system/use-case/enrollment"
 	enrollmentUseCaseMock "github/rakadityas/course-management-system/use-case/enrollment/mocks"
 	"net/http"
@@ -26,6 +29,7 @@ func TestHandler_CourseSignUpHandler(t *testing.T) {
 	const (
 		studentID int64 = 1
 		courseID  int64 = 101
+		sectionID int64 = 201
 		status          = 1
 	)
 	type fields struct {
@@ -37,6 +41,7 @@ func TestHandler_CourseSignUpHandler(t *testing.T) {
 		requestPayload enrollmentUseCase.CourseSignUpRequest
 		mockResp       enrollmentUseCase.CourseSignUpResp
 		mockErr        error
+		principal      *auth.Principal
 		wantStatusCode int
 		wantBody       string
 	}{
@@ -45,7 +50,7 @@ func TestHandler_CourseSignUpHandler(t *testing.T) {
 			fields: fields{
 				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
 					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
-					mockEnrollmentUC.EXPECT().CourseSignUp(gomock.Any(), enrollmentUseCase.CourseSignUpRequest{StudentID: studentID, CourseID: courseID}).Return(enrollmentUseCase.CourseSignUpResp{
+					mockEnrollmentUC.EXPECT().CourseSignUp(gomock.Any(), enrollmentUseCase.CourseSignUpRequest{StudentID: studentID, SectionID: sectionID}).Return(enrollmentUseCase.CourseSignUpResp{
 						Status: common.StatusSuccess,
 						EnrollmentData: &enrollmentUseCase.CourseEnrollment{
 							ID:           1,
@@ -53,6 +58,8 @@ func TestHandler_CourseSignUpHandler(t *testing.T) {
 							StudentEmail: "student@example.com",
 							CourseID:     courseID,
 							CourseName:   "Course Name",
+							SectionID:    sectionID,
+							TermID:       301,
 							Status:       status,
 						},
 					}, nil)
@@ -61,10 +68,11 @@ func TestHandler_CourseSignUpHandler(t *testing.T) {
 			},
 			requestPayload: enrollmentUseCase.CourseSignUpRequest{
 				StudentID: studentID,
-				CourseID:  courseID,
+				SectionID: sectionID,
 			},
+			principal:      studentPrincipal,
 			wantStatusCode: http.StatusOK,
-			wantBody:       `{"status":"success","enrollment_data":{"id":1,"student_id":1,"student_email":"student@example.com","course_id":101,"course_name":"Course Name","status":1,"create_time":"0001-01-01T00:00:00Z","update_time":"0001-01-01T00:00:00Z"}}`,
+			wantBody:       `{"status":"success","enrollment_data":{"id":1,"student_id":1,"student_email":"student@example.com","course_id":101,"course_name":"Course Name","section_id":201,"term_id":301,"status":"active","create_time":"0001-01-01T00:00:00Z","update_time":"0001-01-01T00:00:00Z"}}`,
 		},
 		{
 			name: "Empty Request Data",
@@ -72,12 +80,144 @@ func TestHandler_CourseSignUpHandler(t *testing.T) {
 				EnrollmentUseCase: nil,
 			},
 			requestPayload: enrollmentUseCase.CourseSignUpRequest{
-				StudentID: 0,
-				CourseID:  courseID,
+				StudentID: studentID,
+				SectionID: 0,
 			},
+			principal:      studentPrincipal,
 			wantStatusCode: http.StatusBadRequest,
 			wantBody:       `{"status":"failure","message":"Request Data is empty"}`,
 		},
+		{
+			name: "Student ID Derived From Token",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().CourseSignUp(gomock.Any(), enrollmentUseCase.CourseSignUpRequest{StudentID: studentID, SectionID: sectionID}).Return(enrollmentUseCase.CourseSignUpResp{
+						Status:  common.StatusFailure,
+						Message: "student has enrolled before",
+					}, courseEnrollmentDomain.ErrEnrollmentAlreadyExists)
+					return mockEnrollmentUC
+				}(),
+			},
+			requestPayload: enrollmentUseCase.CourseSignUpRequest{
+				SectionID: sectionID,
+			},
+			principal:      studentPrincipal,
+			wantStatusCode: http.StatusConflict,
+			wantBody:       `{"status":"failure","message":"student has enrolled before"}`,
+		},
+		{
+			name: "Another Student",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().CourseSignUp(gomock.Any(), enrollmentUseCase.CourseSignUpRequest{StudentID: 2, SectionID: sectionID}).Return(enrollmentUseCase.CourseSignUpResp{
+						Status:  common.StatusFailure,
+						Message: "permission denied",
+					}, auth.ErrForbidden)
+					return mockEnrollmentUC
+				}(),
+			},
+			requestPayload: enrollmentUseCase.CourseSignUpRequest{
+				StudentID: 2,
+				SectionID: sectionID,
+			},
+			principal:      studentPrincipal,
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       `{"status":"failure","message":"permission denied"}`,
+		},
+		{
+			name: "Unauthenticated",
+			fields: fields{
+				EnrollmentUseCase: nil,
+			},
+			requestPayload: enrollmentUseCase.CourseSignUpRequest{
+				SectionID: sectionID,
+			},
+			principal:      nil,
+			wantStatusCode: http.StatusUnauthorized,
+			wantBody:       `{"status":"failure","message":"Missing bearer token"}`,
+		},
+		{
+			name: "Re-enrollment Cooldown",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().CourseSignUp(gomock.Any(), enrollmentUseCase.CourseSignUpRequest{StudentID: studentID, SectionID: sectionID}).Return(enrollmentUseCase.CourseSignUpResp{
+						Status:  common.StatusFailure,
+						Message: "student cancelled this course too recently to re-enroll",
+					}, courseEnrollmentDomain.ErrReEnrollmentCooldown)
+					return mockEnrollmentUC
+				}(),
+			},
+			requestPayload: enrollmentUseCase.CourseSignUpRequest{
+				StudentID: studentID,
+				SectionID: sectionID,
+			},
+			principal:      studentPrincipal,
+			wantStatusCode: http.StatusConflict,
+			wantBody:       `{"status":"failure","message":"student cancelled this course too recently to re-enroll"}`,
+		},
+		{
+			name: "Enrollment Closed",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().CourseSignUp(gomock.Any(), enrollmentUseCase.CourseSignUpRequest{StudentID: studentID, SectionID: sectionID}).Return(enrollmentUseCase.CourseSignUpResp{
+						Status:  common.StatusFailure,
+						Message: "enrollment for this term has closed",
+					}, termDomain.ErrEnrollmentClosed)
+					return mockEnrollmentUC
+				}(),
+			},
+			requestPayload: enrollmentUseCase.CourseSignUpRequest{
+				StudentID: studentID,
+				SectionID: sectionID,
+			},
+			principal:      studentPrincipal,
+			wantStatusCode: http.StatusConflict,
+			wantBody:       `{"status":"failure","message":"enrollment for this term has closed"}`,
+		},
+		{
+			name: "Duplicate Enrollment",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().CourseSignUp(gomock.Any(), enrollmentUseCase.CourseSignUpRequest{StudentID: studentID, SectionID: sectionID}).Return(enrollmentUseCase.CourseSignUpResp{
+						Status:  common.StatusFailure,
+						Message: "student has enrolled before",
+					}, courseEnrollmentDomain.ErrEnrollmentAlreadyExists)
+					return mockEnrollmentUC
+				}(),
+			},
+			requestPayload: enrollmentUseCase.CourseSignUpRequest{
+				StudentID: studentID,
+				SectionID: sectionID,
+			},
+			principal:      studentPrincipal,
+			wantStatusCode: http.StatusConflict,
+			wantBody:       `{"status":"failure","message":"student has enrolled before"}`,
+		},
+		{
+			name: "Error From UseCase",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().CourseSignUp(gomock.Any(), enrollmentUseCase.CourseSignUpRequest{StudentID: studentID, SectionID: sectionID}).Return(enrollmentUseCase.CourseSignUpResp{
+						Status:  common.StatusFailure,
+						Message: "failed to sign up course",
+					}, errors.New("db error"))
+					return mockEnrollmentUC
+				}(),
+			},
+			requestPayload: enrollmentUseCase.CourseSignUpRequest{
+				StudentID: studentID,
+				SectionID: sectionID,
+			},
+			principal:      studentPrincipal,
+			wantStatusCode: http.StatusInternalServerError,
+			wantBody:       `{"status":"failure","message":"failed to sign up course"}`,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -87,6 +227,9 @@ func TestHandler_CourseSignUpHandler(t *testing.T) {
 
 			body, _ := json.Marshal(tt.requestPayload)
 			req := httptest.NewRequest(http.MethodPost, "/course-sign-up", bytes.NewReader(body))
+			if tt.principal != nil {
+				req = req.WithContext(auth.WithPrincipal(req.Context(), *tt.principal))
+			}
 			rec := httptest.NewRecorder()
 
 			handler := h.CourseSignUpHandler()
@@ -124,6 +267,7 @@ func TestHandler_ListCoursesHandler(t *testing.T) {
 		queryParams    map[string]string
 		mockResp       enrollmentUseCase.ListCoursesResp
 		mockErr        error
+		principal      *auth.Principal
 		wantStatusCode int
 		wantBody       string
 	}{
@@ -132,17 +276,24 @@ func TestHandler_ListCoursesHandler(t *testing.T) {
 			fields: fields{
 				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
 					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
-					mockEnrollmentUC.EXPECT().ListCourses(gomock.Any(), studentID).Return(enrollmentUseCase.ListCoursesResp{
+					mockEnrollmentUC.EXPECT().ListCourses(gomock.Any(), enrollmentUseCase.ListCoursesRequest{StudentID: studentID}).Return(enrollmentUseCase.ListCoursesResp{
 						Status: common.StatusSuccess,
 						Courses: []enrollmentUseCase.CourseDetail{
 							{
-								CourseID:   101,
-								CourseName: "Course A",
-								Status:     1,
-								CreateTime: time.Time{},
-								UpdateTime: time.Time{},
+								CourseID:    101,
+								CourseName:  "Course A",
+								SectionID:   201,
+								TermID:      301,
+								TermName:    "2024 Fall",
+								CreditHours: 3,
+								Status:      1,
+								CreateTime:  time.Time{},
+								UpdateTime:  time.Time{},
 							},
 						},
+						CreditLoads: []enrollmentUseCase.TermCreditLoad{
+							{TermID: 301, TermName: "2024 Fall", EnrolledCredits: 3, MaxCredits: 18, RemainingCredits: func() *int { remaining := 15; return &remaining }()},
+						},
 					}, nil)
 					return mockEnrollmentUC
 				}(),
@@ -150,8 +301,9 @@ func TestHandler_ListCoursesHandler(t *testing.T) {
 			queryParams: map[string]string{
 				"student_id": strconv.FormatInt(studentID, 10),
 			},
+			principal:      studentPrincipal,
 			wantStatusCode: http.StatusOK,
-			wantBody:       `{"status":"success","courses":[{"course_id":101,"course_name":"Course A","status":1,"create_time":"0001-01-01T00:00:00Z","update_time":"0001-01-01T00:00:00Z"}]}`,
+			wantBody:       `{"status":"success","courses":[{"course_id":101,"course_name":"Course A","section_id":201,"term_id":301,"term_name":"2024 Fall","credit_hours":3,"status":"active","create_time":"0001-01-01T00:00:00Z","update_time":"0001-01-01T00:00:00Z"}],"credit_loads":[{"term_id":301,"term_name":"2024 Fall","enrolled_credits":3,"max_credits":18,"remaining_credits":15,"full_time":false}]}`,
 		},
 		{
 			name: "Invalid Student ID",
@@ -161,26 +313,61 @@ func TestHandler_ListCoursesHandler(t *testing.T) {
 			queryParams: map[string]string{
 				"student_id": "invalid",
 			},
+			principal:      studentPrincipal,
 			wantStatusCode: http.StatusBadRequest,
 			wantBody:       `{"status":"failure","message":"Invalid student ID"}`,
 		},
 		{
-			name: "Zero Student ID",
+			name: "Student ID Derived From Token",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().ListCourses(gomock.Any(), enrollmentUseCase.ListCoursesRequest{StudentID: studentID}).Return(enrollmentUseCase.ListCoursesResp{
+						Status: common.StatusSuccess,
+					}, nil)
+					return mockEnrollmentUC
+				}(),
+			},
+			queryParams:    map[string]string{},
+			principal:      studentPrincipal,
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"status":"success"}`,
+		},
+		{
+			name: "Student ID Required Without Student Token",
 			fields: fields{
 				EnrollmentUseCase: nil,
 			},
+			queryParams:    map[string]string{},
+			principal:      &auth.Principal{Subject: "admin", Roles: []string{auth.RoleAdmin}},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"student_id is required"}`,
+		},
+		{
+			name: "Another Student",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().ListCourses(gomock.Any(), enrollmentUseCase.ListCoursesRequest{StudentID: 2}).Return(enrollmentUseCase.ListCoursesResp{
+						Status:  common.StatusFailure,
+						Message: "permission denied",
+					}, auth.ErrForbidden)
+					return mockEnrollmentUC
+				}(),
+			},
 			queryParams: map[string]string{
-				"student_id": "0",
+				"student_id": "2",
 			},
-			wantStatusCode: http.StatusBadRequest,
-			wantBody:       `{"status":"failure","message":"Student ID Zero"}`,
+			principal:      studentPrincipal,
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       `{"status":"failure","message":"permission denied"}`,
 		},
 		{
 			name: "Error From UseCase",
 			fields: fields{
 				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
 					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
-					mockEnrollmentUC.EXPECT().ListCourses(gomock.Any(), studentID).Return(enrollmentUseCase.ListCoursesResp{
+					mockEnrollmentUC.EXPECT().ListCourses(gomock.Any(), enrollmentUseCase.ListCoursesRequest{StudentID: studentID}).Return(enrollmentUseCase.ListCoursesResp{
 						Status:  common.StatusFailure,
 						Message: "failed to retrieve courses",
 					}, errors.New("some error"))
@@ -190,9 +377,108 @@ func TestHandler_ListCoursesHandler(t *testing.T) {
 			queryParams: map[string]string{
 				"student_id": strconv.FormatInt(studentID, 10),
 			},
+			principal:      studentPrincipal,
 			wantStatusCode: http.StatusInternalServerError,
 			wantBody:       `{"status":"failure","message":"failed to retrieve courses"}`,
 		},
+		{
+			name: "Success With List Options",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					createdAfter := time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)
+					mockEnrollmentUC.EXPECT().ListCourses(gomock.Any(), enrollmentUseCase.ListCoursesRequest{
+						StudentID: studentID,
+						ListOptions: enrollmentUseCase.ListOptions{
+							Statuses:     []courseEnrollmentDomain.EnrollmentStatus{courseEnrollmentDomain.StatusActive, courseEnrollmentDomain.StatusWaitlisted},
+							CreatedAfter: &createdAfter,
+							TermID:       301,
+							SortBy:       courseEnrollmentDomain.SortByName,
+							Limit:        10,
+							Cursor:       "abc",
+						},
+					}).Return(enrollmentUseCase.ListCoursesResp{
+						Status:     common.StatusSuccess,
+						NextCursor: "def",
+					}, nil)
+					return mockEnrollmentUC
+				}(),
+			},
+			queryParams: map[string]string{
+				"student_id":    strconv.FormatInt(studentID, 10),
+				"status":        "active,waitlisted",
+				"created_after": "2024-08-01T00:00:00Z",
+				"term_id":       "301",
+				"sort":          "name",
+				"limit":         "10",
+				"cursor":        "abc",
+			},
+			principal:      studentPrincipal,
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"status":"success","next_cursor":"def"}`,
+		},
+		{
+			name: "Invalid Sort",
+			fields: fields{
+				EnrollmentUseCase: nil,
+			},
+			queryParams: map[string]string{
+				"student_id": strconv.FormatInt(studentID, 10),
+				"sort":       "grade",
+			},
+			principal:      studentPrincipal,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Invalid sort"}`,
+		},
+		{
+			name: "Invalid Status",
+			fields: fields{
+				EnrollmentUseCase: nil,
+			},
+			queryParams: map[string]string{
+				"student_id": strconv.FormatInt(studentID, 10),
+				"status":     "enrolled",
+			},
+			principal:      studentPrincipal,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Invalid status"}`,
+		},
+		{
+			name: "Invalid Term ID",
+			fields: fields{
+				EnrollmentUseCase: nil,
+			},
+			queryParams: map[string]string{
+				"student_id": strconv.FormatInt(studentID, 10),
+				"term_id":    "fall",
+			},
+			principal:      studentPrincipal,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Invalid term_id"}`,
+		},
+		{
+			name: "Invalid Cursor",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().ListCourses(gomock.Any(), enrollmentUseCase.ListCoursesRequest{
+						StudentID:   studentID,
+						ListOptions: enrollmentUseCase.ListOptions{Cursor: "abc"},
+					}).Return(enrollmentUseCase.ListCoursesResp{
+						Status:  common.StatusFailure,
+						Message: "invalid page cursor",
+					}, courseEnrollmentDomain.ErrInvalidCursor)
+					return mockEnrollmentUC
+				}(),
+			},
+			queryParams: map[string]string{
+				"student_id": strconv.FormatInt(studentID, 10),
+				"cursor":     "abc",
+			},
+			principal:      studentPrincipal,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"invalid page cursor"}`,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -201,6 +487,9 @@ func TestHandler_ListCoursesHandler(t *testing.T) {
 			}
 
 			req := httptest.NewRequest(http.MethodGet, "/list-courses", nil)
+			if tt.principal != nil {
+				req = req.WithContext(auth.WithPrincipal(req.Context(), *tt.principal))
+			}
 			q := req.URL.Query()
 			for key, value := range tt.queryParams {
 				q.Add(key, value)
@@ -235,7 +524,7 @@ func TestHandler_CancelCourseHandler(t *testing.T) {
 
 	const (
 		studentID int64 = 1
-		courseID  int64 = 101
+		sectionID int64 = 11
 	)
 	type fields struct {
 		EnrollmentUseCase enrollmentUseCase.EnrollmentUseCaseItf
@@ -246,6 +535,7 @@ func TestHandler_CancelCourseHandler(t *testing.T) {
 		requestPayload enrollmentUseCase.CancelCourseRequest
 		mockResp       enrollmentUseCase.CancelCourseResp
 		mockErr        error
+		principal      *auth.Principal
 		wantStatusCode int
 		wantBody       string
 	}{
@@ -254,7 +544,7 @@ func TestHandler_CancelCourseHandler(t *testing.T) {
 			fields: fields{
 				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
 					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
-					mockEnrollmentUC.EXPECT().CancelCourse(gomock.Any(), studentID, courseID).Return(enrollmentUseCase.CancelCourseResp{
+					mockEnrollmentUC.EXPECT().CancelCourse(gomock.Any(), studentID, sectionID).Return(enrollmentUseCase.CancelCourseResp{
 						Status: common.StatusSuccess,
 					}, nil)
 					return mockEnrollmentUC
@@ -262,8 +552,9 @@ func TestHandler_CancelCourseHandler(t *testing.T) {
 			},
 			requestPayload: enrollmentUseCase.CancelCourseRequest{
 				StudentID: studentID,
-				CourseID:  courseID,
+				SectionID: sectionID,
 			},
+			principal:      studentPrincipal,
 			wantStatusCode: http.StatusOK,
 			wantBody:       `{"status":"success"}`,
 		},
@@ -273,18 +564,59 @@ func TestHandler_CancelCourseHandler(t *testing.T) {
 				EnrollmentUseCase: nil,
 			},
 			requestPayload: enrollmentUseCase.CancelCourseRequest{
-				StudentID: 0,
-				CourseID:  courseID,
+				StudentID: studentID,
+				SectionID: 0,
 			},
+			principal:      studentPrincipal,
 			wantStatusCode: http.StatusBadRequest,
 			wantBody:       `{"status":"failure","message":"Invalid request payload (empty)"}`,
 		},
+		{
+			name: "Another Student",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().CancelCourse(gomock.Any(), int64(2), sectionID).Return(enrollmentUseCase.CancelCourseResp{
+						Status:  common.StatusFailure,
+						Message: "permission denied",
+					}, auth.ErrForbidden)
+					return mockEnrollmentUC
+				}(),
+			},
+			requestPayload: enrollmentUseCase.CancelCourseRequest{
+				StudentID: 2,
+				SectionID: sectionID,
+			},
+			principal:      studentPrincipal,
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       `{"status":"failure","message":"permission denied"}`,
+		},
+		{
+			name: "Not A Student",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().CancelCourse(gomock.Any(), studentID, sectionID).Return(enrollmentUseCase.CancelCourseResp{
+						Status:  common.StatusFailure,
+						Message: "permission denied",
+					}, auth.ErrForbidden)
+					return mockEnrollmentUC
+				}(),
+			},
+			requestPayload: enrollmentUseCase.CancelCourseRequest{
+				StudentID: studentID,
+				SectionID: sectionID,
+			},
+			principal:      &auth.Principal{Subject: "service:reporting"},
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       `{"status":"failure","message":"permission denied"}`,
+		},
 		{
 			name: "Error From UseCase",
 			fields: fields{
 				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
 					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
-					mockEnrollmentUC.EXPECT().CancelCourse(gomock.Any(), studentID, courseID).Return(enrollmentUseCase.CancelCourseResp{
+					mockEnrollmentUC.EXPECT().CancelCourse(gomock.Any(), studentID, sectionID).Return(enrollmentUseCase.CancelCourseResp{
 						Status:  common.StatusFailure,
 						Message: "failed to cancel course",
 					}, errors.New("some error"))
@@ -293,8 +625,9 @@ func TestHandler_CancelCourseHandler(t *testing.T) {
 			},
 			requestPayload: enrollmentUseCase.CancelCourseRequest{
 				StudentID: studentID,
-				CourseID:  courseID,
+				SectionID: sectionID,
 			},
+			principal:      studentPrincipal,
 			wantStatusCode: http.StatusInternalServerError,
 			wantBody:       `{"status":"failure","message":"failed to cancel course"}`,
 		},
@@ -307,6 +640,9 @@ func TestHandler_CancelCourseHandler(t *testing.T) {
 
 			body, _ := json.Marshal(tt.requestPayload)
 			req := httptest.NewRequest(http.MethodPost, "/cancel-course", bytes.NewReader(body))
+			if tt.principal != nil {
+				req = req.WithContext(auth.WithPrincipal(req.Context(), *tt.principal))
+			}
 			rec := httptest.NewRecorder()
 
 			handler := h.CancelCourseHandler()
@@ -346,6 +682,7 @@ func TestHandler_ListClassmatesHandler(t *testing.T) {
 		queryParams    map[string]string
 		mockResp       enrollmentUseCase.ListClassmatesResp
 		mockErr        error
+		principal      *auth.Principal
 		wantStatusCode int
 		wantBody       string
 	}{
@@ -354,7 +691,7 @@ func TestHandler_ListClassmatesHandler(t *testing.T) {
 			fields: fields{
 				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
 					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
-					mockEnrollmentUC.EXPECT().ListClassmates(gomock.Any(), studentID).Return(enrollmentUseCase.ListClassmatesResp{
+					mockEnrollmentUC.EXPECT().ListClassmates(gomock.Any(), enrollmentUseCase.ListClassmatesRequest{StudentID: studentID}).Return(enrollmentUseCase.ListClassmatesResp{
 						Status: common.StatusSuccess,
 						Courses: []enrollmentUseCase.ListClassmatesCourseResp{
 							{
@@ -379,6 +716,7 @@ func TestHandler_ListClassmatesHandler(t *testing.T) {
 			queryParams: map[string]string{
 				"student_id": strconv.FormatInt(studentID, 10),
 			},
+			principal:      studentPrincipal,
 			wantStatusCode: http.StatusOK,
 			wantBody: `{
 				"status": "success",
@@ -395,13 +733,20 @@ func TestHandler_ListClassmatesHandler(t *testing.T) {
 			}`,
 		},
 		{
-			name: "Missing student_id",
+			name: "Student ID Derived From Token",
 			fields: fields{
-				EnrollmentUseCase: nil,
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().ListClassmates(gomock.Any(), enrollmentUseCase.ListClassmatesRequest{StudentID: studentID}).Return(enrollmentUseCase.ListClassmatesResp{
+						Status: common.StatusSuccess,
+					}, nil)
+					return mockEnrollmentUC
+				}(),
 			},
 			queryParams:    map[string]string{},
-			wantStatusCode: http.StatusBadRequest,
-			wantBody:       `{"status":"failure","message":"student_id is required"}`,
+			principal:      studentPrincipal,
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"status":"success","courses":null}`,
 		},
 		{
 			name: "Invalid student_id",
@@ -411,26 +756,35 @@ func TestHandler_ListClassmatesHandler(t *testing.T) {
 			queryParams: map[string]string{
 				"student_id": "invalid",
 			},
+			principal:      studentPrincipal,
 			wantStatusCode: http.StatusBadRequest,
 			wantBody:       `{"status":"failure","message":"Invalid student_id"}`,
 		},
 		{
-			name: "Student ID Zero",
+			name: "Another Student",
 			fields: fields{
-				EnrollmentUseCase: nil,
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().ListClassmates(gomock.Any(), enrollmentUseCase.ListClassmatesRequest{StudentID: 2}).Return(enrollmentUseCase.ListClassmatesResp{
+						Status:  common.StatusFailure,
+						Message: "permission denied",
+					}, auth.ErrForbidden)
+					return mockEnrollmentUC
+				}(),
 			},
 			queryParams: map[string]string{
-				"student_id": "0",
+				"student_id": "2",
 			},
-			wantStatusCode: http.StatusBadRequest,
-			wantBody:       `{"status":"failure","message":"Invalid request payload (empty)"}`,
+			principal:      studentPrincipal,
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       `{"status":"failure","message":"permission denied","courses": null}`,
 		},
 		{
 			name: "Error from UseCase",
 			fields: fields{
 				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
 					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
-					mockEnrollmentUC.EXPECT().ListClassmates(gomock.Any(), studentID).Return(enrollmentUseCase.ListClassmatesResp{
+					mockEnrollmentUC.EXPECT().ListClassmates(gomock.Any(), enrollmentUseCase.ListClassmatesRequest{StudentID: studentID}).Return(enrollmentUseCase.ListClassmatesResp{
 						Status:  common.StatusFailure,
 						Message: "failed to list classmates",
 					}, errors.New("some error"))
@@ -440,6 +794,7 @@ func TestHandler_ListClassmatesHandler(t *testing.T) {
 			queryParams: map[string]string{
 				"student_id": strconv.FormatInt(studentID, 10),
 			},
+			principal:      studentPrincipal,
 			wantStatusCode: http.StatusInternalServerError,
 			wantBody:       `{"status":"failure","message":"failed to list classmates","courses": null}`,
 		},
@@ -458,6 +813,9 @@ func TestHandler_ListClassmatesHandler(t *testing.T) {
 			query = strings.TrimSuffix(query, "&")
 
 			req := httptest.NewRequest(http.MethodGet, "/list-classmates"+query, nil)
+			if tt.principal != nil {
+				req = req.WithContext(auth.WithPrincipal(req.Context(), *tt.principal))
+			}
 			rec := httptest.NewRecorder()
 
 			handler := h.ListClassmatesHandler()
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github/rakadityas/course-management-system/common/health"
+)
+
+// HealthzHandler reports that the process is alive. It checks no dependencies,
+// so a failing database never gets a healthy process restarted.
+func (h *Handler) HealthzHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(health.Report{Status: health.StatusUp})
+	}
+}
+
+// ReadyzHandler reports whether the application can serve traffic, with the result of each
+// readiness check. It responds 503 when any check fails.
+func (h *Handler) ReadyzHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		report := h.ReadinessChecker.Run(r.Context())
+
+		statusCode := http.StatusOK
+		if !report.IsUp() {
+			statusCode = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(report)
+	}
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github/rakadityas/course-management-system/common/health"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestHandler_HealthzHandler(t *testing.T) {
+	h := &Handler{}
+
+	req := httptest.NewRequest(http.MethodGet, "/healthz", nil)
+	rec := httptest.NewRecorder()
+
+	handler := h.HealthzHandler()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("Status code = %v, want %v", rec.Code, http.StatusOK)
+	}
+	if got, want := rec.Body.String(), "{\"status\":\"up\"}\n"; got != want {
+		t.Errorf("Response body = %q, want %q", got, want)
+	}
+}
+
+func TestHandler_ReadyzHandler(t *testing.T) {
+	up := func(ctx context.Context) error { return nil }
+	down := func(ctx context.Context) error { return errors.New("connection refused") }
+
+	tests := []struct {
+		name           string
+		checks         []health.Check
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name: "Ready",
+			checks: []health.Check{
+				{Name: "database", Probe: up},
+				{Name: "migrations", Probe: up},
+			},
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"status":"up","components":{"database":{"status":"up","duration_ms":0},"migrations":{"status":"up","duration_ms":0}}}`,
+		},
+		{
+			name: "Database Down",
+			checks: []health.Check{
+				{Name: "database", Probe: down},
+				{Name: "migrations", Probe: up},
+			},
+			wantStatusCode: http.StatusServiceUnavailable,
+			wantBody:       `{"status":"down","components":{"database":{"status":"down","error":"connection refused","duration_ms":0},"migrations":{"status":"up","duration_ms":0}}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				ReadinessChecker: health.Checker{Timeout: time.Second, Checks: tt.checks},
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+			rec := httptest.NewRecorder()
+
+			handler := h.ReadyzHandler()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
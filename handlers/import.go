@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	common "github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/auth"
+	importUseCase "github/rakadityas/course-management-system/use-case/import"
+)
+
+// Upload limits of an import. Files beyond the in-memory part are buffered on disk while parsing.
+const (
+	maxImportUploadBytes = 32 << 20
+	maxImportMemoryBytes = 8 << 20
+)
+
+// ImportHandler handles importing students, courses and enrollments from CSV files uploaded as
+// multipart/form-data, one form file per kind. ?dry_run=true validates without importing.
+func (h *Handler) ImportHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var dryRun bool
+		if dryRunParam := r.URL.Query().Get("dry_run"); dryRunParam != "" {
+			var err error
+			dryRun, err = strconv.ParseBool(dryRunParam)
+			if err != nil {
+				statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid dry_run"})
+				http.Error(w, string(statusByte), http.StatusBadRequest)
+				return
+			}
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxImportUploadBytes)
+		if err := r.ParseMultipartForm(maxImportMemoryBytes); err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Upload exceeds " + strconv.FormatInt(maxBytesErr.Limit>>20, 10) + " MB"})
+				http.Error(w, string(statusByte), http.StatusRequestEntityTooLarge)
+				return
+			}
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid multipart form"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+		defer r.MultipartForm.RemoveAll()
+
+		requestPayload := importUseCase.ImportRequest{DryRun: dryRun}
+		for _, name := range []string{importUseCase.FileStudents, importUseCase.FileCourses, importUseCase.FileEnrollments} {
+			file, _, err := r.FormFile(name)
+			if errors.Is(err, http.ErrMissingFile) {
+				continue
+			}
+			if err != nil {
+				statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid " + name + " file"})
+				http.Error(w, string(statusByte), http.StatusBadRequest)
+				return
+			}
+			defer file.Close()
+
+			switch name {
+			case importUseCase.FileStudents:
+				requestPayload.Students = file
+			case importUseCase.FileCourses:
+				requestPayload.Courses = file
+			case importUseCase.FileEnrollments:
+				requestPayload.Enrollments = file
+			}
+		}
+
+		resp, err := h.ImportUseCase.Import(ctx, requestPayload)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), importErrorStatusCode(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// importErrorStatusCode maps import errors to the HTTP status code returned to the client.
+func importErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, auth.ErrUnauthenticated):
+		return http.StatusUnauthorized
+	case errors.Is(err, auth.ErrForbidden):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/auth"
+	importUseCase "github/rakadityas/course-management-system/use-case/import"
+	importUseCaseMock "github/rakadityas/course-management-system/use-case/import/mocks"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestHandler_ImportHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	readAll := func(r io.Reader) string {
+		if r == nil {
+			return ""
+		}
+		content, _ := io.ReadAll(r)
+		return string(content)
+	}
+
+	type fields struct {
+		ImportUseCase importUseCase.ImportUseCaseItf
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		query          string
+		files          map[string]string
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name: "Dry Run",
+			fields: fields{
+				ImportUseCase: func() importUseCase.ImportUseCaseItf {
+					mockImportUC := importUseCaseMock.NewMockImportUseCaseItf(ctrl)
+					mockImportUC.EXPECT().Import(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, req importUseCase.ImportRequest) (importUseCase.ImportResp, error) {
+						if !req.DryRun || readAll(req.Students) != "email\nnew@example.com\n" || readAll(req.Courses) != "name,capacity\nBiology,30\n" || req.Enrollments != nil {
+							t.Errorf("unexpected import request %+v", req)
+						}
+						return importUseCase.ImportResp{
+							Status:   common.StatusSuccess,
+							Message:  "dry run, nothing was imported",
+							DryRun:   true,
+							Students: &importUseCase.ImportFileResult{Rows: 1, Valid: 1},
+							Courses:  &importUseCase.ImportFileResult{Rows: 1, Valid: 1},
+						}, nil
+					})
+					return mockImportUC
+				}(),
+			},
+			query:          "?dry_run=true",
+			files:          map[string]string{"students": "email\nnew@example.com\n", "courses": "name,capacity\nBiology,30\n"},
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"status":"success","message":"dry run, nothing was imported","dry_run":true,"students":{"rows":1,"valid":1},"courses":{"rows":1,"valid":1}}`,
+		},
+		{
+			name: "Invalid Rows",
+			fields: fields{
+				ImportUseCase: func() importUseCase.ImportUseCaseItf {
+					mockImportUC := importUseCaseMock.NewMockImportUseCaseItf(ctrl)
+					mockImportUC.EXPECT().Import(gomock.Any(), gomock.Any()).Return(importUseCase.ImportResp{
+						Status:   common.StatusFailure,
+						Message:  "1 invalid rows, nothing was imported",
+						Students: &importUseCase.ImportFileResult{Rows: 1},
+						Errors:   []importUseCase.ImportRowError{{File: importUseCase.FileStudents, Line: 2, Message: "invalid email address"}},
+					}, nil)
+					return mockImportUC
+				}(),
+			},
+			files:          map[string]string{"students": "email\nnot-an-email\n"},
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"status":"failure","message":"1 invalid rows, nothing was imported","dry_run":false,"students":{"rows":1,"valid":0},"errors":[{"file":"students","line":2,"message":"invalid email address"}]}`,
+		},
+		{
+			name: "Missing Permission For File",
+			fields: fields{
+				ImportUseCase: func() importUseCase.ImportUseCaseItf {
+					mockImportUC := importUseCaseMock.NewMockImportUseCaseItf(ctrl)
+					mockImportUC.EXPECT().Import(gomock.Any(), gomock.Any()).Return(importUseCase.ImportResp{
+						Status:  common.StatusFailure,
+						Message: "permission denied",
+					}, auth.ErrForbidden)
+					return mockImportUC
+				}(),
+			},
+			files:          map[string]string{"enrollments": "student_id,section_id\n1,201\n"},
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       `{"status":"failure","message":"permission denied","dry_run":false}`,
+		},
+		{
+			name:           "Invalid Dry Run",
+			query:          "?dry_run=maybe",
+			files:          map[string]string{"students": "email\n"},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Invalid dry_run"}`,
+		},
+		{
+			name:           "Upload Too Large",
+			files:          map[string]string{"students": strings.Repeat("a", maxImportUploadBytes)},
+			wantStatusCode: http.StatusRequestEntityTooLarge,
+			wantBody:       `{"status":"failure","message":"Upload exceeds 32 MB"}`,
+		},
+		{
+			name:           "Not A Multipart Form",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Invalid multipart form"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				ImportUseCase: tt.fields.ImportUseCase,
+			}
+
+			var req *http.Request
+			if tt.files != nil {
+				var body bytes.Buffer
+				writer := multipart.NewWriter(&body)
+				for field, content := range tt.files {
+					part, _ := writer.CreateFormFile(field, field+".csv")
+					part.Write([]byte(content))
+				}
+				writer.Close()
+				req = httptest.NewRequest(http.MethodPost, "/imports"+tt.query, &body)
+				req.Header.Set("Content-Type", writer.FormDataContentType())
+			} else {
+				req = httptest.NewRequest(http.MethodPost, "/imports"+tt.query, strings.NewReader("email\n"))
+				req.Header.Set("Content-Type", "text/csv")
+			}
+			rec := httptest.NewRecorder()
+
+			handler := h.ImportHandler()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
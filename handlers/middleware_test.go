@@ -0,0 +1,193 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github/rakadityas/course-management-system/common/auth"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+var studentPrincipal = &auth.Principal{Subject: "student:1", StudentID: 1}
+
+type stubTokenVerifier map[string]auth.Principal
+
+func (v stubTokenVerifier) Verify(token string) (auth.Principal, error) {
+	principal, ok := v[token]
+	if !ok {
+		return auth.Principal{}, errors.New("unknown token")
+	}
+	return principal, nil
+}
+
+type stubPrincipalResolver struct {
+	err error
+}
+
+func (r stubPrincipalResolver) ResolvePrincipal(ctx context.Context, principal auth.Principal) (auth.Principal, error) {
+	if r.err != nil {
+		return auth.Principal{}, r.err
+	}
+	principal.Roles = []string{auth.RoleStudent}
+	principal.Permissions = []auth.Permission{auth.PermManageOwnEnrollments}
+	return principal, nil
+}
+
+func TestHandler_AuthMiddleware(t *testing.T) {
+	tests := []struct {
+		name           string
+		authorization  string
+		resolverErr    error
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name:           "Valid Token",
+			authorization:  "Bearer valid-token",
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"subject":"student:1","student_id":1,"permissions":["enrollments:manage_own"]}`,
+		},
+		{
+			name:           "Lowercase Scheme",
+			authorization:  "bearer valid-token",
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"subject":"student:1","student_id":1,"permissions":["enrollments:manage_own"]}`,
+		},
+		{
+			name:           "Missing Header",
+			authorization:  "",
+			wantStatusCode: http.StatusUnauthorized,
+			wantBody:       `{"status":"failure","message":"Missing bearer token"}`,
+		},
+		{
+			name:           "Basic Scheme",
+			authorization:  "Basic dXNlcjpwYXNz",
+			wantStatusCode: http.StatusUnauthorized,
+			wantBody:       `{"status":"failure","message":"Missing bearer token"}`,
+		},
+		{
+			name:           "Invalid Token",
+			authorization:  "Bearer forged-token",
+			wantStatusCode: http.StatusUnauthorized,
+			wantBody:       `{"status":"failure","message":"Invalid bearer token"}`,
+		},
+		{
+			name:           "Permission Lookup Failure",
+			authorization:  "Bearer valid-token",
+			resolverErr:    errors.New("db error"),
+			wantStatusCode: http.StatusInternalServerError,
+			wantBody:       `{"status":"failure","message":"Failed to resolve permissions"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				TokenVerifier:     stubTokenVerifier{"valid-token": *studentPrincipal},
+				PrincipalResolver: stubPrincipalResolver{err: tt.resolverErr},
+			}
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				principal, _ := auth.PrincipalFromContext(r.Context())
+				json.NewEncoder(w).Encode(map[string]interface{}{"subject": principal.Subject, "student_id": principal.StudentID, "permissions": principal.Permissions})
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/courses", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+
+			h.AuthMiddleware(next).ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+			if tt.wantStatusCode == http.StatusUnauthorized && rec.Header().Get("WWW-Authenticate") == "" {
+				t.Errorf("WWW-Authenticate header is missing")
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
+
+func TestHandler_RequirePermission(t *testing.T) {
+	tests := []struct {
+		name           string
+		principal      *auth.Principal
+		permissions    []auth.Permission
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name:           "Permitted",
+			principal:      &auth.Principal{Subject: "admin", Permissions: []auth.Permission{auth.PermManageCourses}},
+			permissions:    []auth.Permission{auth.PermManageCourses},
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"status":"success"}`,
+		},
+		{
+			name:           "Any Of Permissions",
+			principal:      &auth.Principal{Subject: "student:1", StudentID: 1, Permissions: []auth.Permission{auth.PermManageOwnEnrollments}},
+			permissions:    []auth.Permission{auth.PermManageEnrollments, auth.PermManageOwnEnrollments},
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"status":"success"}`,
+		},
+		{
+			name:           "Forbidden",
+			principal:      &auth.Principal{Subject: "student:1", StudentID: 1, Permissions: []auth.Permission{auth.PermManageOwnEnrollments}},
+			permissions:    []auth.Permission{auth.PermManageCourses},
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       `{"status":"failure","message":"Forbidden"}`,
+		},
+		{
+			name:           "Unauthenticated",
+			principal:      nil,
+			permissions:    []auth.Permission{auth.PermManageCourses},
+			wantStatusCode: http.StatusUnauthorized,
+			wantBody:       `{"status":"failure","message":"Missing bearer token"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{}
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"status":"success"}`))
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/courses/catalog", nil)
+			if tt.principal != nil {
+				req = req.WithContext(auth.WithPrincipal(req.Context(), *tt.principal))
+			}
+			rec := httptest.NewRecorder()
+
+			h.RequirePermission(tt.permissions...)(next).ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	common "github/rakadityas/course-management-system/common"
+	enrollmentUseCase "github/rakadityas/course-management-system/use-case/enrollment"
+
+	"github.com/gorilla/mux"
+)
+
+// rosterCSVHeader is the header row of a roster exported as CSV.
+var rosterCSVHeader = []string{"student_id", "student_email", "status", "create_time", "update_time"}
+
+// CourseRosterHandler handles listing the students enrolled in a course.
+// The roster is returned as CSV when the client accepts text/csv, and as JSON otherwise.
+func (h *Handler) CourseRosterHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		courseID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+		if err != nil || courseID == 0 {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid course ID"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+
+		resp, err := h.EnrollmentUseCase.GetCourseRoster(ctx, courseID)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), enrollmentErrorStatusCode(err))
+			return
+		}
+
+		if resp.Status == common.StatusSuccess && strings.Contains(r.Header.Get("Accept"), "text/csv") {
+			w.Header().Set("Content-Type", "text/csv")
+			w.Header().Set("Content-Disposition", `attachment; filename="course-`+strconv.FormatInt(courseID, 10)+`-roster.csv"`)
+			w.WriteHeader(http.StatusOK)
+			writeRosterCSV(w, resp.Students)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// writeRosterCSV writes the roster students as CSV rows below rosterCSVHeader.
+func writeRosterCSV(w http.ResponseWriter, students []enrollmentUseCase.RosterStudent) {
+	writer := csv.NewWriter(w)
+	writer.Write(rosterCSVHeader)
+	for _, student := range students {
+		writer.Write([]string{
+			strconv.FormatInt(student.StudentID, 10),
+			student.StudentEmail,
+			student.Status.String(),
+			student.CreateTime.Format(time.RFC3339),
+			student.UpdateTime.Format(time.RFC3339),
+		})
+	}
+	writer.Flush()
+}
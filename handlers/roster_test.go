@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"encoding/json"
+	"github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/auth"
+	courseEnrollmentDomain "github/rakadityas/course-management-system/domain/course-enrollment"
+	enrollmentUseCase "github/rakadityas/course-management-system/use-case/enrollment"
+	enrollmentUseCaseMock "github/rakadityas/course-management-system/use-case/enrollment/mocks"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+)
+
+func TestHandler_CourseRosterHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	timestamp := time.Date(2024, time.August, 1, 0, 0, 0, 0, time.UTC)
+	roster := enrollmentUseCase.CourseRosterResp{
+		Status:     common.StatusSuccess,
+		CourseID:   101,
+		CourseName: "Course A",
+		Students: []enrollmentUseCase.RosterStudent{
+			{StudentID: 2, StudentEmail: "student2@example.com", Status: courseEnrollmentDomain.StatusActive, CreateTime: timestamp, UpdateTime: timestamp},
+			{StudentID: 3, Status: courseEnrollmentDomain.StatusCancelled, CreateTime: timestamp, UpdateTime: timestamp},
+		},
+	}
+
+	type fields struct {
+		EnrollmentUseCase enrollmentUseCase.EnrollmentUseCaseItf
+	}
+	tests := []struct {
+		name            string
+		fields          fields
+		courseID        string
+		accept          string
+		wantStatusCode  int
+		wantContentType string
+		wantBody        string
+	}{
+		{
+			name: "Success JSON",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().GetCourseRoster(gomock.Any(), int64(101)).Return(roster, nil)
+					return mockEnrollmentUC
+				}(),
+			},
+			courseID:        "101",
+			wantStatusCode:  http.StatusOK,
+			wantContentType: "application/json",
+			wantBody:        `{"status":"success","course_id":101,"course_name":"Course A","students":[{"student_id":2,"student_email":"student2@example.com","status":"active","create_time":"2024-08-01T00:00:00Z","update_time":"2024-08-01T00:00:00Z"},{"student_id":3,"status":"cancelled","create_time":"2024-08-01T00:00:00Z","update_time":"2024-08-01T00:00:00Z"}]}`,
+		},
+		{
+			name: "Success CSV",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().GetCourseRoster(gomock.Any(), int64(101)).Return(roster, nil)
+					return mockEnrollmentUC
+				}(),
+			},
+			courseID:        "101",
+			accept:          "text/csv",
+			wantStatusCode:  http.StatusOK,
+			wantContentType: "text/csv",
+			wantBody: "student_id,student_email,status,create_time,update_time\n" +
+				"2,student2@example.com,active,2024-08-01T00:00:00Z,2024-08-01T00:00:00Z\n" +
+				"3,,cancelled,2024-08-01T00:00:00Z,2024-08-01T00:00:00Z\n",
+		},
+		{
+			name: "Forbidden",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().GetCourseRoster(gomock.Any(), int64(101)).Return(enrollmentUseCase.CourseRosterResp{
+						Status:  common.StatusFailure,
+						Message: "permission denied",
+					}, auth.ErrForbidden)
+					return mockEnrollmentUC
+				}(),
+			},
+			courseID:        "101",
+			accept:          "text/csv",
+			wantStatusCode:  http.StatusForbidden,
+			wantContentType: "text/plain; charset=utf-8",
+			wantBody:        `{"status":"failure","message":"permission denied"}`,
+		},
+		{
+			name:            "Invalid Course ID",
+			courseID:        "0",
+			wantStatusCode:  http.StatusBadRequest,
+			wantContentType: "text/plain; charset=utf-8",
+			wantBody:        `{"status":"failure","message":"Invalid course ID"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				EnrollmentUseCase: tt.fields.EnrollmentUseCase,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/courses/"+tt.courseID+"/roster", nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			req = mux.SetURLVars(req, map[string]string{"id": tt.courseID})
+			rec := httptest.NewRecorder()
+
+			handler := h.CourseRosterHandler()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+			if got := rec.Header().Get("Content-Type"); got != tt.wantContentType {
+				t.Errorf("Content-Type = %v, want %v", got, tt.wantContentType)
+			}
+
+			if tt.wantContentType == "text/csv" {
+				if got := rec.Body.String(); got != tt.wantBody {
+					t.Errorf("Response body = %q, want %q", got, tt.wantBody)
+				}
+				return
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
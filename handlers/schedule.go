@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	common "github/rakadityas/course-management-system/common"
+	enrollmentUseCase "github/rakadityas/course-management-system/use-case/enrollment"
+
+	"github.com/gorilla/mux"
+)
+
+// StudentScheduleHandler handles reading the weekly timetable of a student.
+// The optional term_id query parameter limits the timetable to one term.
+func (h *Handler) StudentScheduleHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		studentID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+		if err != nil || studentID == 0 {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid student ID"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+
+		var termID int64
+		if termIDParam := r.URL.Query().Get("term_id"); termIDParam != "" {
+			termID, err = strconv.ParseInt(termIDParam, 10, 64)
+			if err != nil || termID <= 0 {
+				statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid term_id"})
+				http.Error(w, string(statusByte), http.StatusBadRequest)
+				return
+			}
+		}
+
+		resp, err := h.EnrollmentUseCase.GetSchedule(ctx, enrollmentUseCase.ScheduleRequest{StudentID: studentID, TermID: termID})
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), enrollmentErrorStatusCode(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
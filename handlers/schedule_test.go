@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"encoding/json"
+	"github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/auth"
+	sectionDomain "github/rakadityas/course-management-system/domain/section"
+	enrollmentUseCase "github/rakadityas/course-management-system/use-case/enrollment"
+	enrollmentUseCaseMock "github/rakadityas/course-management-system/use-case/enrollment/mocks"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+)
+
+func TestHandler_StudentScheduleHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	type fields struct {
+		EnrollmentUseCase enrollmentUseCase.EnrollmentUseCaseItf
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		studentID      string
+		query          string
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().GetSchedule(gomock.Any(), enrollmentUseCase.ScheduleRequest{StudentID: 1, TermID: 2}).Return(enrollmentUseCase.ScheduleResp{
+						Status:    common.StatusSuccess,
+						StudentID: 1,
+						Days: []enrollmentUseCase.ScheduleDay{
+							{DayOfWeek: sectionDomain.Monday, Meetings: []enrollmentUseCase.ScheduleMeeting{
+								{CourseID: 101, CourseName: "Course A", SectionID: 201, TermID: 2, StartTime: 9 * 60, EndTime: 10*60 + 30, Room: "B-101"},
+							}},
+						},
+					}, nil)
+					return mockEnrollmentUC
+				}(),
+			},
+			studentID:      "1",
+			query:          "?term_id=2",
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"status":"success","student_id":1,"days":[{"day_of_week":"monday","meetings":[{"course_id":101,"course_name":"Course A","section_id":201,"term_id":2,"start_time":"09:00","end_time":"10:30","room":"B-101"}]}]}`,
+		},
+		{
+			name: "Forbidden",
+			fields: fields{
+				EnrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mockEnrollmentUC := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mockEnrollmentUC.EXPECT().GetSchedule(gomock.Any(), enrollmentUseCase.ScheduleRequest{StudentID: 1}).Return(enrollmentUseCase.ScheduleResp{
+						Status:  common.StatusFailure,
+						Message: "permission denied",
+					}, auth.ErrForbidden)
+					return mockEnrollmentUC
+				}(),
+			},
+			studentID:      "1",
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       `{"status":"failure","message":"permission denied"}`,
+		},
+		{
+			name:           "Invalid Term ID",
+			studentID:      "1",
+			query:          "?term_id=abc",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Invalid term_id"}`,
+		},
+		{
+			name:           "Invalid Student ID",
+			studentID:      "0",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Invalid student ID"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				EnrollmentUseCase: tt.fields.EnrollmentUseCase,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/students/"+tt.studentID+"/schedule"+tt.query, nil)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.studentID})
+			rec := httptest.NewRecorder()
+
+			handler := h.StudentScheduleHandler()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
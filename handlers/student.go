@@ -0,0 +1,225 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	common "github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/auth"
+	studentDomain "github/rakadityas/course-management-system/domain/student"
+	studentUseCase "github/rakadityas/course-management-system/use-case/student"
+
+	"github.com/gorilla/mux"
+)
+
+// CreateStudentHandler handles the registration of a new student.
+func (h *Handler) CreateStudentHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var requestPayload studentUseCase.CreateStudentRequest
+		if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid request payload"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+		if requestPayload.Email == "" {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Request Data is empty"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+
+		resp, err := h.StudentUseCase.CreateStudent(ctx, requestPayload)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), studentErrorStatusCode(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// ListStudentsHandler handles the paginated listing of students.
+func (h *Handler) ListStudentsHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var requestPayload studentUseCase.ListStudentsRequest
+		if limitParam := r.URL.Query().Get("limit"); limitParam != "" {
+			limit, err := strconv.Atoi(limitParam)
+			if err != nil || limit < 0 {
+				statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid limit"})
+				http.Error(w, string(statusByte), http.StatusBadRequest)
+				return
+			}
+			requestPayload.Limit = limit
+		}
+		if offsetParam := r.URL.Query().Get("offset"); offsetParam != "" {
+			offset, err := strconv.Atoi(offsetParam)
+			if err != nil || offset < 0 {
+				statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid offset"})
+				http.Error(w, string(statusByte), http.StatusBadRequest)
+				return
+			}
+			requestPayload.Offset = offset
+		}
+
+		resp, err := h.StudentUseCase.ListStudents(ctx, requestPayload)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// UpdateStudentEmailHandler handles changing the email of a student.
+func (h *Handler) UpdateStudentEmailHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		studentID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+		if err != nil || studentID == 0 {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid student ID"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+
+		var requestPayload studentUseCase.UpdateStudentEmailRequest
+		if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid request payload"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+		if requestPayload.Email == "" {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Request Data is empty"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+		requestPayload.StudentID = studentID
+
+		resp, err := h.StudentUseCase.UpdateStudentEmail(ctx, requestPayload)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), studentErrorStatusCode(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// DeleteStudentHandler handles the soft deletion of a student.
+func (h *Handler) DeleteStudentHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		studentID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+		if err != nil || studentID == 0 {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid student ID"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+
+		resp, err := h.StudentUseCase.DeleteStudent(ctx, studentID)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// GetPrivacySettingsHandler handles reading the privacy settings of a student.
+func (h *Handler) GetPrivacySettingsHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		studentID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+		if err != nil || studentID == 0 {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid student ID"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+
+		resp, err := h.StudentUseCase.GetPrivacySettings(ctx, studentID)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), studentErrorStatusCode(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// UpdatePrivacySettingsHandler handles changing the privacy settings of a student.
+func (h *Handler) UpdatePrivacySettingsHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		studentID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+		if err != nil || studentID == 0 {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid student ID"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+
+		var requestPayload studentUseCase.UpdatePrivacySettingsRequest
+		if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Invalid request payload"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+		if requestPayload.Visibility == "" {
+			statusByte, _ := json.Marshal(HandlerStatus{Status: common.StatusFailure, Message: "Request Data is empty"})
+			http.Error(w, string(statusByte), http.StatusBadRequest)
+			return
+		}
+		requestPayload.StudentID = studentID
+
+		resp, err := h.StudentUseCase.UpdatePrivacySettings(ctx, requestPayload)
+		if err != nil {
+			respByte, _ := json.Marshal(resp)
+			http.Error(w, string(respByte), studentErrorStatusCode(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// studentErrorStatusCode maps student domain errors to the HTTP status code returned to the client.
+func studentErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, studentDomain.ErrEmailAlreadyExists):
+		return http.StatusConflict
+	case errors.Is(err, studentDomain.ErrInvalidEmail), errors.Is(err, studentDomain.ErrInvalidVisibility):
+		return http.StatusBadRequest
+	case errors.Is(err, auth.ErrUnauthenticated):
+		return http.StatusUnauthorized
+	case errors.Is(err, auth.ErrForbidden):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
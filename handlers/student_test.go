@@ -0,0 +1,494 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/auth"
+	studentDomain "github/rakadityas/course-management-system/domain/student"
+	studentUseCase "github/rakadityas/course-management-system/use-case/student"
+	studentUseCaseMock "github/rakadityas/course-management-system/use-case/student/mocks"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+)
+
+func TestHandler_CreateStudentHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const studentEmail = "student@example.com"
+	type fields struct {
+		StudentUseCase studentUseCase.StudentUseCaseItf
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		requestPayload studentUseCase.CreateStudentRequest
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				StudentUseCase: func() studentUseCase.StudentUseCaseItf {
+					mockStudentUC := studentUseCaseMock.NewMockStudentUseCaseItf(ctrl)
+					mockStudentUC.EXPECT().CreateStudent(gomock.Any(), studentUseCase.CreateStudentRequest{Email: studentEmail}).Return(studentUseCase.StudentResp{
+						Status:      common.StatusSuccess,
+						StudentData: &studentUseCase.StudentDetail{StudentID: 1, Email: studentEmail},
+					}, nil)
+					return mockStudentUC
+				}(),
+			},
+			requestPayload: studentUseCase.CreateStudentRequest{Email: studentEmail},
+			wantStatusCode: http.StatusCreated,
+			wantBody:       `{"status":"success","student_data":{"student_id":1,"email":"student@example.com","create_time":"0001-01-01T00:00:00Z","update_time":"0001-01-01T00:00:00Z"}}`,
+		},
+		{
+			name: "Empty Request Data",
+			fields: fields{
+				StudentUseCase: nil,
+			},
+			requestPayload: studentUseCase.CreateStudentRequest{},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Request Data is empty"}`,
+		},
+		{
+			name: "Email Already Exists",
+			fields: fields{
+				StudentUseCase: func() studentUseCase.StudentUseCaseItf {
+					mockStudentUC := studentUseCaseMock.NewMockStudentUseCaseItf(ctrl)
+					mockStudentUC.EXPECT().CreateStudent(gomock.Any(), studentUseCase.CreateStudentRequest{Email: studentEmail}).Return(studentUseCase.StudentResp{
+						Status:  common.StatusFailure,
+						Message: "email is already registered",
+					}, studentDomain.ErrEmailAlreadyExists)
+					return mockStudentUC
+				}(),
+			},
+			requestPayload: studentUseCase.CreateStudentRequest{Email: studentEmail},
+			wantStatusCode: http.StatusConflict,
+			wantBody:       `{"status":"failure","message":"email is already registered"}`,
+		},
+		{
+			name: "Invalid Email",
+			fields: fields{
+				StudentUseCase: func() studentUseCase.StudentUseCaseItf {
+					mockStudentUC := studentUseCaseMock.NewMockStudentUseCaseItf(ctrl)
+					mockStudentUC.EXPECT().CreateStudent(gomock.Any(), studentUseCase.CreateStudentRequest{Email: "invalid"}).Return(studentUseCase.StudentResp{
+						Status:  common.StatusFailure,
+						Message: "invalid email address",
+					}, studentDomain.ErrInvalidEmail)
+					return mockStudentUC
+				}(),
+			},
+			requestPayload: studentUseCase.CreateStudentRequest{Email: "invalid"},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"invalid email address"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				StudentUseCase: tt.fields.StudentUseCase,
+			}
+
+			body, _ := json.Marshal(tt.requestPayload)
+			req := httptest.NewRequest(http.MethodPost, "/students", bytes.NewReader(body))
+			rec := httptest.NewRecorder()
+
+			handler := h.CreateStudentHandler()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
+
+func TestHandler_ListStudentsHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	type fields struct {
+		StudentUseCase studentUseCase.StudentUseCaseItf
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		query          string
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				StudentUseCase: func() studentUseCase.StudentUseCaseItf {
+					mockStudentUC := studentUseCaseMock.NewMockStudentUseCaseItf(ctrl)
+					mockStudentUC.EXPECT().ListStudents(gomock.Any(), studentUseCase.ListStudentsRequest{Limit: 10, Offset: 20}).Return(studentUseCase.ListStudentsResp{
+						Status: common.StatusSuccess,
+						Students: []studentUseCase.StudentDetail{
+							{StudentID: 21, Email: "student21@example.com"},
+						},
+						Limit:  10,
+						Offset: 20,
+					}, nil)
+					return mockStudentUC
+				}(),
+			},
+			query:          "?limit=10&offset=20",
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"status":"success","students":[{"student_id":21,"email":"student21@example.com","create_time":"0001-01-01T00:00:00Z","update_time":"0001-01-01T00:00:00Z"}],"limit":10,"offset":20}`,
+		},
+		{
+			name: "Invalid Limit",
+			fields: fields{
+				StudentUseCase: nil,
+			},
+			query:          "?limit=abc",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Invalid limit"}`,
+		},
+		{
+			name: "Error From UseCase",
+			fields: fields{
+				StudentUseCase: func() studentUseCase.StudentUseCaseItf {
+					mockStudentUC := studentUseCaseMock.NewMockStudentUseCaseItf(ctrl)
+					mockStudentUC.EXPECT().ListStudents(gomock.Any(), studentUseCase.ListStudentsRequest{}).Return(studentUseCase.ListStudentsResp{
+						Status:  common.StatusFailure,
+						Message: "failed to retrieve students",
+					}, errors.New("some error"))
+					return mockStudentUC
+				}(),
+			},
+			query:          "",
+			wantStatusCode: http.StatusInternalServerError,
+			wantBody:       `{"status":"failure","message":"failed to retrieve students","limit":0,"offset":0}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				StudentUseCase: tt.fields.StudentUseCase,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/students"+tt.query, nil)
+			rec := httptest.NewRecorder()
+
+			handler := h.ListStudentsHandler()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
+
+func TestHandler_UpdateStudentEmailHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const (
+		studentID int64 = 1
+		newEmail        = "new@example.com"
+	)
+	type fields struct {
+		StudentUseCase studentUseCase.StudentUseCaseItf
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		pathID         string
+		requestPayload studentUseCase.UpdateStudentEmailRequest
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				StudentUseCase: func() studentUseCase.StudentUseCaseItf {
+					mockStudentUC := studentUseCaseMock.NewMockStudentUseCaseItf(ctrl)
+					mockStudentUC.EXPECT().UpdateStudentEmail(gomock.Any(), studentUseCase.UpdateStudentEmailRequest{StudentID: studentID, Email: newEmail}).Return(studentUseCase.StudentResp{
+						Status:      common.StatusSuccess,
+						StudentData: &studentUseCase.StudentDetail{StudentID: studentID, Email: newEmail},
+					}, nil)
+					return mockStudentUC
+				}(),
+			},
+			pathID:         "1",
+			requestPayload: studentUseCase.UpdateStudentEmailRequest{Email: newEmail},
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"status":"success","student_data":{"student_id":1,"email":"new@example.com","create_time":"0001-01-01T00:00:00Z","update_time":"0001-01-01T00:00:00Z"}}`,
+		},
+		{
+			name: "Invalid Student ID",
+			fields: fields{
+				StudentUseCase: nil,
+			},
+			pathID:         "0",
+			requestPayload: studentUseCase.UpdateStudentEmailRequest{Email: newEmail},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Invalid student ID"}`,
+		},
+		{
+			name: "Email Already Exists",
+			fields: fields{
+				StudentUseCase: func() studentUseCase.StudentUseCaseItf {
+					mockStudentUC := studentUseCaseMock.NewMockStudentUseCaseItf(ctrl)
+					mockStudentUC.EXPECT().UpdateStudentEmail(gomock.Any(), studentUseCase.UpdateStudentEmailRequest{StudentID: studentID, Email: newEmail}).Return(studentUseCase.StudentResp{
+						Status:  common.StatusFailure,
+						Message: "email is already registered",
+					}, studentDomain.ErrEmailAlreadyExists)
+					return mockStudentUC
+				}(),
+			},
+			pathID:         "1",
+			requestPayload: studentUseCase.UpdateStudentEmailRequest{Email: newEmail},
+			wantStatusCode: http.StatusConflict,
+			wantBody:       `{"status":"failure","message":"email is already registered"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				StudentUseCase: tt.fields.StudentUseCase,
+			}
+
+			body, _ := json.Marshal(tt.requestPayload)
+			req := httptest.NewRequest(http.MethodPatch, "/students/"+tt.pathID, bytes.NewReader(body))
+			req = mux.SetURLVars(req, map[string]string{"id": tt.pathID})
+			rec := httptest.NewRecorder()
+
+			handler := h.UpdateStudentEmailHandler()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
+
+func TestHandler_DeleteStudentHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const studentID int64 = 1
+	type fields struct {
+		StudentUseCase studentUseCase.StudentUseCaseItf
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		pathID         string
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				StudentUseCase: func() studentUseCase.StudentUseCaseItf {
+					mockStudentUC := studentUseCaseMock.NewMockStudentUseCaseItf(ctrl)
+					mockStudentUC.EXPECT().DeleteStudent(gomock.Any(), studentID).Return(studentUseCase.DeleteStudentResp{Status: common.StatusSuccess}, nil)
+					return mockStudentUC
+				}(),
+			},
+			pathID:         "1",
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"status":"success"}`,
+		},
+		{
+			name: "Error From UseCase",
+			fields: fields{
+				StudentUseCase: func() studentUseCase.StudentUseCaseItf {
+					mockStudentUC := studentUseCaseMock.NewMockStudentUseCaseItf(ctrl)
+					mockStudentUC.EXPECT().DeleteStudent(gomock.Any(), studentID).Return(studentUseCase.DeleteStudentResp{
+						Status:  common.StatusFailure,
+						Message: "failed to delete student",
+					}, errors.New("some error"))
+					return mockStudentUC
+				}(),
+			},
+			pathID:         "1",
+			wantStatusCode: http.StatusInternalServerError,
+			wantBody:       `{"status":"failure","message":"failed to delete student"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				StudentUseCase: tt.fields.StudentUseCase,
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/students/"+tt.pathID, nil)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.pathID})
+			rec := httptest.NewRecorder()
+
+			handler := h.DeleteStudentHandler()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
+
+func TestHandler_UpdatePrivacySettingsHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const studentID int64 = 1
+	type fields struct {
+		StudentUseCase studentUseCase.StudentUseCaseItf
+	}
+	tests := []struct {
+		name           string
+		fields         fields
+		pathID         string
+		requestBody    string
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				StudentUseCase: func() studentUseCase.StudentUseCaseItf {
+					mockStudentUC := studentUseCaseMock.NewMockStudentUseCaseItf(ctrl)
+					mockStudentUC.EXPECT().UpdatePrivacySettings(gomock.Any(), studentUseCase.UpdatePrivacySettingsRequest{StudentID: studentID, Visibility: studentDomain.VisibilityHidden}).Return(studentUseCase.PrivacySettingsResp{
+						Status:  common.StatusSuccess,
+						Privacy: &studentUseCase.PrivacySettingsDetail{StudentID: studentID, Visibility: studentDomain.VisibilityHidden},
+					}, nil)
+					return mockStudentUC
+				}(),
+			},
+			pathID:         "1",
+			requestBody:    `{"visibility":"hidden"}`,
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"status":"success","privacy":{"student_id":1,"visibility":"hidden","show_email":false}}`,
+		},
+		{
+			name: "Empty Visibility",
+			fields: fields{
+				StudentUseCase: nil,
+			},
+			pathID:         "1",
+			requestBody:    `{"show_email":true}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"Request Data is empty"}`,
+		},
+		{
+			name: "Invalid Visibility",
+			fields: fields{
+				StudentUseCase: func() studentUseCase.StudentUseCaseItf {
+					mockStudentUC := studentUseCaseMock.NewMockStudentUseCaseItf(ctrl)
+					mockStudentUC.EXPECT().UpdatePrivacySettings(gomock.Any(), studentUseCase.UpdatePrivacySettingsRequest{StudentID: studentID, Visibility: "everyone"}).Return(studentUseCase.PrivacySettingsResp{
+						Status:  common.StatusFailure,
+						Message: "invalid visibility",
+					}, studentDomain.ErrInvalidVisibility)
+					return mockStudentUC
+				}(),
+			},
+			pathID:         "1",
+			requestBody:    `{"visibility":"everyone"}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"status":"failure","message":"invalid visibility"}`,
+		},
+		{
+			name: "Another Student",
+			fields: fields{
+				StudentUseCase: func() studentUseCase.StudentUseCaseItf {
+					mockStudentUC := studentUseCaseMock.NewMockStudentUseCaseItf(ctrl)
+					mockStudentUC.EXPECT().UpdatePrivacySettings(gomock.Any(), studentUseCase.UpdatePrivacySettingsRequest{StudentID: studentID, Visibility: studentDomain.VisibilityHidden}).Return(studentUseCase.PrivacySettingsResp{
+						Status:  common.StatusFailure,
+						Message: "permission denied",
+					}, auth.ErrForbidden)
+					return mockStudentUC
+				}(),
+			},
+			pathID:         "1",
+			requestBody:    `{"visibility":"hidden"}`,
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       `{"status":"failure","message":"permission denied"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				StudentUseCase: tt.fields.StudentUseCase,
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/students/"+tt.pathID+"/privacy", bytes.NewReader([]byte(tt.requestBody)))
+			req = mux.SetURLVars(req, map[string]string{"id": tt.pathID})
+			rec := httptest.NewRecorder()
+
+			handler := h.UpdatePrivacySettingsHandler()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			var gotBody, wantBody map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &gotBody); err != nil {
+				t.Fatalf("Failed to unmarshal response body: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &wantBody); err != nil {
+				t.Fatalf("Failed to unmarshal expected body: %v", err)
+			}
+			if !reflect.DeepEqual(gotBody, wantBody) {
+				t.Errorf("Response body = %v, want %v", gotBody, wantBody)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package routes
 
 import (
+	"github/rakadityas/course-management-system/common/auth"
 	"github/rakadityas/course-management-system/handlers"
 
 	"github.com/gorilla/mux"
@@ -10,10 +11,75 @@ import (
 func SetupRoutes(handler *handlers.Handler) *mux.Router {
 	r := mux.NewRouter()
 
-	r.HandleFunc("/signup", handler.CourseSignUpHandler()).Methods("POST")
-	r.HandleFunc("/courses", handler.ListCoursesHandler()).Methods("GET")
-	r.HandleFunc("/cancel", handler.CancelCourseHandler()).Methods("POST")
-	r.HandleFunc("/classmates", handler.ListClassmatesHandler()).Methods("GET")
+	r.HandleFunc("/healthz", handler.HealthzHandler()).Methods("GET")
+	r.HandleFunc("/readyz", handler.ReadyzHandler()).Methods("GET")
+
+	// every other route requires a bearer token
+	api := r.NewRoute().Subrouter()
+	api.Use(handler.AuthMiddleware)
+
+	// the use cases decide whose enrollments the caller may act on
+	enrollments := api.NewRoute().Subrouter()
+	enrollments.Use(handler.RequirePermission(auth.PermManageOwnEnrollments, auth.PermManageEnrollments))
+	enrollments.HandleFunc("/signup", handler.CourseSignUpHandler()).Methods("POST")
+	enrollments.HandleFunc("/courses", handler.ListCoursesHandler()).Methods("GET")
+	enrollments.HandleFunc("/cancel", handler.CancelCourseHandler()).Methods("POST")
+	enrollments.HandleFunc("/classmates", handler.ListClassmatesHandler()).Methods("GET")
+	enrollments.HandleFunc("/students/{id:[0-9]+}/schedule", handler.StudentScheduleHandler()).Methods("GET")
+	enrollments.HandleFunc("/students/{id:[0-9]+}/transcript", handler.StudentTranscriptHandler()).Methods("GET")
+
+	// registrars sign whole cohorts up or out at once
+	batches := api.NewRoute().Subrouter()
+	batches.Use(handler.RequirePermission(auth.PermManageEnrollments))
+	batches.HandleFunc("/signup/batch", handler.BatchCourseSignUpHandler()).Methods("POST")
+	batches.HandleFunc("/cancel/batch", handler.BatchCancelCourseHandler()).Methods("POST")
+
+	students := api.NewRoute().Subrouter()
+	students.Use(handler.RequirePermission(auth.PermManageStudents))
+	students.HandleFunc("/students", handler.CreateStudentHandler()).Methods("POST")
+	students.HandleFunc("/students", handler.ListStudentsHandler()).Methods("GET")
+	students.HandleFunc("/students/{id:[0-9]+}", handler.UpdateStudentEmailHandler()).Methods("PATCH")
+	students.HandleFunc("/students/{id:[0-9]+}", handler.DeleteStudentHandler()).Methods("DELETE")
+
+	// students manage their own privacy settings, the use case decides whose
+	api.HandleFunc("/students/{id:[0-9]+}/privacy", handler.GetPrivacySettingsHandler()).Methods("GET")
+	api.HandleFunc("/students/{id:[0-9]+}/privacy", handler.UpdatePrivacySettingsHandler()).Methods("PUT")
+
+	// any authenticated caller may browse the catalog and who teaches what
+	api.HandleFunc("/courses/catalog", handler.ListCatalogHandler()).Methods("GET")
+	api.HandleFunc("/courses/catalog/{id:[0-9]+}/sections", handler.ListCourseSectionsHandler()).Methods("GET")
+	api.HandleFunc("/terms", handler.ListTermsHandler()).Methods("GET")
+	api.HandleFunc("/instructors/{id:[0-9]+}/courses", handler.ListInstructorCoursesHandler()).Methods("GET")
+
+	catalog := api.NewRoute().Subrouter()
+	catalog.Use(handler.RequirePermission(auth.PermManageCourses))
+	catalog.HandleFunc("/courses/catalog", handler.CreateCourseHandler()).Methods("POST")
+	catalog.HandleFunc("/courses/catalog/{id:[0-9]+}", handler.RenameCourseHandler()).Methods("PATCH")
+	catalog.HandleFunc("/courses/catalog/{id:[0-9]+}/archive", handler.ArchiveCourseHandler()).Methods("POST")
+	catalog.HandleFunc("/courses/catalog/{id:[0-9]+}/prerequisites", handler.SetPrerequisitesHandler()).Methods("PUT")
+	catalog.HandleFunc("/courses/catalog/{id:[0-9]+}/sections", handler.CreateSectionHandler()).Methods("POST")
+	catalog.HandleFunc("/terms", handler.CreateTermHandler()).Methods("POST")
+	catalog.HandleFunc("/courses/catalog/{id:[0-9]+}/instructors/{instructor_id:[0-9]+}", handler.AssignInstructorHandler()).Methods("PUT")
+	catalog.HandleFunc("/courses/catalog/{id:[0-9]+}/instructors/{instructor_id:[0-9]+}", handler.UnassignInstructorHandler()).Methods("DELETE")
+
+	// the use case checks the permission of each imported file
+	imports := api.NewRoute().Subrouter()
+	imports.Use(handler.RequirePermission(auth.PermManageStudents, auth.PermManageCourses, auth.PermManageEnrollments))
+	imports.HandleFunc("/imports", handler.ImportHandler()).Methods("POST")
+
+	exports := api.NewRoute().Subrouter()
+	exports.Use(handler.RequirePermission(auth.PermManageEnrollments))
+	exports.HandleFunc("/exports/enrollments", handler.ExportEnrollmentsHandler()).Methods("GET")
+
+	// instructors see the rosters of the courses they teach, the use case checks which
+	rosters := api.NewRoute().Subrouter()
+	rosters.Use(handler.RequirePermission(auth.PermViewRosters))
+	rosters.HandleFunc("/courses/{id:[0-9]+}/roster", handler.CourseRosterHandler()).Methods("GET")
+
+	// instructors grade the courses they teach, the use case checks which
+	grades := api.NewRoute().Subrouter()
+	grades.Use(handler.RequirePermission(auth.PermWriteGrades))
+	grades.HandleFunc("/courses/{id:[0-9]+}/grades/{student_id:[0-9]+}", handler.RecordGradeHandler()).Methods("PUT")
 
 	return r
 }
@@ -0,0 +1,583 @@
+package catalogusecase
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	common "github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/auth"
+	"github/rakadityas/course-management-system/common/transaction"
+	courseDomain "github/rakadityas/course-management-system/domain/course"
+	instructorDomain "github/rakadityas/course-management-system/domain/instructor"
+	sectionDomain "github/rakadityas/course-management-system/domain/section"
+	termDomain "github/rakadityas/course-management-system/domain/term"
+)
+
+// CatalogUseCaseItf defines the interface for the CatalogUseCase.
+type CatalogUseCaseItf interface {
+	CreateCourse(ctx context.Context, req CreateCourseRequest) (CourseResp, error)
+	RenameCourse(ctx context.Context, req RenameCourseRequest) (CourseResp, error)
+	ArchiveCourse(ctx context.Context, courseID int64) (ArchiveCourseResp, error)
+	ListCatalog(ctx context.Context, req ListCatalogRequest) (ListCatalogResp, error)
+	SetPrerequisites(ctx context.Context, req SetPrerequisitesRequest) (CourseResp, error)
+	AssignInstructor(ctx context.Context, req CourseInstructorRequest) (CourseInstructorsResp, error)
+	UnassignInstructor(ctx context.Context, req CourseInstructorRequest) (CourseInstructorsResp, error)
+	ListInstructorCourses(ctx context.Context, instructorID int64) (ListInstructorCoursesResp, error)
+	CreateTerm(ctx context.Context, req CreateTermRequest) (TermResp, error)
+	ListTerms(ctx context.Context) (ListTermsResp, error)
+	CreateSection(ctx context.Context, req CreateSectionRequest) (SectionResp, error)
+	ListCourseSections(ctx context.Context, courseID int64) (ListCourseSectionsResp, error)
+}
+
+type CatalogUseCase struct {
+	courseService     courseDomain.CourseDomainItf
+	instructorService instructorDomain.InstructorDomainItf
+	sectionService    sectionDomain.SectionDomainItf
+	termService       termDomain.TermDomainItf
+	unitOfWork        transaction.UnitOfWork
+}
+
+func NewCatalogUseCase(courseService courseDomain.CourseDomainItf, instructorService instructorDomain.InstructorDomainItf, sectionService sectionDomain.SectionDomainItf, termService termDomain.TermDomainItf, unitOfWork transaction.UnitOfWork) CatalogUseCaseItf {
+	return &CatalogUseCase{
+		courseService:     courseService,
+		instructorService: instructorService,
+		sectionService:    sectionService,
+		termService:       termService,
+		unitOfWork:        unitOfWork,
+	}
+}
+
+// CreateCourse adds a new course to the catalog.
+func (catalogUC *CatalogUseCase) CreateCourse(ctx context.Context, req CreateCourseRequest) (CourseResp, error) {
+	// Only course managers may change the catalog
+	if err := auth.Authorize(ctx, auth.PermManageCourses); err != nil {
+		return CourseResp{Status: common.StatusFailure, Message: courseErrorMessage(err, "failed to create course")}, err
+	}
+
+	creditHours := courseDomain.DefaultCreditHours
+	if req.CreditHours != nil {
+		creditHours = *req.CreditHours
+	}
+
+	course, err := catalogUC.courseService.CreateCourse(ctx, req.Name, req.Capacity, creditHours)
+	if err != nil {
+		return CourseResp{Status: common.StatusFailure, Message: courseErrorMessage(err, "failed to create course")}, err
+	}
+
+	return CourseResp{
+		Status:     common.StatusSuccess,
+		CourseData: toCatalogCourse(course),
+	}, nil
+}
+
+// RenameCourse changes the name of a course that is still in the catalog.
+func (catalogUC *CatalogUseCase) RenameCourse(ctx context.Context, req RenameCourseRequest) (CourseResp, error) {
+	// Only course managers may change the catalog
+	if err := auth.Authorize(ctx, auth.PermManageCourses); err != nil {
+		return CourseResp{Status: common.StatusFailure, Message: courseErrorMessage(err, "failed to rename course")}, err
+	}
+
+	// Ensure the course data exists
+	courseData, err := catalogUC.courseService.GetCourseByID(ctx, req.CourseID)
+	if err != nil {
+		return CourseResp{Status: common.StatusFailure, Message: "failed to retrieve course data"}, err
+	}
+	if courseData == nil {
+		return CourseResp{Status: common.StatusFailure, Message: "course data not found"}, nil
+	}
+	if courseData.IsArchived() {
+		return CourseResp{Status: common.StatusFailure, Message: "course is archived"}, nil
+	}
+
+	err = catalogUC.courseService.UpdateCourseName(ctx, req.CourseID, req.Name)
+	if err != nil {
+		return CourseResp{Status: common.StatusFailure, Message: courseErrorMessage(err, "failed to rename course")}, err
+	}
+
+	// Re-read so the response reflects the stored name and update time
+	courseData, err = catalogUC.courseService.GetCourseByID(ctx, req.CourseID)
+	if err != nil {
+		return CourseResp{Status: common.StatusFailure, Message: "failed to retrieve course data"}, err
+	}
+	if courseData == nil {
+		return CourseResp{Status: common.StatusFailure, Message: "course data not found"}, nil
+	}
+
+	return CourseResp{
+		Status:     common.StatusSuccess,
+		CourseData: toCatalogCourse(*courseData),
+	}, nil
+}
+
+// ArchiveCourse removes a course from the catalog so it no longer accepts sign-ups.
+// Existing enrollments are kept untouched.
+func (catalogUC *CatalogUseCase) ArchiveCourse(ctx context.Context, courseID int64) (ArchiveCourseResp, error) {
+	// Only course managers may change the catalog
+	if err := auth.Authorize(ctx, auth.PermManageCourses); err != nil {
+		return ArchiveCourseResp{Status: common.StatusFailure, Message: courseErrorMessage(err, "failed to archive course")}, err
+	}
+
+	// Ensure the course data exists
+	courseData, err := catalogUC.courseService.GetCourseByID(ctx, courseID)
+	if err != nil {
+		return ArchiveCourseResp{Status: common.StatusFailure, Message: "failed to retrieve course data"}, err
+	}
+	if courseData == nil {
+		return ArchiveCourseResp{Status: common.StatusFailure, Message: "course data not found"}, nil
+	}
+	if courseData.IsArchived() {
+		return ArchiveCourseResp{Status: common.StatusFailure, Message: "course is already archived"}, nil
+	}
+
+	err = catalogUC.courseService.ArchiveCourse(ctx, courseID)
+	if err != nil {
+		return ArchiveCourseResp{Status: common.StatusFailure, Message: "failed to archive course"}, err
+	}
+
+	return ArchiveCourseResp{
+		Status: common.StatusSuccess,
+	}, nil
+}
+
+// ListCatalog retrieves a page of the catalog, optionally filtered by course name.
+func (catalogUC *CatalogUseCase) ListCatalog(ctx context.Context, req ListCatalogRequest) (ListCatalogResp, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = DefaultListLimit
+	}
+	if limit > MaxListLimit {
+		limit = MaxListLimit
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	var (
+		courses []courseDomain.Course
+		err     error
+	)
+	if req.Name != "" {
+		courses, err = catalogUC.courseService.SearchCoursesByName(ctx, req.Name, req.IncludeArchived, limit, offset)
+	} else {
+		courses, err = catalogUC.courseService.GetCourses(ctx, req.IncludeArchived, limit, offset)
+	}
+	if err != nil {
+		return ListCatalogResp{Status: common.StatusFailure, Message: "failed to retrieve courses"}, err
+	}
+
+	var catalogCourses []CatalogCourse
+	for _, course := range courses {
+		catalogCourses = append(catalogCourses, *toCatalogCourse(course))
+	}
+
+	return ListCatalogResp{
+		Status:  common.StatusSuccess,
+		Courses: catalogCourses,
+		Limit:   limit,
+		Offset:  offset,
+	}, nil
+}
+
+// SetPrerequisites replaces the courses a student must complete before signing up for the course.
+// An empty list removes every prerequisite.
+func (catalogUC *CatalogUseCase) SetPrerequisites(ctx context.Context, req SetPrerequisitesRequest) (CourseResp, error) {
+	// Only course managers may change the catalog
+	if err := auth.Authorize(ctx, auth.PermManageCourses); err != nil {
+		return CourseResp{Status: common.StatusFailure, Message: courseErrorMessage(err, "failed to set course prerequisites")}, err
+	}
+
+	// Ensure the course and its prerequisites exist
+	courseData, err := catalogUC.courseService.GetCourseByID(ctx, req.CourseID)
+	if err != nil {
+		return CourseResp{Status: common.StatusFailure, Message: "failed to retrieve course data"}, err
+	}
+	if courseData == nil {
+		return CourseResp{Status: common.StatusFailure, Message: "course data not found"}, nil
+	}
+	prerequisiteByID, err := catalogUC.courseService.GetCoursesByIDs(ctx, req.PrerequisiteCourseIDs)
+	if err != nil {
+		return CourseResp{Status: common.StatusFailure, Message: "failed to retrieve course data"}, err
+	}
+	for _, prerequisiteID := range req.PrerequisiteCourseIDs {
+		if _, ok := prerequisiteByID[prerequisiteID]; !ok {
+			return CourseResp{Status: common.StatusFailure, Message: "prerequisite course data not found for courseID: " + strconv.FormatInt(prerequisiteID, 10)}, nil
+		}
+	}
+
+	// Check for cycles and write against the same prerequisite graph
+	var prerequisiteIDs []int64
+	err = catalogUC.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		var err error
+		prerequisiteIDs, err = catalogUC.courseService.SetPrerequisites(ctx, req.CourseID, req.PrerequisiteCourseIDs)
+		return err
+	})
+	if err != nil {
+		return CourseResp{Status: common.StatusFailure, Message: courseErrorMessage(err, "failed to set course prerequisites")}, err
+	}
+
+	catalogCourse := toCatalogCourse(*courseData)
+	catalogCourse.PrerequisiteCourseIDs = prerequisiteIDs
+
+	return CourseResp{
+		Status:     common.StatusSuccess,
+		CourseData: catalogCourse,
+	}, nil
+}
+
+// AssignInstructor assigns an instructor to teach a course that is still in the catalog.
+func (catalogUC *CatalogUseCase) AssignInstructor(ctx context.Context, req CourseInstructorRequest) (CourseInstructorsResp, error) {
+	// Only course managers may change the catalog
+	if err := auth.Authorize(ctx, auth.PermManageCourses); err != nil {
+		return CourseInstructorsResp{Status: common.StatusFailure, Message: courseErrorMessage(err, "failed to assign instructor")}, err
+	}
+
+	// Ensure the course and instructor data exist
+	courseData, err := catalogUC.courseService.GetCourseByID(ctx, req.CourseID)
+	if err != nil {
+		return CourseInstructorsResp{Status: common.StatusFailure, Message: "failed to retrieve course data"}, err
+	}
+	if courseData == nil {
+		return CourseInstructorsResp{Status: common.StatusFailure, Message: "course data not found"}, nil
+	}
+	if courseData.IsArchived() {
+		return CourseInstructorsResp{Status: common.StatusFailure, Message: "course is archived"}, nil
+	}
+	instructorData, err := catalogUC.instructorService.GetInstructorByID(ctx, req.InstructorID)
+	if err != nil {
+		return CourseInstructorsResp{Status: common.StatusFailure, Message: "failed to retrieve instructor data"}, err
+	}
+	if instructorData == nil {
+		return CourseInstructorsResp{Status: common.StatusFailure, Message: "instructor data not found"}, nil
+	}
+
+	err = catalogUC.instructorService.AssignCourse(ctx, req.InstructorID, req.CourseID)
+	if err != nil {
+		return CourseInstructorsResp{Status: common.StatusFailure, Message: courseErrorMessage(err, "failed to assign instructor")}, err
+	}
+
+	return catalogUC.courseInstructors(ctx, req.CourseID)
+}
+
+// UnassignInstructor removes an instructor from a course.
+func (catalogUC *CatalogUseCase) UnassignInstructor(ctx context.Context, req CourseInstructorRequest) (CourseInstructorsResp, error) {
+	// Only course managers may change the catalog
+	if err := auth.Authorize(ctx, auth.PermManageCourses); err != nil {
+		return CourseInstructorsResp{Status: common.StatusFailure, Message: courseErrorMessage(err, "failed to unassign instructor")}, err
+	}
+
+	// Ensure the course data exists
+	courseData, err := catalogUC.courseService.GetCourseByID(ctx, req.CourseID)
+	if err != nil {
+		return CourseInstructorsResp{Status: common.StatusFailure, Message: "failed to retrieve course data"}, err
+	}
+	if courseData == nil {
+		return CourseInstructorsResp{Status: common.StatusFailure, Message: "course data not found"}, nil
+	}
+
+	err = catalogUC.instructorService.UnassignCourse(ctx, req.InstructorID, req.CourseID)
+	if err != nil {
+		return CourseInstructorsResp{Status: common.StatusFailure, Message: courseErrorMessage(err, "failed to unassign instructor")}, err
+	}
+
+	return catalogUC.courseInstructors(ctx, req.CourseID)
+}
+
+// ListInstructorCourses retrieves the courses an instructor teaches, archived ones included.
+func (catalogUC *CatalogUseCase) ListInstructorCourses(ctx context.Context, instructorID int64) (ListInstructorCoursesResp, error) {
+	// Ensure the instructor data exists
+	instructorData, err := catalogUC.instructorService.GetInstructorByID(ctx, instructorID)
+	if err != nil {
+		return ListInstructorCoursesResp{Status: common.StatusFailure, Message: "failed to retrieve instructor data"}, err
+	}
+	if instructorData == nil {
+		return ListInstructorCoursesResp{Status: common.StatusFailure, Message: "instructor data not found"}, nil
+	}
+
+	courseIDs, err := catalogUC.instructorService.GetCourseIDsByInstructorID(ctx, instructorID)
+	if err != nil {
+		return ListInstructorCoursesResp{Status: common.StatusFailure, Message: "failed to retrieve instructor courses"}, err
+	}
+	courseByID, err := catalogUC.courseService.GetCoursesByIDs(ctx, courseIDs)
+	if err != nil {
+		return ListInstructorCoursesResp{Status: common.StatusFailure, Message: "failed to retrieve course data"}, err
+	}
+
+	var catalogCourses []CatalogCourse
+	for _, courseID := range courseIDs {
+		course, ok := courseByID[courseID]
+		if !ok {
+			return ListInstructorCoursesResp{Status: common.StatusFailure, Message: "course data is not found for courseID: " + strconv.FormatInt(courseID, 10)}, nil
+		}
+		catalogCourses = append(catalogCourses, *toCatalogCourse(course))
+	}
+
+	return ListInstructorCoursesResp{
+		Status:         common.StatusSuccess,
+		InstructorData: toInstructorDetail(*instructorData),
+		Courses:        catalogCourses,
+	}, nil
+}
+
+// CreateTerm adds a new academic term with its enrollment window and drop deadline.
+func (catalogUC *CatalogUseCase) CreateTerm(ctx context.Context, req CreateTermRequest) (TermResp, error) {
+	// Only course managers may change the catalog
+	if err := auth.Authorize(ctx, auth.PermManageCourses); err != nil {
+		return TermResp{Status: common.StatusFailure, Message: courseErrorMessage(err, "failed to create term")}, err
+	}
+
+	term, err := catalogUC.termService.CreateTerm(ctx, termDomain.Term{
+		Name:                req.Name,
+		StartDate:           req.StartDate,
+		EndDate:             req.EndDate,
+		EnrollmentOpenTime:  req.EnrollmentOpenTime,
+		EnrollmentCloseTime: req.EnrollmentCloseTime,
+		DropDeadline:        req.DropDeadline,
+	})
+	if err != nil {
+		return TermResp{Status: common.StatusFailure, Message: courseErrorMessage(err, "failed to create term")}, err
+	}
+
+	return TermResp{
+		Status:   common.StatusSuccess,
+		TermData: toTermDetail(term),
+	}, nil
+}
+
+// ListTerms retrieves every academic term, the most recent first.
+func (catalogUC *CatalogUseCase) ListTerms(ctx context.Context) (ListTermsResp, error) {
+	terms, err := catalogUC.termService.GetTerms(ctx)
+	if err != nil {
+		return ListTermsResp{Status: common.StatusFailure, Message: "failed to retrieve terms"}, err
+	}
+
+	var termDetails []TermDetail
+	for _, term := range terms {
+		termDetails = append(termDetails, *toTermDetail(term))
+	}
+
+	return ListTermsResp{
+		Status: common.StatusSuccess,
+		Terms:  termDetails,
+	}, nil
+}
+
+// CreateSection offers a course that is still in the catalog in a term.
+// The section takes the course capacity unless the request sets its own.
+func (catalogUC *CatalogUseCase) CreateSection(ctx context.Context, req CreateSectionRequest) (SectionResp, error) {
+	// Only course managers may change the catalog
+	if err := auth.Authorize(ctx, auth.PermManageCourses); err != nil {
+		return SectionResp{Status: common.StatusFailure, Message: courseErrorMessage(err, "failed to create section")}, err
+	}
+
+	// Ensure the course and term data exist
+	courseData, err := catalogUC.courseService.GetCourseByID(ctx, req.CourseID)
+	if err != nil {
+		return SectionResp{Status: common.StatusFailure, Message: "failed to retrieve course data"}, err
+	}
+	if courseData == nil {
+		return SectionResp{Status: common.StatusFailure, Message: "course data not found"}, nil
+	}
+	if courseData.IsArchived() {
+		return SectionResp{Status: common.StatusFailure, Message: "course is archived"}, nil
+	}
+	termData, err := catalogUC.termService.GetTermByID(ctx, req.TermID)
+	if err != nil {
+		return SectionResp{Status: common.StatusFailure, Message: "failed to retrieve term data"}, err
+	}
+	if termData == nil {
+		return SectionResp{Status: common.StatusFailure, Message: "term data not found"}, nil
+	}
+
+	capacity := courseData.Capacity
+	if req.Capacity != nil {
+		capacity = *req.Capacity
+	}
+	meetings := make([]sectionDomain.Meeting, 0, len(req.Meetings))
+	for _, meeting := range req.Meetings {
+		meetings = append(meetings, sectionDomain.Meeting{
+			DayOfWeek: meeting.DayOfWeek,
+			StartTime: meeting.StartTime,
+			EndTime:   meeting.EndTime,
+			Room:      meeting.Room,
+		})
+	}
+
+	// Store the section together with its meetings
+	var section sectionDomain.Section
+	err = catalogUC.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		var err error
+		section, err = catalogUC.sectionService.CreateSection(ctx, courseData.ID, termData.ID, capacity)
+		if err != nil {
+			return err
+		}
+		meetings, err = catalogUC.sectionService.AddMeetings(ctx, section.ID, meetings)
+		return err
+	})
+	if err != nil {
+		return SectionResp{Status: common.StatusFailure, Message: courseErrorMessage(err, "failed to create section")}, err
+	}
+
+	return SectionResp{
+		Status:      common.StatusSuccess,
+		SectionData: toSectionDetail(section, *termData, meetings),
+	}, nil
+}
+
+// ListCourseSections retrieves the sections a course is offered in, ordered by term.
+func (catalogUC *CatalogUseCase) ListCourseSections(ctx context.Context, courseID int64) (ListCourseSectionsResp, error) {
+	// Ensure the course data exists
+	courseData, err := catalogUC.courseService.GetCourseByID(ctx, courseID)
+	if err != nil {
+		return ListCourseSectionsResp{Status: common.StatusFailure, Message: "failed to retrieve course data"}, err
+	}
+	if courseData == nil {
+		return ListCourseSectionsResp{Status: common.StatusFailure, Message: "course data not found"}, nil
+	}
+
+	sections, err := catalogUC.sectionService.GetSectionsByCourseID(ctx, courseID)
+	if err != nil {
+		return ListCourseSectionsResp{Status: common.StatusFailure, Message: "failed to retrieve section data"}, err
+	}
+	termIDs := make([]int64, 0, len(sections))
+	for _, section := range sections {
+		termIDs = append(termIDs, section.TermID)
+	}
+	termByID, err := catalogUC.termService.GetTermsByIDs(ctx, termIDs)
+	if err != nil {
+		return ListCourseSectionsResp{Status: common.StatusFailure, Message: "failed to retrieve term data"}, err
+	}
+	sectionIDs := make([]int64, 0, len(sections))
+	for _, section := range sections {
+		sectionIDs = append(sectionIDs, section.ID)
+	}
+	meetingsBySectionID, err := catalogUC.sectionService.GetMeetingsBySectionIDs(ctx, sectionIDs)
+	if err != nil {
+		return ListCourseSectionsResp{Status: common.StatusFailure, Message: "failed to retrieve section meetings"}, err
+	}
+
+	var sectionDetails []SectionDetail
+	for _, section := range sections {
+		sectionDetails = append(sectionDetails, *toSectionDetail(section, termByID[section.TermID], meetingsBySectionID[section.ID]))
+	}
+
+	return ListCourseSectionsResp{
+		Status:   common.StatusSuccess,
+		CourseID: courseID,
+		Sections: sectionDetails,
+	}, nil
+}
+
+// courseInstructors builds the response listing the current instructors of a course.
+func (catalogUC *CatalogUseCase) courseInstructors(ctx context.Context, courseID int64) (CourseInstructorsResp, error) {
+	instructorsByCourseID, err := catalogUC.instructorService.GetInstructorsByCourseIDs(ctx, []int64{courseID})
+	if err != nil {
+		return CourseInstructorsResp{Status: common.StatusFailure, Message: "failed to retrieve course instructors"}, err
+	}
+
+	var instructors []InstructorDetail
+	for _, instructor := range instructorsByCourseID[courseID] {
+		instructors = append(instructors, *toInstructorDetail(instructor))
+	}
+
+	return CourseInstructorsResp{
+		Status:      common.StatusSuccess,
+		CourseID:    courseID,
+		Instructors: instructors,
+	}, nil
+}
+
+// courseErrorMessage maps known course domain errors to a client facing message.
+func courseErrorMessage(err error, fallback string) string {
+	switch {
+	case errors.Is(err, courseDomain.ErrInvalidCourseName):
+		return "invalid course name"
+	case errors.Is(err, courseDomain.ErrInvalidCourseCapacity):
+		return "invalid course capacity"
+	case errors.Is(err, courseDomain.ErrInvalidCreditHours):
+		return "invalid course credit hours"
+	case errors.Is(err, courseDomain.ErrPrerequisiteCycle):
+		return "course prerequisites would form a cycle"
+	case errors.Is(err, instructorDomain.ErrAlreadyAssigned):
+		return "instructor is already assigned to the course"
+	case errors.Is(err, instructorDomain.ErrNotAssigned):
+		return "instructor is not assigned to the course"
+	case errors.Is(err, termDomain.ErrInvalidTermName):
+		return "invalid term name"
+	case errors.Is(err, termDomain.ErrInvalidTermDates):
+		return "term must end after it starts"
+	case errors.Is(err, termDomain.ErrInvalidEnrollmentWindow):
+		return "enrollment window must close after it opens"
+	case errors.Is(err, termDomain.ErrInvalidDropDeadline):
+		return "drop deadline must be after enrollment opens"
+	case errors.Is(err, termDomain.ErrTermAlreadyExists):
+		return "term already exists"
+	case errors.Is(err, sectionDomain.ErrInvalidSectionCapacity):
+		return "invalid section capacity"
+	case errors.Is(err, sectionDomain.ErrInvalidMeeting):
+		return "meeting must end after it starts on the same day"
+	case errors.Is(err, sectionDomain.ErrOverlappingMeetings):
+		return "section meetings overlap"
+	case errors.Is(err, auth.ErrUnauthenticated):
+		return "authentication required"
+	case errors.Is(err, auth.ErrForbidden):
+		return "permission denied"
+	default:
+		return fallback
+	}
+}
+
+func toCatalogCourse(course courseDomain.Course) *CatalogCourse {
+	return &CatalogCourse{
+		CourseID:    course.ID,
+		CourseName:  course.Name,
+		Capacity:    course.Capacity,
+		CreditHours: course.CreditHours,
+		Archived:    course.IsArchived(),
+		ArchiveTime: course.ArchiveTime,
+		CreateTime:  course.CreateTime,
+		UpdateTime:  course.UpdateTime,
+	}
+}
+
+func toInstructorDetail(instructor instructorDomain.Instructor) *InstructorDetail {
+	return &InstructorDetail{
+		InstructorID: instructor.ID,
+		Name:         instructor.Name,
+		Email:        instructor.Email,
+	}
+}
+
+func toTermDetail(term termDomain.Term) *TermDetail {
+	return &TermDetail{
+		TermID:              term.ID,
+		Name:                term.Name,
+		StartDate:           term.StartDate,
+		EndDate:             term.EndDate,
+		EnrollmentOpenTime:  term.EnrollmentOpenTime,
+		EnrollmentCloseTime: term.EnrollmentCloseTime,
+		DropDeadline:        term.DropDeadline,
+	}
+}
+
+func toSectionDetail(section sectionDomain.Section, term termDomain.Term, meetings []sectionDomain.Meeting) *SectionDetail {
+	var meetingDetails []MeetingDetail
+	for _, meeting := range meetings {
+		meetingDetails = append(meetingDetails, MeetingDetail{
+			DayOfWeek: meeting.DayOfWeek,
+			StartTime: meeting.StartTime,
+			EndTime:   meeting.EndTime,
+			Room:      meeting.Room,
+		})
+	}
+
+	return &SectionDetail{
+		SectionID: section.ID,
+		CourseID:  section.CourseID,
+		TermID:    section.TermID,
+		TermName:  term.Name,
+		Capacity:  section.Capacity,
+		Meetings:  meetingDetails,
+	}
+}
@@ -0,0 +1,1266 @@
+package catalogusecase
+
+import (
+	"context"
+	"errors"
+	common "github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/auth"
+	"github/rakadityas/course-management-system/common/testutil"
+	courseDomain "github/rakadityas/course-management-system/domain/course"
+	courseDomainMock "github/rakadityas/course-management-system/domain/course/mocks"
+	instructorDomain "github/rakadityas/course-management-system/domain/instructor"
+	instructorDomainMock "github/rakadityas/course-management-system/domain/instructor/mocks"
+	sectionDomain "github/rakadityas/course-management-system/domain/section"
+	sectionDomainMock "github/rakadityas/course-management-system/domain/section/mocks"
+	termDomain "github/rakadityas/course-management-system/domain/term"
+	termDomainMock "github/rakadityas/course-management-system/domain/term/mocks"
+	"reflect"
+	"testing"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// adminCtx is authenticated as an administrator.
+var adminCtx = testutil.AdminCtx(auth.PermManageCourses)
+
+func TestCatalogUseCase_CreateCourse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const courseName = "Mathematics 101"
+	timestamp := time.Date(2023, 8, 25, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		courseService courseDomain.CourseDomainItf
+	}
+	type args struct {
+		ctx context.Context
+		req CreateCourseRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    CourseResp
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().CreateCourse(gomock.Any(), courseName, 30, courseDomain.DefaultCreditHours).Return(courseDomain.Course{ID: 1, Name: courseName, Capacity: 30, CreditHours: 3, CreateTime: timestamp, UpdateTime: timestamp}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: CreateCourseRequest{Name: courseName, Capacity: 30},
+			},
+			want: CourseResp{
+				Status:     common.StatusSuccess,
+				CourseData: &CatalogCourse{CourseID: 1, CourseName: courseName, Capacity: 30, CreditHours: 3, CreateTime: timestamp, UpdateTime: timestamp},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success With Credit Hours",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().CreateCourse(gomock.Any(), courseName, 30, 4).Return(courseDomain.Course{ID: 1, Name: courseName, Capacity: 30, CreditHours: 4, CreateTime: timestamp, UpdateTime: timestamp}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: CreateCourseRequest{Name: courseName, Capacity: 30, CreditHours: func() *int { hours := 4; return &hours }()},
+			},
+			want: CourseResp{
+				Status:     common.StatusSuccess,
+				CourseData: &CatalogCourse{CourseID: 1, CourseName: courseName, Capacity: 30, CreditHours: 4, CreateTime: timestamp, UpdateTime: timestamp},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Invalid Course Name",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().CreateCourse(gomock.Any(), "   ", 0, courseDomain.DefaultCreditHours).Return(courseDomain.Course{}, courseDomain.ErrInvalidCourseName)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: CreateCourseRequest{Name: "   "},
+			},
+			want:    CourseResp{Status: common.StatusFailure, Message: "invalid course name"},
+			wantErr: true,
+		},
+		{
+			name: "Invalid Course Capacity",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().CreateCourse(gomock.Any(), courseName, -1, courseDomain.DefaultCreditHours).Return(courseDomain.Course{}, courseDomain.ErrInvalidCourseCapacity)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: CreateCourseRequest{Name: courseName, Capacity: -1},
+			},
+			want:    CourseResp{Status: common.StatusFailure, Message: "invalid course capacity"},
+			wantErr: true,
+		},
+		{
+			name: "Not A Course Manager",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					return courseDomainMock.NewMockCourseDomainItf(ctrl)
+				}(),
+			},
+			args: args{
+				ctx: auth.WithPrincipal(context.Background(), auth.Principal{Subject: "student:1", StudentID: 1, Roles: []string{auth.RoleStudent}}),
+				req: CreateCourseRequest{Name: courseName, Capacity: 30},
+			},
+			want:    CourseResp{Status: common.StatusFailure, Message: "permission denied"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalogUC := &CatalogUseCase{
+				courseService: tt.fields.courseService,
+			}
+			got, err := catalogUC.CreateCourse(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CatalogUseCase.CreateCourse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CatalogUseCase.CreateCourse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCatalogUseCase_RenameCourse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const (
+		courseID   int64 = 1
+		courseName       = "Mathematics 102"
+	)
+	timestamp := time.Date(2023, 8, 25, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		courseService courseDomain.CourseDomainItf
+	}
+	type args struct {
+		ctx context.Context
+		req RenameCourseRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    CourseResp
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					gomock.InOrder(
+						mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Mathematics 101"}, nil),
+						mock.EXPECT().UpdateCourseName(gomock.Any(), courseID, courseName).Return(nil),
+						mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: courseName, CreateTime: timestamp, UpdateTime: timestamp}, nil),
+					)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: RenameCourseRequest{CourseID: courseID, Name: courseName},
+			},
+			want: CourseResp{
+				Status:     common.StatusSuccess,
+				CourseData: &CatalogCourse{CourseID: courseID, CourseName: courseName, CreateTime: timestamp, UpdateTime: timestamp},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Course Not Found",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(nil, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: RenameCourseRequest{CourseID: courseID, Name: courseName},
+			},
+			want:    CourseResp{Status: common.StatusFailure, Message: "course data not found"},
+			wantErr: false,
+		},
+		{
+			name: "Course Archived",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Mathematics 101", ArchiveTime: &timestamp}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: RenameCourseRequest{CourseID: courseID, Name: courseName},
+			},
+			want:    CourseResp{Status: common.StatusFailure, Message: "course is archived"},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalogUC := &CatalogUseCase{
+				courseService: tt.fields.courseService,
+			}
+			got, err := catalogUC.RenameCourse(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CatalogUseCase.RenameCourse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CatalogUseCase.RenameCourse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCatalogUseCase_ArchiveCourse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const courseID int64 = 1
+	timestamp := time.Date(2023, 8, 25, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		courseService courseDomain.CourseDomainItf
+	}
+	type args struct {
+		ctx      context.Context
+		courseID int64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    ArchiveCourseResp
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID}, nil)
+					mock.EXPECT().ArchiveCourse(gomock.Any(), courseID).Return(nil)
+					return mock
+				}(),
+			},
+			args:    args{ctx: adminCtx, courseID: courseID},
+			want:    ArchiveCourseResp{Status: common.StatusSuccess},
+			wantErr: false,
+		},
+		{
+			name: "Already Archived",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, ArchiveTime: &timestamp}, nil)
+					return mock
+				}(),
+			},
+			args:    args{ctx: adminCtx, courseID: courseID},
+			want:    ArchiveCourseResp{Status: common.StatusFailure, Message: "course is already archived"},
+			wantErr: false,
+		},
+		{
+			name: "Failed to Archive",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID}, nil)
+					mock.EXPECT().ArchiveCourse(gomock.Any(), courseID).Return(errors.New("db error"))
+					return mock
+				}(),
+			},
+			args:    args{ctx: adminCtx, courseID: courseID},
+			want:    ArchiveCourseResp{Status: common.StatusFailure, Message: "failed to archive course"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalogUC := &CatalogUseCase{
+				courseService: tt.fields.courseService,
+			}
+			got, err := catalogUC.ArchiveCourse(tt.args.ctx, tt.args.courseID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CatalogUseCase.ArchiveCourse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CatalogUseCase.ArchiveCourse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCatalogUseCase_ListCatalog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	timestamp := time.Date(2023, 8, 25, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		courseService courseDomain.CourseDomainItf
+	}
+	type args struct {
+		ctx context.Context
+		req ListCatalogRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    ListCatalogResp
+		wantErr bool
+	}{
+		{
+			name: "List All",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourses(gomock.Any(), false, DefaultListLimit, 0).Return([]courseDomain.Course{
+						{ID: 1, Name: "Mathematics 101", CreateTime: timestamp, UpdateTime: timestamp},
+					}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: ListCatalogRequest{},
+			},
+			want: ListCatalogResp{
+				Status: common.StatusSuccess,
+				Courses: []CatalogCourse{
+					{CourseID: 1, CourseName: "Mathematics 101", CreateTime: timestamp, UpdateTime: timestamp},
+				},
+				Limit:  DefaultListLimit,
+				Offset: 0,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Search By Name",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().SearchCoursesByName(gomock.Any(), "art", true, 5, 10).Return([]courseDomain.Course{
+						{ID: 3, Name: "History of Art", ArchiveTime: &timestamp, CreateTime: timestamp, UpdateTime: timestamp},
+					}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: ListCatalogRequest{Name: "art", IncludeArchived: true, Limit: 5, Offset: 10},
+			},
+			want: ListCatalogResp{
+				Status: common.StatusSuccess,
+				Courses: []CatalogCourse{
+					{CourseID: 3, CourseName: "History of Art", Archived: true, ArchiveTime: &timestamp, CreateTime: timestamp, UpdateTime: timestamp},
+				},
+				Limit:  5,
+				Offset: 10,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Failed to Retrieve Courses",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourses(gomock.Any(), false, MaxListLimit, 0).Return(nil, errors.New("db error"))
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: ListCatalogRequest{Limit: 500},
+			},
+			want:    ListCatalogResp{Status: common.StatusFailure, Message: "failed to retrieve courses"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalogUC := &CatalogUseCase{
+				courseService: tt.fields.courseService,
+			}
+			got, err := catalogUC.ListCatalog(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CatalogUseCase.ListCatalog() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CatalogUseCase.ListCatalog() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCatalogUseCase_SetPrerequisites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	timestamp := time.Now()
+	type fields struct {
+		courseService courseDomain.CourseDomainItf
+	}
+	type args struct {
+		ctx context.Context
+		req SetPrerequisitesRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    CourseResp
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), int64(3)).Return(&courseDomain.Course{ID: 3, Name: "Calculus", CreateTime: timestamp, UpdateTime: timestamp}, nil)
+					mock.EXPECT().GetCoursesByIDs(gomock.Any(), []int64{2, 1}).Return(map[int64]courseDomain.Course{1: {ID: 1}, 2: {ID: 2}}, nil)
+					mock.EXPECT().SetPrerequisites(gomock.Any(), int64(3), []int64{2, 1}).Return([]int64{1, 2}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: SetPrerequisitesRequest{CourseID: 3, PrerequisiteCourseIDs: []int64{2, 1}},
+			},
+			want: CourseResp{
+				Status: common.StatusSuccess,
+				CourseData: &CatalogCourse{
+					CourseID:              3,
+					CourseName:            "Calculus",
+					PrerequisiteCourseIDs: []int64{1, 2},
+					CreateTime:            timestamp,
+					UpdateTime:            timestamp,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Course Not Found",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), int64(3)).Return(nil, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: SetPrerequisitesRequest{CourseID: 3, PrerequisiteCourseIDs: []int64{1}},
+			},
+			want:    CourseResp{Status: common.StatusFailure, Message: "course data not found"},
+			wantErr: false,
+		},
+		{
+			name: "Prerequisite Not Found",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), int64(3)).Return(&courseDomain.Course{ID: 3}, nil)
+					mock.EXPECT().GetCoursesByIDs(gomock.Any(), []int64{9}).Return(map[int64]courseDomain.Course{}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: SetPrerequisitesRequest{CourseID: 3, PrerequisiteCourseIDs: []int64{9}},
+			},
+			want:    CourseResp{Status: common.StatusFailure, Message: "prerequisite course data not found for courseID: 9"},
+			wantErr: false,
+		},
+		{
+			name: "Prerequisites Form A Cycle",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), int64(1)).Return(&courseDomain.Course{ID: 1}, nil)
+					mock.EXPECT().GetCoursesByIDs(gomock.Any(), []int64{3}).Return(map[int64]courseDomain.Course{3: {ID: 3}}, nil)
+					mock.EXPECT().SetPrerequisites(gomock.Any(), int64(1), []int64{3}).Return(nil, courseDomain.ErrPrerequisiteCycle)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: SetPrerequisitesRequest{CourseID: 1, PrerequisiteCourseIDs: []int64{3}},
+			},
+			want:    CourseResp{Status: common.StatusFailure, Message: "course prerequisites would form a cycle"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalogUC := &CatalogUseCase{
+				courseService: tt.fields.courseService,
+				unitOfWork:    testutil.NewPassThroughUnitOfWork(ctrl),
+			}
+			got, err := catalogUC.SetPrerequisites(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CatalogUseCase.SetPrerequisites() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CatalogUseCase.SetPrerequisites() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCatalogUseCase_AssignInstructor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const (
+		courseID     int64 = 101
+		instructorID int64 = 1
+	)
+	archiveTime := time.Date(2023, 8, 25, 0, 0, 0, 0, time.UTC)
+	instructor := instructorDomain.Instructor{ID: instructorID, Name: "Ada Lovelace", Email: "ada@example.com"}
+
+	type fields struct {
+		courseService     courseDomain.CourseDomainItf
+		instructorService instructorDomain.InstructorDomainItf
+	}
+	type args struct {
+		ctx context.Context
+		req CourseInstructorRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    CourseInstructorsResp
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Mathematics 101"}, nil)
+					return mock
+				}(),
+				instructorService: func() instructorDomain.InstructorDomainItf {
+					mock := instructorDomainMock.NewMockInstructorDomainItf(ctrl)
+					gomock.InOrder(
+						mock.EXPECT().GetInstructorByID(gomock.Any(), instructorID).Return(&instructor, nil),
+						mock.EXPECT().AssignCourse(gomock.Any(), instructorID, courseID).Return(nil),
+						mock.EXPECT().GetInstructorsByCourseIDs(gomock.Any(), []int64{courseID}).Return(map[int64][]instructorDomain.Instructor{courseID: {instructor}}, nil),
+					)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: CourseInstructorRequest{CourseID: courseID, InstructorID: instructorID},
+			},
+			want: CourseInstructorsResp{
+				Status:      common.StatusSuccess,
+				CourseID:    courseID,
+				Instructors: []InstructorDetail{{InstructorID: instructorID, Name: "Ada Lovelace", Email: "ada@example.com"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Course Archived",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, ArchiveTime: &archiveTime}, nil)
+					return mock
+				}(),
+				instructorService: instructorDomainMock.NewMockInstructorDomainItf(ctrl),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: CourseInstructorRequest{CourseID: courseID, InstructorID: instructorID},
+			},
+			want:    CourseInstructorsResp{Status: common.StatusFailure, Message: "course is archived"},
+			wantErr: false,
+		},
+		{
+			name: "Instructor Not Found",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID}, nil)
+					return mock
+				}(),
+				instructorService: func() instructorDomain.InstructorDomainItf {
+					mock := instructorDomainMock.NewMockInstructorDomainItf(ctrl)
+					mock.EXPECT().GetInstructorByID(gomock.Any(), instructorID).Return(nil, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: CourseInstructorRequest{CourseID: courseID, InstructorID: instructorID},
+			},
+			want:    CourseInstructorsResp{Status: common.StatusFailure, Message: "instructor data not found"},
+			wantErr: false,
+		},
+		{
+			name: "Already Assigned",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID}, nil)
+					return mock
+				}(),
+				instructorService: func() instructorDomain.InstructorDomainItf {
+					mock := instructorDomainMock.NewMockInstructorDomainItf(ctrl)
+					mock.EXPECT().GetInstructorByID(gomock.Any(), instructorID).Return(&instructor, nil)
+					mock.EXPECT().AssignCourse(gomock.Any(), instructorID, courseID).Return(instructorDomain.ErrAlreadyAssigned)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: CourseInstructorRequest{CourseID: courseID, InstructorID: instructorID},
+			},
+			want:    CourseInstructorsResp{Status: common.StatusFailure, Message: "instructor is already assigned to the course"},
+			wantErr: true,
+		},
+		{
+			name: "Not A Course Manager",
+			fields: fields{
+				courseService:     courseDomainMock.NewMockCourseDomainItf(ctrl),
+				instructorService: instructorDomainMock.NewMockInstructorDomainItf(ctrl),
+			},
+			args: args{
+				ctx: auth.WithPrincipal(context.Background(), auth.Principal{Subject: "student:1", StudentID: 1, Roles: []string{auth.RoleStudent}}),
+				req: CourseInstructorRequest{CourseID: courseID, InstructorID: instructorID},
+			},
+			want:    CourseInstructorsResp{Status: common.StatusFailure, Message: "permission denied"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalogUC := &CatalogUseCase{
+				courseService:     tt.fields.courseService,
+				instructorService: tt.fields.instructorService,
+			}
+			got, err := catalogUC.AssignInstructor(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CatalogUseCase.AssignInstructor() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CatalogUseCase.AssignInstructor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCatalogUseCase_UnassignInstructor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const (
+		courseID     int64 = 101
+		instructorID int64 = 1
+	)
+
+	type fields struct {
+		courseService     courseDomain.CourseDomainItf
+		instructorService instructorDomain.InstructorDomainItf
+	}
+	type args struct {
+		ctx context.Context
+		req CourseInstructorRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    CourseInstructorsResp
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID}, nil)
+					return mock
+				}(),
+				instructorService: func() instructorDomain.InstructorDomainItf {
+					mock := instructorDomainMock.NewMockInstructorDomainItf(ctrl)
+					gomock.InOrder(
+						mock.EXPECT().UnassignCourse(gomock.Any(), instructorID, courseID).Return(nil),
+						mock.EXPECT().GetInstructorsByCourseIDs(gomock.Any(), []int64{courseID}).Return(map[int64][]instructorDomain.Instructor{}, nil),
+					)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: CourseInstructorRequest{CourseID: courseID, InstructorID: instructorID},
+			},
+			want:    CourseInstructorsResp{Status: common.StatusSuccess, CourseID: courseID},
+			wantErr: false,
+		},
+		{
+			name: "Not Assigned",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID}, nil)
+					return mock
+				}(),
+				instructorService: func() instructorDomain.InstructorDomainItf {
+					mock := instructorDomainMock.NewMockInstructorDomainItf(ctrl)
+					mock.EXPECT().UnassignCourse(gomock.Any(), instructorID, courseID).Return(instructorDomain.ErrNotAssigned)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: CourseInstructorRequest{CourseID: courseID, InstructorID: instructorID},
+			},
+			want:    CourseInstructorsResp{Status: common.StatusFailure, Message: "instructor is not assigned to the course"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalogUC := &CatalogUseCase{
+				courseService:     tt.fields.courseService,
+				instructorService: tt.fields.instructorService,
+			}
+			got, err := catalogUC.UnassignInstructor(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CatalogUseCase.UnassignInstructor() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CatalogUseCase.UnassignInstructor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCatalogUseCase_ListInstructorCourses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const instructorID int64 = 1
+	timestamp := time.Date(2023, 8, 25, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		courseService     courseDomain.CourseDomainItf
+		instructorService instructorDomain.InstructorDomainItf
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    ListInstructorCoursesResp
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCoursesByIDs(gomock.Any(), []int64{1, 2}).Return(map[int64]courseDomain.Course{
+						1: {ID: 1, Name: "Mathematics 101", CreateTime: timestamp, UpdateTime: timestamp},
+						2: {ID: 2, Name: "Introduction to Programming", ArchiveTime: &timestamp, CreateTime: timestamp, UpdateTime: timestamp},
+					}, nil)
+					return mock
+				}(),
+				instructorService: func() instructorDomain.InstructorDomainItf {
+					mock := instructorDomainMock.NewMockInstructorDomainItf(ctrl)
+					mock.EXPECT().GetInstructorByID(gomock.Any(), instructorID).Return(&instructorDomain.Instructor{ID: instructorID, Name: "Ada Lovelace", Email: "ada@example.com"}, nil)
+					mock.EXPECT().GetCourseIDsByInstructorID(gomock.Any(), instructorID).Return([]int64{1, 2}, nil)
+					return mock
+				}(),
+			},
+			want: ListInstructorCoursesResp{
+				Status:         common.StatusSuccess,
+				InstructorData: &InstructorDetail{InstructorID: instructorID, Name: "Ada Lovelace", Email: "ada@example.com"},
+				Courses: []CatalogCourse{
+					{CourseID: 1, CourseName: "Mathematics 101", CreateTime: timestamp, UpdateTime: timestamp},
+					{CourseID: 2, CourseName: "Introduction to Programming", Archived: true, ArchiveTime: &timestamp, CreateTime: timestamp, UpdateTime: timestamp},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Instructor Not Found",
+			fields: fields{
+				courseService: courseDomainMock.NewMockCourseDomainItf(ctrl),
+				instructorService: func() instructorDomain.InstructorDomainItf {
+					mock := instructorDomainMock.NewMockInstructorDomainItf(ctrl)
+					mock.EXPECT().GetInstructorByID(gomock.Any(), instructorID).Return(nil, nil)
+					return mock
+				}(),
+			},
+			want:    ListInstructorCoursesResp{Status: common.StatusFailure, Message: "instructor data not found"},
+			wantErr: false,
+		},
+		{
+			name: "Failed to Retrieve Courses",
+			fields: fields{
+				courseService: courseDomainMock.NewMockCourseDomainItf(ctrl),
+				instructorService: func() instructorDomain.InstructorDomainItf {
+					mock := instructorDomainMock.NewMockInstructorDomainItf(ctrl)
+					mock.EXPECT().GetInstructorByID(gomock.Any(), instructorID).Return(&instructorDomain.Instructor{ID: instructorID}, nil)
+					mock.EXPECT().GetCourseIDsByInstructorID(gomock.Any(), instructorID).Return(nil, errors.New("db error"))
+					return mock
+				}(),
+			},
+			want:    ListInstructorCoursesResp{Status: common.StatusFailure, Message: "failed to retrieve instructor courses"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalogUC := &CatalogUseCase{
+				courseService:     tt.fields.courseService,
+				instructorService: tt.fields.instructorService,
+			}
+			got, err := catalogUC.ListInstructorCourses(adminCtx, instructorID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CatalogUseCase.ListInstructorCourses() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CatalogUseCase.ListInstructorCourses() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCatalogUseCase_CreateTerm(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	startDate := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC)
+	openTime := time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)
+	closeTime := time.Date(2024, 9, 15, 0, 0, 0, 0, time.UTC)
+	term := termDomain.Term{Name: "2024 Fall", StartDate: startDate, EndDate: endDate, EnrollmentOpenTime: &openTime, EnrollmentCloseTime: &closeTime}
+
+	type fields struct {
+		termService termDomain.TermDomainItf
+	}
+	type args struct {
+		ctx context.Context
+		req CreateTermRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    TermResp
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				termService: func() termDomain.TermDomainItf {
+					created := term
+					created.ID = 2
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().CreateTerm(gomock.Any(), term).Return(created, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: CreateTermRequest{Name: "2024 Fall", StartDate: startDate, EndDate: endDate, EnrollmentOpenTime: &openTime, EnrollmentCloseTime: &closeTime},
+			},
+			want: TermResp{
+				Status:   common.StatusSuccess,
+				TermData: &TermDetail{TermID: 2, Name: "2024 Fall", StartDate: startDate, EndDate: endDate, EnrollmentOpenTime: &openTime, EnrollmentCloseTime: &closeTime},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Invalid Term Dates",
+			fields: fields{
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().CreateTerm(gomock.Any(), termDomain.Term{Name: "2024 Fall", StartDate: endDate, EndDate: startDate}).Return(termDomain.Term{}, termDomain.ErrInvalidTermDates)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: CreateTermRequest{Name: "2024 Fall", StartDate: endDate, EndDate: startDate},
+			},
+			want:    TermResp{Status: common.StatusFailure, Message: "term must end after it starts"},
+			wantErr: true,
+		},
+		{
+			name: "Term Already Exists",
+			fields: fields{
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().CreateTerm(gomock.Any(), term).Return(termDomain.Term{}, termDomain.ErrTermAlreadyExists)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: CreateTermRequest{Name: "2024 Fall", StartDate: startDate, EndDate: endDate, EnrollmentOpenTime: &openTime, EnrollmentCloseTime: &closeTime},
+			},
+			want:    TermResp{Status: common.StatusFailure, Message: "term already exists"},
+			wantErr: true,
+		},
+		{
+			name: "Not A Course Manager",
+			fields: fields{
+				termService: termDomainMock.NewMockTermDomainItf(ctrl),
+			},
+			args: args{
+				ctx: auth.WithPrincipal(context.Background(), auth.Principal{Subject: "student:1", StudentID: 1, Roles: []string{auth.RoleStudent}}),
+				req: CreateTermRequest{Name: "2024 Fall", StartDate: startDate, EndDate: endDate},
+			},
+			want:    TermResp{Status: common.StatusFailure, Message: "permission denied"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalogUC := &CatalogUseCase{
+				termService: tt.fields.termService,
+			}
+			got, err := catalogUC.CreateTerm(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CatalogUseCase.CreateTerm() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CatalogUseCase.CreateTerm() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCatalogUseCase_CreateSection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const (
+		courseID int64 = 101
+		termID   int64 = 2
+	)
+	archiveTime := time.Date(2023, 8, 25, 0, 0, 0, 0, time.UTC)
+	capacity, negativeCapacity := 15, -1
+
+	type fields struct {
+		courseService  courseDomain.CourseDomainItf
+		sectionService sectionDomain.SectionDomainItf
+		termService    termDomain.TermDomainItf
+	}
+	type args struct {
+		ctx context.Context
+		req CreateSectionRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    SectionResp
+		wantErr bool
+	}{
+		{
+			name: "Success With Course Capacity",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Capacity: 30}, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2025 Spring"}, nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().CreateSection(gomock.Any(), courseID, termID, 30).Return(sectionDomain.Section{ID: 7, CourseID: courseID, TermID: termID, Capacity: 30}, nil)
+					mock.EXPECT().AddMeetings(gomock.Any(), int64(7), []sectionDomain.Meeting{}).Return([]sectionDomain.Meeting{}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: CreateSectionRequest{CourseID: courseID, TermID: termID},
+			},
+			want: SectionResp{
+				Status:      common.StatusSuccess,
+				SectionData: &SectionDetail{SectionID: 7, CourseID: courseID, TermID: termID, TermName: "2025 Spring", Capacity: 30},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success With Section Capacity",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Capacity: 30}, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2025 Spring"}, nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().CreateSection(gomock.Any(), courseID, termID, capacity).Return(sectionDomain.Section{ID: 7, CourseID: courseID, TermID: termID, Capacity: capacity}, nil)
+					mock.EXPECT().AddMeetings(gomock.Any(), int64(7), []sectionDomain.Meeting{}).Return([]sectionDomain.Meeting{}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: CreateSectionRequest{CourseID: courseID, TermID: termID, Capacity: &capacity},
+			},
+			want: SectionResp{
+				Status:      common.StatusSuccess,
+				SectionData: &SectionDetail{SectionID: 7, CourseID: courseID, TermID: termID, TermName: "2025 Spring", Capacity: capacity},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success With Meetings",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Capacity: 30}, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2025 Spring"}, nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().CreateSection(gomock.Any(), courseID, termID, 30).Return(sectionDomain.Section{ID: 7, CourseID: courseID, TermID: termID, Capacity: 30}, nil)
+					mock.EXPECT().AddMeetings(gomock.Any(), int64(7), []sectionDomain.Meeting{
+						{DayOfWeek: sectionDomain.Monday, StartTime: 9 * 60, EndTime: 10*60 + 30, Room: "B-101"},
+					}).Return([]sectionDomain.Meeting{
+						{ID: 1, SectionID: 7, DayOfWeek: sectionDomain.Monday, StartTime: 9 * 60, EndTime: 10*60 + 30, Room: "B-101"},
+					}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: CreateSectionRequest{CourseID: courseID, TermID: termID, Meetings: []MeetingDetail{
+					{DayOfWeek: sectionDomain.Monday, StartTime: 9 * 60, EndTime: 10*60 + 30, Room: "B-101"},
+				}},
+			},
+			want: SectionResp{
+				Status: common.StatusSuccess,
+				SectionData: &SectionDetail{SectionID: 7, CourseID: courseID, TermID: termID, TermName: "2025 Spring", Capacity: 30, Meetings: []MeetingDetail{
+					{DayOfWeek: sectionDomain.Monday, StartTime: 9 * 60, EndTime: 10*60 + 30, Room: "B-101"},
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Overlapping Meetings",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Capacity: 30}, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2025 Spring"}, nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().CreateSection(gomock.Any(), courseID, termID, 30).Return(sectionDomain.Section{ID: 7, CourseID: courseID, TermID: termID, Capacity: 30}, nil)
+					mock.EXPECT().AddMeetings(gomock.Any(), int64(7), gomock.Any()).Return(nil, sectionDomain.ErrOverlappingMeetings)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: CreateSectionRequest{CourseID: courseID, TermID: termID, Meetings: []MeetingDetail{
+					{DayOfWeek: sectionDomain.Monday, StartTime: 9 * 60, EndTime: 11 * 60},
+					{DayOfWeek: sectionDomain.Monday, StartTime: 10 * 60, EndTime: 12 * 60},
+				}},
+			},
+			want:    SectionResp{Status: common.StatusFailure, Message: "section meetings overlap"},
+			wantErr: true,
+		},
+		{
+			name: "Course Archived",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, ArchiveTime: &archiveTime}, nil)
+					return mock
+				}(),
+				termService:    termDomainMock.NewMockTermDomainItf(ctrl),
+				sectionService: sectionDomainMock.NewMockSectionDomainItf(ctrl),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: CreateSectionRequest{CourseID: courseID, TermID: termID},
+			},
+			want:    SectionResp{Status: common.StatusFailure, Message: "course is archived"},
+			wantErr: false,
+		},
+		{
+			name: "Term Not Found",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Capacity: 30}, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermByID(gomock.Any(), termID).Return(nil, nil)
+					return mock
+				}(),
+				sectionService: sectionDomainMock.NewMockSectionDomainItf(ctrl),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: CreateSectionRequest{CourseID: courseID, TermID: termID},
+			},
+			want:    SectionResp{Status: common.StatusFailure, Message: "term data not found"},
+			wantErr: false,
+		},
+		{
+			name: "Invalid Section Capacity",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Capacity: 30}, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2025 Spring"}, nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().CreateSection(gomock.Any(), courseID, termID, negativeCapacity).Return(sectionDomain.Section{}, sectionDomain.ErrInvalidSectionCapacity)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: CreateSectionRequest{CourseID: courseID, TermID: termID, Capacity: &negativeCapacity},
+			},
+			want:    SectionResp{Status: common.StatusFailure, Message: "invalid section capacity"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalogUC := &CatalogUseCase{
+				courseService:  tt.fields.courseService,
+				sectionService: tt.fields.sectionService,
+				termService:    tt.fields.termService,
+				unitOfWork:     testutil.NewPassThroughUnitOfWork(ctrl),
+			}
+			got, err := catalogUC.CreateSection(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CatalogUseCase.CreateSection() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CatalogUseCase.CreateSection() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCatalogUseCase_ListCourseSections(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const courseID int64 = 101
+
+	courseService := courseDomainMock.NewMockCourseDomainItf(ctrl)
+	courseService.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID}, nil)
+	sectionService := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+	sectionService.EXPECT().GetSectionsByCourseID(gomock.Any(), courseID).Return([]sectionDomain.Section{
+		{ID: 1, CourseID: courseID, TermID: 1, Capacity: 30},
+		{ID: 7, CourseID: courseID, TermID: 2, Capacity: 15},
+	}, nil)
+	sectionService.EXPECT().GetMeetingsBySectionIDs(gomock.Any(), []int64{1, 7}).Return(map[int64][]sectionDomain.Meeting{
+		7: {{ID: 3, SectionID: 7, DayOfWeek: sectionDomain.Tuesday, StartTime: 13 * 60, EndTime: 14 * 60, Room: "A-2"}},
+	}, nil)
+	termService := termDomainMock.NewMockTermDomainItf(ctrl)
+	termService.EXPECT().GetTermsByIDs(gomock.Any(), []int64{1, 2}).Return(map[int64]termDomain.Term{
+		1: {ID: 1, Name: "2024 Fall"},
+		2: {ID: 2, Name: "2025 Spring"},
+	}, nil)
+
+	catalogUC := &CatalogUseCase{
+		courseService:  courseService,
+		sectionService: sectionService,
+		termService:    termService,
+	}
+	got, err := catalogUC.ListCourseSections(adminCtx, courseID)
+	if err != nil {
+		t.Fatalf("CatalogUseCase.ListCourseSections() error = %v", err)
+	}
+	want := ListCourseSectionsResp{
+		Status:   common.StatusSuccess,
+		CourseID: courseID,
+		Sections: []SectionDetail{
+			{SectionID: 1, CourseID: courseID, TermID: 1, TermName: "2024 Fall", Capacity: 30},
+			{SectionID: 7, CourseID: courseID, TermID: 2, TermName: "2025 Spring", Capacity: 15, Meetings: []MeetingDetail{
+				{DayOfWeek: sectionDomain.Tuesday, StartTime: 13 * 60, EndTime: 14 * 60, Room: "A-2"},
+			}},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CatalogUseCase.ListCourseSections() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,7 @@
+package catalogusecase
+
+// Pagination defaults for listing the catalog.
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+)
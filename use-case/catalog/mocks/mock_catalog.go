@@ -0,0 +1,216 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: use-case/catalog/catalog.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	catalogusecase "github/rakadityas/course-management-system/use-case/catalog"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCatalogUseCaseItf is a mock of CatalogUseCaseItf interface.
+type MockCatalogUseCaseItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockCatalogUseCaseItfMockRecorder
+}
+
+// MockCatalogUseCaseItfMockRecorder is the mock recorder for MockCatalogUseCaseItf.
+type MockCatalogUseCaseItfMockRecorder struct {
+	mock *MockCatalogUseCaseItf
+}
+
+// NewMockCatalogUseCaseItf creates a new mock instance.
+func NewMockCatalogUseCaseItf(ctrl *gomock.Controller) *MockCatalogUseCaseItf {
+	mock := &MockCatalogUseCaseItf{ctrl: ctrl}
+	mock.recorder = &MockCatalogUseCaseItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCatalogUseCaseItf) EXPECT() *MockCatalogUseCaseItfMockRecorder {
+	return m.recorder
+}
+
+// ArchiveCourse mocks base method.
+func (m *MockCatalogUseCaseItf) ArchiveCourse(ctx context.Context, courseID int64) (catalogusecase.ArchiveCourseResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveCourse", ctx, courseID)
+	ret0, _ := ret[0].(catalogusecase.ArchiveCourseResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveCourse indicates an expected call of ArchiveCourse.
+func (mr *MockCatalogUseCaseItfMockRecorder) ArchiveCourse(ctx, courseID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveCourse", reflect.TypeOf((*MockCatalogUseCaseItf)(nil).ArchiveCourse), ctx, courseID)
+}
+
+// AssignInstructor mocks base method.
+func (m *MockCatalogUseCaseItf) AssignInstructor(ctx context.Context, req catalogusecase.CourseInstructorRequest) (catalogusecase.CourseInstructorsResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignInstructor", ctx, req)
+	ret0, _ := ret[0].(catalogusecase.CourseInstructorsResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignInstructor indicates an expected call of AssignInstructor.
+func (mr *MockCatalogUseCaseItfMockRecorder) AssignInstructor(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignInstructor", reflect.TypeOf((*MockCatalogUseCaseItf)(nil).AssignInstructor), ctx, req)
+}
+
+// CreateCourse mocks base method.
+func (m *MockCatalogUseCaseItf) CreateCourse(ctx context.Context, req catalogusecase.CreateCourseRequest) (catalogusecase.CourseResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCourse", ctx, req)
+	ret0, _ := ret[0].(catalogusecase.CourseResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCourse indicates an expected call of CreateCourse.
+func (mr *MockCatalogUseCaseItfMockRecorder) CreateCourse(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCourse", reflect.TypeOf((*MockCatalogUseCaseItf)(nil).CreateCourse), ctx, req)
+}
+
+// CreateSection mocks base method.
+func (m *MockCatalogUseCaseItf) CreateSection(ctx context.Context, req catalogusecase.CreateSectionRequest) (catalogusecase.SectionResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSection", ctx, req)
+	ret0, _ := ret[0].(catalogusecase.SectionResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSection indicates an expected call of CreateSection.
+func (mr *MockCatalogUseCaseItfMockRecorder) CreateSection(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSection", reflect.TypeOf((*MockCatalogUseCaseItf)(nil).CreateSection), ctx, req)
+}
+
+// CreateTerm mocks base method.
+func (m *MockCatalogUseCaseItf) CreateTerm(ctx context.Context, req catalogusecase.CreateTermRequest) (catalogusecase.TermResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTerm", ctx, req)
+	ret0, _ := ret[0].(catalogusecase.TermResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTerm indicates an expected call of CreateTerm.
+func (mr *MockCatalogUseCaseItfMockRecorder) CreateTerm(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTerm", reflect.TypeOf((*MockCatalogUseCaseItf)(nil).CreateTerm), ctx, req)
+}
+
+// ListCatalog mocks base method.
+func (m *MockCatalogUseCaseItf) ListCatalog(ctx context.Context, req catalogusecase.ListCatalogRequest) (catalogusecase.ListCatalogResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCatalog", ctx, req)
+	ret0, _ := ret[0].(catalogusecase.ListCatalogResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCatalog indicates an expected call of ListCatalog.
+func (mr *MockCatalogUseCaseItfMockRecorder) ListCatalog(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCatalog", reflect.TypeOf((*MockCatalogUseCaseItf)(nil).ListCatalog), ctx, req)
+}
+
+// ListCourseSections mocks base method.
+func (m *MockCatalogUseCaseItf) ListCourseSections(ctx context.Context, courseID int64) (catalogusecase.ListCourseSectionsResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCourseSections", ctx, courseID)
+	ret0, _ := ret[0].(catalogusecase.ListCourseSectionsResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCourseSections indicates an expected call of ListCourseSections.
+func (mr *MockCatalogUseCaseItfMockRecorder) ListCourseSections(ctx, courseID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCourseSections", reflect.TypeOf((*MockCatalogUseCaseItf)(nil).ListCourseSections), ctx, courseID)
+}
+
+// ListInstructorCourses mocks base method.
+func (m *MockCatalogUseCaseItf) ListInstructorCourses(ctx context.Context, instructorID int64) (catalogusecase.ListInstructorCoursesResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInstructorCourses", ctx, instructorID)
+	ret0, _ := ret[0].(catalogusecase.ListInstructorCoursesResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInstructorCourses indicates an expected call of ListInstructorCourses.
+func (mr *MockCatalogUseCaseItfMockRecorder) ListInstructorCourses(ctx, instructorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstructorCourses", reflect.TypeOf((*MockCatalogUseCaseItf)(nil).ListInstructorCourses), ctx, instructorID)
+}
+
+// ListTerms mocks base method.
+func (m *MockCatalogUseCaseItf) ListTerms(ctx context.Context) (catalogusecase.ListTermsResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTerms", ctx)
+	ret0, _ := ret[0].(catalogusecase.ListTermsResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTerms indicates an expected call of ListTerms.
+func (mr *MockCatalogUseCaseItfMockRecorder) ListTerms(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTerms", reflect.TypeOf((*MockCatalogUseCaseItf)(nil).ListTerms), ctx)
+}
+
+// RenameCourse mocks base method.
+func (m *MockCatalogUseCaseItf) RenameCourse(ctx context.Context, req catalogusecase.RenameCourseRequest) (catalogusecase.CourseResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameCourse", ctx, req)
+	ret0, _ := ret[0].(catalogusecase.CourseResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameCourse indicates an expected call of RenameCourse.
+func (mr *MockCatalogUseCaseItfMockRecorder) RenameCourse(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameCourse", reflect.TypeOf((*MockCatalogUseCaseItf)(nil).RenameCourse), ctx, req)
+}
+
+// SetPrerequisites mocks base method.
+func (m *MockCatalogUseCaseItf) SetPrerequisites(ctx context.Context, req catalogusecase.SetPrerequisitesRequest) (catalogusecase.CourseResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPrerequisites", ctx, req)
+	ret0, _ := ret[0].(catalogusecase.CourseResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPrerequisites indicates an expected call of SetPrerequisites.
+func (mr *MockCatalogUseCaseItfMockRecorder) SetPrerequisites(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrerequisites", reflect.TypeOf((*MockCatalogUseCaseItf)(nil).SetPrerequisites), ctx, req)
+}
+
+// UnassignInstructor mocks base method.
+func (m *MockCatalogUseCaseItf) UnassignInstructor(ctx context.Context, req catalogusecase.CourseInstructorRequest) (catalogusecase.CourseInstructorsResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignInstructor", ctx, req)
+	ret0, _ := ret[0].(catalogusecase.CourseInstructorsResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnassignInstructor indicates an expected call of UnassignInstructor.
+func (mr *MockCatalogUseCaseItfMockRecorder) UnassignInstructor(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignInstructor", reflect.TypeOf((*MockCatalogUseCaseItf)(nil).UnassignInstructor), ctx, req)
+}
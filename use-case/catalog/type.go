@@ -0,0 +1,194 @@
+package catalogusecase
+
+import (
+	"time"
+
+	sectionDomain "github/rakadityas/course-management-system/domain/section"
+)
+
+// Course related
+type (
+	// CreateCourseRequest represents the request payload for adding a course to the catalog.
+	// Without credit hours the course is worth courseDomain.DefaultCreditHours.
+	CreateCourseRequest struct {
+		Name        string `json:"name"`
+		Capacity    int    `json:"capacity"`
+		CreditHours *int   `json:"credit_hours,omitempty"`
+	}
+
+	// RenameCourseRequest represents the request payload for renaming a course.
+	RenameCourseRequest struct {
+		CourseID int64  `json:"-"`
+		Name     string `json:"name"`
+	}
+
+	// SetPrerequisitesRequest represents the request payload for replacing the prerequisites of a course.
+	SetPrerequisitesRequest struct {
+		CourseID              int64   `json:"-"`
+		PrerequisiteCourseIDs []int64 `json:"prerequisite_course_ids"`
+	}
+
+	// CourseResp represents the response structure for a single catalog operation.
+	CourseResp struct {
+		Status     string         `json:"status"`
+		Message    string         `json:"message,omitempty"`
+		CourseData *CatalogCourse `json:"course_data,omitempty"`
+	}
+
+	// CatalogCourse provides the catalog information about a course.
+	CatalogCourse struct {
+		CourseID    int64      `json:"course_id"`
+		CourseName  string     `json:"course_name"`
+		Capacity    int        `json:"capacity"`
+		CreditHours int        `json:"credit_hours"`
+		Archived    bool       `json:"archived"`
+		ArchiveTime *time.Time `json:"archive_time,omitempty"`
+		CreateTime  time.Time  `json:"create_time"`
+		UpdateTime  time.Time  `json:"update_time"`
+
+		PrerequisiteCourseIDs []int64 `json:"prerequisite_course_ids,omitempty"`
+	}
+
+	// ArchiveCourseResp represents the response structure for archiving a course.
+	ArchiveCourseResp struct {
+		Status  string `json:"status"`
+		Message string `json:"message,omitempty"`
+	}
+)
+
+// ListCatalog related
+type (
+	// ListCatalogRequest represents the search and pagination parameters for listing the catalog.
+	ListCatalogRequest struct {
+		Name            string
+		IncludeArchived bool
+		Limit           int
+		Offset          int
+	}
+
+	// ListCatalogResp represents the response structure for listing the catalog.
+	ListCatalogResp struct {
+		Status  string          `json:"status"`
+		Message string          `json:"message,omitempty"`
+		Courses []CatalogCourse `json:"courses,omitempty"`
+		Limit   int             `json:"limit"`
+		Offset  int             `json:"offset"`
+	}
+)
+
+// Instructor related
+type (
+	// CourseInstructorRequest identifies an instructor and the course they are assigned to or removed from.
+	CourseInstructorRequest struct {
+		CourseID     int64
+		InstructorID int64
+	}
+
+	// CourseInstructorsResp represents the instructors of a course after an assignment change.
+	CourseInstructorsResp struct {
+		Status      string             `json:"status"`
+		Message     string             `json:"message,omitempty"`
+		CourseID    int64              `json:"course_id,omitempty"`
+		Instructors []InstructorDetail `json:"instructors,omitempty"`
+	}
+
+	// InstructorDetail provides information about an instructor.
+	InstructorDetail struct {
+		InstructorID int64  `json:"instructor_id"`
+		Name         string `json:"name"`
+		Email        string `json:"email"`
+	}
+
+	// ListInstructorCoursesResp represents the response structure for listing the courses an instructor teaches.
+	ListInstructorCoursesResp struct {
+		Status         string            `json:"status"`
+		Message        string            `json:"message,omitempty"`
+		InstructorData *InstructorDetail `json:"instructor_data,omitempty"`
+		Courses        []CatalogCourse   `json:"courses,omitempty"`
+	}
+)
+
+// Term related
+type (
+	// CreateTermRequest represents the request payload for adding an academic term.
+	// The enrollment window is optional, a missing bound leaves that side open.
+	// Without a drop deadline, active enrollments may be cancelled at any time.
+	CreateTermRequest struct {
+		Name                string     `json:"name"`
+		StartDate           time.Time  `json:"start_date"`
+		EndDate             time.Time  `json:"end_date"`
+		EnrollmentOpenTime  *time.Time `json:"enrollment_open_time,omitempty"`
+		EnrollmentCloseTime *time.Time `json:"enrollment_close_time,omitempty"`
+		DropDeadline        *time.Time `json:"drop_deadline,omitempty"`
+	}
+
+	// TermResp represents the response structure for a single term operation.
+	TermResp struct {
+		Status   string      `json:"status"`
+		Message  string      `json:"message,omitempty"`
+		TermData *TermDetail `json:"term_data,omitempty"`
+	}
+
+	// ListTermsResp represents the response structure for listing the terms.
+	ListTermsResp struct {
+		Status  string       `json:"status"`
+		Message string       `json:"message,omitempty"`
+		Terms   []TermDetail `json:"terms,omitempty"`
+	}
+
+	// TermDetail provides information about an academic term.
+	TermDetail struct {
+		TermID              int64      `json:"term_id"`
+		Name                string     `json:"name"`
+		StartDate           time.Time  `json:"start_date"`
+		EndDate             time.Time  `json:"end_date"`
+		EnrollmentOpenTime  *time.Time `json:"enrollment_open_time,omitempty"`
+		EnrollmentCloseTime *time.Time `json:"enrollment_close_time,omitempty"`
+		DropDeadline        *time.Time `json:"drop_deadline,omitempty"`
+	}
+)
+
+// Section related
+type (
+	// CreateSectionRequest represents the request payload for offering a course in a term.
+	// A nil capacity takes the capacity of the course.
+	CreateSectionRequest struct {
+		CourseID int64           `json:"-"`
+		TermID   int64           `json:"term_id"`
+		Capacity *int            `json:"capacity,omitempty"`
+		Meetings []MeetingDetail `json:"meetings,omitempty"`
+	}
+
+	// SectionResp represents the response structure for a single section operation.
+	SectionResp struct {
+		Status      string         `json:"status"`
+		Message     string         `json:"message,omitempty"`
+		SectionData *SectionDetail `json:"section_data,omitempty"`
+	}
+
+	// ListCourseSectionsResp represents the response structure for listing the sections of a course.
+	ListCourseSectionsResp struct {
+		Status   string          `json:"status"`
+		Message  string          `json:"message,omitempty"`
+		CourseID int64           `json:"course_id,omitempty"`
+		Sections []SectionDetail `json:"sections,omitempty"`
+	}
+
+	// SectionDetail provides information about a course section.
+	SectionDetail struct {
+		SectionID int64           `json:"section_id"`
+		CourseID  int64           `json:"course_id"`
+		TermID    int64           `json:"term_id"`
+		TermName  string          `json:"term_name"`
+		Capacity  int             `json:"capacity"`
+		Meetings  []MeetingDetail `json:"meetings,omitempty"`
+	}
+
+	// MeetingDetail is a weekly meeting of a section, times are written as "15:04".
+	MeetingDetail struct {
+		DayOfWeek sectionDomain.DayOfWeek `json:"day_of_week"`
+		StartTime sectionDomain.ClockTime `json:"start_time"`
+		EndTime   sectionDomain.ClockTime `json:"end_time"`
+		Room      string                  `json:"room,omitempty"`
+	}
+)
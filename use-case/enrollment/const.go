@@ -0,0 +1,25 @@
+package enrollmentusecase
+
+import courseEnrollmentDomain "github/rakadityas/course-management-system/domain/course-enrollment"
+
+// Pagination defaults for listing enrollments.
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+)
+
+// Batch modes decide what happens to the other items of a batch when one of them fails.
+const (
+	BatchModeAllOrNothing BatchMode = "all_or_nothing" // roll back the whole batch
+	BatchModeBestEffort   BatchMode = "best_effort"    // keep the items that succeeded
+)
+
+// MaxBatchItems caps the number of items of a batch sign-up or cancel request.
+const MaxBatchItems = 100
+
+// RosterStatuses are the enrollment statuses listed on a course roster.
+var RosterStatuses = []courseEnrollmentDomain.EnrollmentStatus{
+	courseEnrollmentDomain.StatusActive,
+	courseEnrollmentDomain.StatusWaitlisted,
+	courseEnrollmentDomain.StatusCancelled,
+}
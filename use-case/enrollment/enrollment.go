@@ -2,37 +2,73 @@ package enrollmentusecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	common "github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/auth"
+	"github/rakadityas/course-management-system/common/logger"
+	"github/rakadityas/course-management-system/common/transaction"
 	courseDomain "github/rakadityas/course-management-system/domain/course"
 	courseEnrollmentDomain "github/rakadityas/course-management-system/domain/course-enrollment"
+	instructorDomain "github/rakadityas/course-management-system/domain/instructor"
+	sectionDomain "github/rakadityas/course-management-system/domain/section"
 	studentDomain "github/rakadityas/course-management-system/domain/student"
+	termDomain "github/rakadityas/course-management-system/domain/term"
+	"sort"
 	"strconv"
+	"time"
 )
 
 // EnrollmentUseCaseInterface defines the interface for the EnrollmentUseCase.
 type EnrollmentUseCaseItf interface {
 	CourseSignUp(ctx context.Context, req CourseSignUpRequest) (CourseSignUpResp, error)
-	ListCourses(ctx context.Context, studentID int64) (ListCoursesResp, error)
-	CancelCourse(ctx context.Context, studentID, courseID int64) (CancelCourseResp, error)
-	ListClassmates(ctx context.Context, studentID int64) (ListClassmatesResp, error)
+	ListCourses(ctx context.Context, req ListCoursesRequest) (ListCoursesResp, error)
+	CancelCourse(ctx context.Context, studentID, sectionID int64) (CancelCourseResp, error)
+	BatchCourseSignUp(ctx context.Context, req BatchCourseSignUpRequest) (BatchCourseSignUpResp, error)
+	BatchCancelCourse(ctx context.Context, req BatchCancelCourseRequest) (BatchCancelCourseResp, error)
+	CancelStudentEnrollments(ctx context.Context, studentID int64) error
+	ListClassmates(ctx context.Context, req ListClassmatesRequest) (ListClassmatesResp, error)
+	GetCourseRoster(ctx context.Context, courseID int64) (CourseRosterResp, error)
+	GetSchedule(ctx context.Context, req ScheduleRequest) (ScheduleResp, error)
+	RecordGrade(ctx context.Context, req RecordGradeRequest) (RecordGradeResp, error)
+	GetTranscript(ctx context.Context, studentID int64) (TranscriptResp, error)
 }
 
 type EnrollmentUseCase struct {
 	studentService          studentDomain.StudentDomainItf
 	courseService           courseDomain.CourseDomainItf
+	sectionService          sectionDomain.SectionDomainItf
+	termService             termDomain.TermDomainItf
 	courseEnrollmentService courseEnrollmentDomain.CourseEnrollmentDomainItf
+	instructorService       instructorDomain.InstructorDomainItf
+	unitOfWork              transaction.UnitOfWork
+	features                Features
+	creditLoadPolicy        courseEnrollmentDomain.CreditLoadPolicy
+	now                     func() time.Time
 }
 
-func NewEnrollmentUseCase(studentService studentDomain.StudentDomainItf, courseService courseDomain.CourseDomainItf, courseEnrollmentService courseEnrollmentDomain.CourseEnrollmentDomainItf) EnrollmentUseCaseItf {
+func NewEnrollmentUseCase(studentService studentDomain.StudentDomainItf, courseService courseDomain.CourseDomainItf, sectionService sectionDomain.SectionDomainItf, termService termDomain.TermDomainItf, courseEnrollmentService courseEnrollmentDomain.CourseEnrollmentDomainItf, instructorService instructorDomain.InstructorDomainItf, unitOfWork transaction.UnitOfWork, features Features, creditLoadPolicy courseEnrollmentDomain.CreditLoadPolicy) EnrollmentUseCaseItf {
 	return &EnrollmentUseCase{
 		studentService:          studentService,
 		courseService:           courseService,
+		sectionService:          sectionService,
+		termService:             termService,
 		courseEnrollmentService: courseEnrollmentService,
+		instructorService:       instructorService,
+		unitOfWork:              unitOfWork,
+		features:                features,
+		creditLoadPolicy:        creditLoadPolicy,
+		now:                     time.Now,
 	}
 }
 
-// CourseSignUp handles the course sign-up process.
+// CourseSignUp handles signing a student up for a section of a course.
 func (enrollmentUC *EnrollmentUseCase) CourseSignUp(ctx context.Context, req CourseSignUpRequest) (CourseSignUpResp, error) {
+	// Ensure the caller may act for the student
+	if err := auth.AuthorizeStudent(ctx, req.StudentID); err != nil {
+		return CourseSignUpResp{Status: common.StatusFailure, Message: enrollmentErrorMessage(err, "failed to sign up course")}, err
+	}
+
 	// Ensure the student data exists
 	studentData, err := enrollmentUC.studentService.GetStudentByID(ctx, req.StudentID)
 	if err != nil {
@@ -42,50 +78,293 @@ func (enrollmentUC *EnrollmentUseCase) CourseSignUp(ctx context.Context, req Cou
 		return CourseSignUpResp{Status: common.StatusFailure, Message: "student data not found"}, nil
 	}
 
-	// Ensure the course data exists
-	courseData, err := enrollmentUC.courseService.GetCourseByID(ctx, req.CourseID)
+	// Ensure the section and its course exist
+	sectionData, err := enrollmentUC.sectionService.GetSectionByID(ctx, req.SectionID)
+	if err != nil {
+		return CourseSignUpResp{Status: common.StatusFailure, Message: "failed to retrieve section data"}, err
+	}
+	if sectionData == nil {
+		return CourseSignUpResp{Status: common.StatusFailure, Message: "section data not found"}, nil
+	}
+	courseData, err := enrollmentUC.courseService.GetCourseByID(ctx, sectionData.CourseID)
 	if err != nil {
 		return CourseSignUpResp{Status: common.StatusFailure, Message: "failed to retrieve course data"}, err
 	}
 	if courseData == nil {
 		return CourseSignUpResp{Status: common.StatusFailure, Message: "course data not found"}, nil
 	}
+	if courseData.IsArchived() {
+		return CourseSignUpResp{Status: common.StatusFailure, Message: "course is archived"}, nil
+	}
 
-	// Ensure the student never made any enrollment at all
-	courseEnrollments, err := enrollmentUC.courseEnrollmentService.GetEnrollmentByStudentIDAndCourseID(ctx, req.StudentID, req.CourseID)
+	// Ensure the enrollment window of the section's term is open
+	termData, err := enrollmentUC.termService.GetTermByID(ctx, sectionData.TermID)
 	if err != nil {
-		return CourseSignUpResp{Status: common.StatusFailure, Message: "failed to retrieve course data"}, err
+		return CourseSignUpResp{Status: common.StatusFailure, Message: "failed to retrieve term data"}, err
+	}
+	if termData == nil {
+		return CourseSignUpResp{Status: common.StatusFailure, Message: "term data not found"}, nil
 	}
-	if len(courseEnrollments) > 0 {
-		return CourseSignUpResp{Status: common.StatusFailure, Message: "student has enrolled before"}, err
+	if err := termData.CheckEnrollmentWindow(enrollmentUC.now()); err != nil {
+		return CourseSignUpResp{Status: common.StatusFailure, Message: enrollmentErrorMessage(err, "failed to sign up course")}, err
 	}
 
-	// Create new enrollment
-	newEnrollment, err := enrollmentUC.courseEnrollmentService.CreateEnrollment(ctx, req.StudentID, req.CourseID, courseEnrollmentDomain.StatusActive)
-	if err != nil {
+	// Ensure every prerequisite of the course has been completed
+	if enrollmentUC.features.Prerequisites {
+		missingPrerequisiteIDs, err := enrollmentUC.missingPrerequisites(ctx, req.StudentID, courseData.ID)
+		if err != nil {
+			return CourseSignUpResp{Status: common.StatusFailure, Message: "failed to retrieve course prerequisites"}, err
+		}
+		if len(missingPrerequisiteIDs) > 0 {
+			return CourseSignUpResp{
+				Status:                       common.StatusFailure,
+				Message:                      "course prerequisites are not completed",
+				MissingPrerequisiteCourseIDs: missingPrerequisiteIDs,
+			}, nil
+		}
+	}
+
+	// Run the schedule, credit load and enrollment checks, the seat count and the write as one unit of work
+	var resp CourseSignUpResp
+	err = enrollmentUC.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = enrollmentUC.enroll(ctx, *studentData, *courseData, *sectionData, *termData)
+		return err
+	})
+
+	return resp, err
+}
+
+// enroll signs the student up for the section of the course, it must run inside a unit of work.
+func (enrollmentUC *EnrollmentUseCase) enroll(ctx context.Context, studentData studentDomain.Student, courseData courseDomain.Course, sectionData sectionDomain.Section, termData termDomain.Term) (CourseSignUpResp, error) {
+	// Lock the student first so concurrent sign-ups of the student, even for different sections, see each other's enrollments
+	if err := enrollmentUC.studentService.LockStudentByID(ctx, studentData.ID); err != nil {
+		if errors.Is(err, studentDomain.ErrNoRowsAffected) {
+			return CourseSignUpResp{Status: common.StatusFailure, Message: "student data not found"}, nil
+		}
+		return CourseSignUpResp{Status: common.StatusFailure, Message: "failed to sign up course"}, err
+	}
+
+	// Lock the section so concurrent sign-ups see each other's seats
+	if err := enrollmentUC.sectionService.LockSectionByID(ctx, sectionData.ID); err != nil {
 		return CourseSignUpResp{Status: common.StatusFailure, Message: "failed to sign up course"}, err
 	}
 
+	// Ensure the section does not meet at the same time as another active enrollment of the term
+	conflict, err := enrollmentUC.scheduleConflict(ctx, studentData.ID, sectionData)
+	if err != nil {
+		return CourseSignUpResp{Status: common.StatusFailure, Message: "failed to retrieve student schedule"}, err
+	}
+	if conflict != nil {
+		return CourseSignUpResp{
+			Status:           common.StatusFailure,
+			Message:          "schedule conflicts with an enrolled course",
+			ScheduleConflict: conflict,
+		}, nil
+	}
+
+	// Ensure the course keeps the student within the credit limit of the term
+	credits, exceeded, err := enrollmentUC.exceedsCreditLimit(ctx, studentData.ID, termData.ID, courseData)
+	if err != nil {
+		return CourseSignUpResp{Status: common.StatusFailure, Message: "failed to retrieve student credit load"}, err
+	}
+	if exceeded {
+		creditLoad := enrollmentUC.termCreditLoad(termData, credits)
+		return CourseSignUpResp{
+			Status:     common.StatusFailure,
+			Message:    "course exceeds the credit limit for the term",
+			CreditLoad: &creditLoad,
+		}, nil
+	}
+
+	// A student holds a single enrollment per section and takes one section of a course at a time;
+	// only a cancelled enrollment in the same section may be reactivated
+	courseEnrollments, err := enrollmentUC.courseEnrollmentService.GetEnrollmentByStudentIDAndCourseID(ctx, studentData.ID, courseData.ID)
+	if err != nil {
+		return CourseSignUpResp{Status: common.StatusFailure, Message: "failed to retrieve course data"}, err
+	}
+	var cancelledEnrollment *courseEnrollmentDomain.CourseEnrollment
+	for i, enrollment := range courseEnrollments {
+		if enrollment.SectionID == sectionData.ID {
+			if enrollment.Status != courseEnrollmentDomain.StatusCancelled {
+				return CourseSignUpResp{Status: common.StatusFailure, Message: "student has enrolled before"}, nil
+			}
+			cancelledEnrollment = &courseEnrollments[i]
+			continue
+		}
+
+		switch enrollment.Status {
+		case courseEnrollmentDomain.StatusCancelled, courseEnrollmentDomain.StatusDropped, courseEnrollmentDomain.StatusFailed:
+			// the course may be taken again in another section
+		default:
+			return CourseSignUpResp{Status: common.StatusFailure, Message: "student has enrolled before"}, nil
+		}
+	}
+
+	// Place the student on the waitlist when every seat is taken
+	status, waitlistPosition := courseEnrollmentDomain.StatusActive, 0
+	if sectionData.Capacity > 0 {
+		activeCount, err := enrollmentUC.courseEnrollmentService.CountEnrollmentBySectionIDAndStatus(ctx, sectionData.ID, courseEnrollmentDomain.StatusActive)
+		if err != nil {
+			return CourseSignUpResp{Status: common.StatusFailure, Message: "failed to retrieve course capacity"}, err
+		}
+		if sectionData.IsFull(activeCount) {
+			if !enrollmentUC.features.Waitlist {
+				return CourseSignUpResp{Status: common.StatusFailure, Message: "course is full"}, nil
+			}
+			waitlistCount, err := enrollmentUC.courseEnrollmentService.CountEnrollmentBySectionIDAndStatus(ctx, sectionData.ID, courseEnrollmentDomain.StatusWaitlisted)
+			if err != nil {
+				return CourseSignUpResp{Status: common.StatusFailure, Message: "failed to retrieve course waitlist"}, err
+			}
+			status, waitlistPosition = courseEnrollmentDomain.StatusWaitlisted, waitlistCount+1
+		}
+	}
+
+	var newEnrollment courseEnrollmentDomain.CourseEnrollment
+	if cancelledEnrollment != nil {
+		// Reactivate the cancelled enrollment, the domain enforces the re-enrollment policy
+		newEnrollment, err = enrollmentUC.courseEnrollmentService.ReEnroll(ctx, *cancelledEnrollment, status)
+		if err != nil {
+			return CourseSignUpResp{Status: common.StatusFailure, Message: enrollmentErrorMessage(err, "failed to re-enroll course")}, err
+		}
+	} else {
+		// Create new enrollment
+		newEnrollment, err = enrollmentUC.courseEnrollmentService.CreateEnrollment(ctx, studentData.ID, courseData.ID, sectionData.ID, status)
+		if err != nil {
+			return CourseSignUpResp{Status: common.StatusFailure, Message: enrollmentErrorMessage(err, "failed to sign up course")}, err
+		}
+	}
+
+	var message string
+	if status == courseEnrollmentDomain.StatusWaitlisted {
+		message = "course is full, student has been waitlisted"
+	}
+
 	// Return a successful response
 	return CourseSignUpResp{
-		Status: common.StatusSuccess,
+		Status:  common.StatusSuccess,
+		Message: message,
 		EnrollmentData: &CourseEnrollment{
-			ID:           newEnrollment.ID,
-			StudentID:    newEnrollment.StudentID,
-			StudentEmail: studentData.Email,
-			CourseID:     newEnrollment.CourseID,
-			CourseName:   courseData.Name,
-			Status:       courseEnrollmentDomain.StatusActive,
-			CreateTime:   newEnrollment.CreateTime,
-			UpdateTime:   newEnrollment.UpdateTime,
+			ID:               newEnrollment.ID,
+			StudentID:        newEnrollment.StudentID,
+			StudentEmail:     studentData.Email,
+			CourseID:         newEnrollment.CourseID,
+			CourseName:       courseData.Name,
+			SectionID:        sectionData.ID,
+			TermID:           sectionData.TermID,
+			Status:           status,
+			WaitlistPosition: waitlistPosition,
+			CreateTime:       newEnrollment.CreateTime,
+			UpdateTime:       newEnrollment.UpdateTime,
 		},
 	}, nil
 }
 
-// ListCourses retrieves the list of courses a student is enrolled in.
-func (enrollmentUC *EnrollmentUseCase) ListCourses(ctx context.Context, studentID int64) (ListCoursesResp, error) {
+// missingPrerequisites returns the prerequisites of the course the student has not completed.
+func (enrollmentUC *EnrollmentUseCase) missingPrerequisites(ctx context.Context, studentID, courseID int64) ([]int64, error) {
+	prerequisiteIDs, err := enrollmentUC.courseService.GetPrerequisiteIDs(ctx, courseID)
+	if err != nil || len(prerequisiteIDs) == 0 {
+		return nil, err
+	}
+
+	completedEnrollments, err := enrollmentUC.courseEnrollmentService.GetEnrollmentByStudentIDAndStatus(ctx, studentID, courseEnrollmentDomain.StatusCompleted)
+	if err != nil {
+		return nil, err
+	}
+	completed := make(map[int64]bool, len(completedEnrollments))
+	for _, enrollment := range completedEnrollments {
+		completed[enrollment.CourseID] = true
+	}
+
+	var missingIDs []int64
+	for _, prerequisiteID := range prerequisiteIDs {
+		if !completed[prerequisiteID] {
+			missingIDs = append(missingIDs, prerequisiteID)
+		}
+	}
+
+	return missingIDs, nil
+}
+
+// scheduleConflict returns the first active enrollment of the student in the term of the section
+// that meets at the same time as the section, or nil when there is none.
+// Other sections of the same course are left out, taking the course twice is rejected on its own.
+func (enrollmentUC *EnrollmentUseCase) scheduleConflict(ctx context.Context, studentID int64, sectionData sectionDomain.Section) (*ScheduleConflict, error) {
+	meetingsBySectionID, err := enrollmentUC.sectionService.GetMeetingsBySectionIDs(ctx, []int64{sectionData.ID})
+	if err != nil || len(meetingsBySectionID[sectionData.ID]) == 0 {
+		return nil, err
+	}
+	sectionMeetings := meetingsBySectionID[sectionData.ID]
+
+	enrollments, err := enrollmentUC.courseEnrollmentService.GetEnrollmentByStudentID(ctx, studentID)
+	if err != nil {
+		return nil, err
+	}
+	var enrolledSectionIDs []int64
+	for _, enrollment := range enrollments {
+		if enrollment.Status == courseEnrollmentDomain.StatusActive && enrollment.CourseID != sectionData.CourseID {
+			enrolledSectionIDs = append(enrolledSectionIDs, enrollment.SectionID)
+		}
+	}
+	if len(enrolledSectionIDs) == 0 {
+		return nil, nil
+	}
+
+	// Only sections of the same term can meet at the same time
+	sectionByID, err := enrollmentUC.sectionService.GetSectionsByIDs(ctx, enrolledSectionIDs)
+	if err != nil {
+		return nil, err
+	}
+	var termSectionIDs []int64
+	for _, sectionID := range enrolledSectionIDs {
+		if section, ok := sectionByID[sectionID]; ok && section.TermID == sectionData.TermID {
+			termSectionIDs = append(termSectionIDs, sectionID)
+		}
+	}
+	if len(termSectionIDs) == 0 {
+		return nil, nil
+	}
+
+	meetingsBySectionID, err = enrollmentUC.sectionService.GetMeetingsBySectionIDs(ctx, termSectionIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, sectionID := range termSectionIDs {
+		meeting, ok := sectionDomain.FindOverlap(sectionMeetings, meetingsBySectionID[sectionID])
+		if !ok {
+			continue
+		}
+
+		courseData, err := enrollmentUC.courseService.GetCourseByID(ctx, sectionByID[sectionID].CourseID)
+		if err != nil {
+			return nil, err
+		}
+		if courseData == nil {
+			return nil, fmt.Errorf("course %d of section %d not found", sectionByID[sectionID].CourseID, sectionID)
+		}
+
+		return &ScheduleConflict{
+			CourseID:   courseData.ID,
+			CourseName: courseData.Name,
+			SectionID:  sectionID,
+			DayOfWeek:  meeting.DayOfWeek,
+			StartTime:  meeting.StartTime,
+			EndTime:    meeting.EndTime,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// ListCourses retrieves a page of the courses a student is enrolled in.
+func (enrollmentUC *EnrollmentUseCase) ListCourses(ctx context.Context, req ListCoursesRequest) (ListCoursesResp, error) {
+	// Ensure the caller may act for the student
+	if err := auth.AuthorizeStudent(ctx, req.StudentID); err != nil {
+		return ListCoursesResp{Status: common.StatusFailure, Message: enrollmentErrorMessage(err, "failed to retrieve enrollments")}, err
+	}
+
 	// Ensure the student data exists
-	studentData, err := enrollmentUC.studentService.GetStudentByID(ctx, studentID)
+	studentData, err := enrollmentUC.studentService.GetStudentByID(ctx, req.StudentID)
 	if err != nil {
 		return ListCoursesResp{Status: common.StatusFailure, Message: "failed to retrieve student data"}, err
 	}
@@ -93,43 +372,259 @@ func (enrollmentUC *EnrollmentUseCase) ListCourses(ctx context.Context, studentI
 		return ListCoursesResp{Status: common.StatusFailure, Message: "student data not found"}, nil
 	}
 
-	// Get course enrollments for the student
-	enrollments, err := enrollmentUC.courseEnrollmentService.GetEnrollmentByStudentID(ctx, studentID)
+	query, limit, err := req.ListOptions.toListQuery()
 	if err != nil {
-		return ListCoursesResp{Status: common.StatusFailure, Message: "failed to retrieve enrollments"}, err
+		return ListCoursesResp{Status: common.StatusFailure, Message: enrollmentErrorMessage(err, "failed to retrieve enrollments")}, err
+	}
+
+	// Get a page of course enrollments for the student
+	enrollments, err := enrollmentUC.courseEnrollmentService.ListEnrollmentsByStudentID(ctx, req.StudentID, query)
+	if err != nil {
+		return ListCoursesResp{Status: common.StatusFailure, Message: enrollmentErrorMessage(err, "failed to retrieve enrollments")}, err
+	}
+	enrollments, hasNextPage := trimPage(enrollments, limit)
+
+	// Get the enrolled courses in a single lookup
+	courseIDs := make([]int64, 0, len(enrollments))
+	for _, enrollment := range enrollments {
+		courseIDs = append(courseIDs, enrollment.CourseID)
+	}
+	courseByID, err := enrollmentUC.courseService.GetCoursesByIDs(ctx, courseIDs)
+	if err != nil {
+		return ListCoursesResp{Status: common.StatusFailure, Message: "failed to retrieve course data"}, err
+	}
+	instructorsByCourseID, err := enrollmentUC.instructorService.GetInstructorsByCourseIDs(ctx, courseIDs)
+	if err != nil {
+		return ListCoursesResp{Status: common.StatusFailure, Message: "failed to retrieve course instructors"}, err
+	}
+
+	// Get the sections enrolled in and their terms
+	sectionIDs := make([]int64, 0, len(enrollments))
+	for _, enrollment := range enrollments {
+		sectionIDs = append(sectionIDs, enrollment.SectionID)
+	}
+	sectionByID, err := enrollmentUC.sectionService.GetSectionsByIDs(ctx, sectionIDs)
+	if err != nil {
+		return ListCoursesResp{Status: common.StatusFailure, Message: "failed to retrieve section data"}, err
+	}
+	termIDs := make([]int64, 0, len(enrollments))
+	for _, enrollment := range enrollments {
+		termIDs = append(termIDs, sectionByID[enrollment.SectionID].TermID)
+	}
+	termByID, err := enrollmentUC.termService.GetTermsByIDs(ctx, termIDs)
+	if err != nil {
+		return ListCoursesResp{Status: common.StatusFailure, Message: "failed to retrieve term data"}, err
 	}
 
 	// Prepare the response
 	var courses []CourseDetail
 	for _, enrollment := range enrollments {
-		course, err := enrollmentUC.courseService.GetCourseByID(ctx, enrollment.CourseID)
-		if err != nil {
-			return ListCoursesResp{Status: common.StatusFailure, Message: "failed to retrieve course data"}, err
-		}
-		if course == nil {
+		course, ok := courseByID[enrollment.CourseID]
+		if !ok {
 			return ListCoursesResp{Status: common.StatusFailure, Message: "course data is not found for courseID: " + strconv.FormatInt(enrollment.CourseID, 10)}, nil
 		}
 
+		var instructorNames []string
+		for _, instructor := range instructorsByCourseID[course.ID] {
+			instructorNames = append(instructorNames, instructor.Name)
+		}
+
+		section := sectionByID[enrollment.SectionID]
 		courses = append(courses, CourseDetail{
-			CourseID:   course.ID,
-			CourseName: course.Name,
-			Status:     enrollment.Status,
-			CreateTime: enrollment.CreateTime,
-			UpdateTime: enrollment.UpdateTime,
+			CourseID:    course.ID,
+			CourseName:  course.Name,
+			SectionID:   enrollment.SectionID,
+			TermID:      section.TermID,
+			TermName:    termByID[section.TermID].Name,
+			CreditHours: course.CreditHours,
+			Instructors: instructorNames,
+			Status:      enrollment.Status,
+			CreateTime:  enrollment.CreateTime,
+			UpdateTime:  enrollment.UpdateTime,
 		})
 	}
 
+	var nextCursor string
+	if hasNextPage {
+		last := enrollments[len(enrollments)-1]
+		nextCursor = courseEnrollmentDomain.NewEnrollmentCursor(query.SortBy, courseByID[last.CourseID].Name, last).Encode()
+	}
+
+	// Report the credit load of each term the student is active in
+	var creditLoads []TermCreditLoad
+	if !enrollmentUC.creditLoadPolicy.IsZero() {
+		creditLoads, err = enrollmentUC.listCreditLoads(ctx, req.StudentID, req.ListOptions.TermID)
+		if err != nil {
+			return ListCoursesResp{Status: common.StatusFailure, Message: "failed to retrieve student credit load"}, err
+		}
+	}
+
 	return ListCoursesResp{
-		Status:  common.StatusSuccess,
-		Courses: courses,
+		Status:      common.StatusSuccess,
+		Courses:     courses,
+		CreditLoads: creditLoads,
+		NextCursor:  nextCursor,
 	}, nil
 }
 
-// CancelCourse cancel registered courses on the course enrollment table
-func (enrollmentUC *EnrollmentUseCase) CancelCourse(ctx context.Context, studentID, courseID int64) (CancelCourseResp, error) {
-	err := enrollmentUC.courseEnrollmentService.UpdateCourseEnrollmentStatus(ctx, studentID, courseID, courseEnrollmentDomain.StatusCancelled)
+// exceedsCreditLimit reports whether taking the course would carry the student over the credit limit of the term,
+// along with the credits the student already takes in the term. It is never exceeded without a limit.
+func (enrollmentUC *EnrollmentUseCase) exceedsCreditLimit(ctx context.Context, studentID, termID int64, courseData courseDomain.Course) (int, bool, error) {
+	if enrollmentUC.creditLoadPolicy.MaxCredits <= 0 {
+		return 0, false, nil
+	}
+
+	creditsByTermID, err := enrollmentUC.activeCreditsByTerm(ctx, studentID)
+	if err != nil {
+		return 0, false, err
+	}
+	credits := creditsByTermID[termID]
+
+	return credits, !enrollmentUC.creditLoadPolicy.Allows(credits, courseData.CreditHours), nil
+}
+
+// activeCreditsByTerm sums the credit hours of the student's active enrollments per term ID.
+func (enrollmentUC *EnrollmentUseCase) activeCreditsByTerm(ctx context.Context, studentID int64) (map[int64]int, error) {
+	enrollments, err := enrollmentUC.courseEnrollmentService.GetEnrollmentByStudentID(ctx, studentID)
+	if err != nil {
+		return nil, err
+	}
+	var active []courseEnrollmentDomain.CourseEnrollment
+	var sectionIDs, courseIDs []int64
+	for _, enrollment := range enrollments {
+		if enrollment.Status != courseEnrollmentDomain.StatusActive {
+			continue
+		}
+		active = append(active, enrollment)
+		sectionIDs = append(sectionIDs, enrollment.SectionID)
+		courseIDs = append(courseIDs, enrollment.CourseID)
+	}
+	if len(active) == 0 {
+		return map[int64]int{}, nil
+	}
+
+	sectionByID, err := enrollmentUC.sectionService.GetSectionsByIDs(ctx, sectionIDs)
+	if err != nil {
+		return nil, err
+	}
+	courseByID, err := enrollmentUC.courseService.GetCoursesByIDs(ctx, courseIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	creditsByTermID := make(map[int64]int)
+	for _, enrollment := range active {
+		section, ok := sectionByID[enrollment.SectionID]
+		if !ok {
+			continue
+		}
+		creditsByTermID[section.TermID] += courseByID[enrollment.CourseID].CreditHours
+	}
+
+	return creditsByTermID, nil
+}
+
+// listCreditLoads returns the credit load of every term the student is active in, ordered by term
+// start, or only that of termID when it is not 0.
+func (enrollmentUC *EnrollmentUseCase) listCreditLoads(ctx context.Context, studentID, termID int64) ([]TermCreditLoad, error) {
+	creditsByTermID, err := enrollmentUC.activeCreditsByTerm(ctx, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var termIDs []int64
+	if termID != 0 {
+		termIDs = []int64{termID}
+	} else {
+		for id := range creditsByTermID {
+			termIDs = append(termIDs, id)
+		}
+		sort.Slice(termIDs, func(i, j int) bool { return termIDs[i] < termIDs[j] })
+	}
+	if len(termIDs) == 0 {
+		return nil, nil
+	}
+
+	termByID, err := enrollmentUC.termService.GetTermsByIDs(ctx, termIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	terms := make([]termDomain.Term, 0, len(termIDs))
+	for _, id := range termIDs {
+		if term, ok := termByID[id]; ok {
+			terms = append(terms, term)
+		}
+	}
+	sort.SliceStable(terms, func(i, j int) bool { return terms[i].StartDate.Before(terms[j].StartDate) })
+
+	creditLoads := make([]TermCreditLoad, 0, len(terms))
+	for _, term := range terms {
+		creditLoads = append(creditLoads, enrollmentUC.termCreditLoad(term, creditsByTermID[term.ID]))
+	}
+
+	return creditLoads, nil
+}
+
+// termCreditLoad describes the student's credits in the term against the credit load policy.
+func (enrollmentUC *EnrollmentUseCase) termCreditLoad(term termDomain.Term, credits int) TermCreditLoad {
+	creditLoad := TermCreditLoad{
+		TermID:          term.ID,
+		TermName:        term.Name,
+		EnrolledCredits: credits,
+		MaxCredits:      enrollmentUC.creditLoadPolicy.MaxCredits,
+		FullTime:        enrollmentUC.creditLoadPolicy.IsFullTime(credits),
+	}
+	if remaining, limited := enrollmentUC.creditLoadPolicy.Remaining(credits); limited {
+		creditLoad.RemainingCredits = &remaining
+	}
+
+	return creditLoad
+}
+
+// CancelCourse cancels the enrollment of a student in a section on the course enrollment table.
+// The seat released by an active enrollment goes to the head of the section waitlist.
+// Active enrollments can only be cancelled until the drop deadline of their term.
+func (enrollmentUC *EnrollmentUseCase) CancelCourse(ctx context.Context, studentID, sectionID int64) (CancelCourseResp, error) {
+	// Ensure the caller may act for the student
+	if err := auth.AuthorizeStudent(ctx, studentID); err != nil {
+		return CancelCourseResp{Status: common.StatusFailure, Message: enrollmentErrorMessage(err, "failed to cancel course enrollment")}, err
+	}
+
+	var resp CancelCourseResp
+	err := enrollmentUC.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = enrollmentUC.cancel(ctx, studentID, sectionID)
+		return err
+	})
+
+	return resp, err
+}
+
+// cancel cancels the enrollment of the student in the section, it must run inside a unit of work.
+func (enrollmentUC *EnrollmentUseCase) cancel(ctx context.Context, studentID, sectionID int64) (CancelCourseResp, error) {
+	// Lock the enrollment so the drop deadline is checked against the status that gets cancelled
+	enrollment, err := enrollmentUC.courseEnrollmentService.LockEnrollmentByStudentIDAndSectionID(ctx, studentID, sectionID)
 	if err != nil {
-		return CancelCourseResp{Status: common.StatusFailure, Message: "failed to cancel course enrollment"}, err
+		return CancelCourseResp{Status: common.StatusFailure, Message: "failed to retrieve course enrollment"}, err
+	}
+	if enrollment == nil {
+		return CancelCourseResp{Status: common.StatusFailure, Message: "course enrollment not found"}, nil
+	}
+
+	// Ensure the drop deadline of the enrollment's term has not passed
+	if enrollment.Status == courseEnrollmentDomain.StatusActive {
+		termData, err := enrollmentUC.sectionTerm(ctx, enrollment.SectionID)
+		if err != nil {
+			return CancelCourseResp{Status: common.StatusFailure, Message: "failed to retrieve term data"}, err
+		}
+		if err := termData.CheckDropDeadline(enrollmentUC.now()); err != nil {
+			return CancelCourseResp{Status: common.StatusFailure, Message: enrollmentErrorMessage(err, "failed to cancel course enrollment")}, err
+		}
+	}
+
+	if err := enrollmentUC.cancelEnrollment(ctx, *enrollment); err != nil {
+		return CancelCourseResp{Status: common.StatusFailure, Message: enrollmentErrorMessage(err, "failed to cancel course enrollment")}, err
 	}
 
 	return CancelCourseResp{
@@ -137,10 +632,253 @@ func (enrollmentUC *EnrollmentUseCase) CancelCourse(ctx context.Context, student
 	}, nil
 }
 
-// ListClassmates retrieves the list of classmates for the given student.
-func (enrollmentUC *EnrollmentUseCase) ListClassmates(ctx context.Context, studentID int64) (ListClassmatesResp, error) {
+// CancelStudentEnrollments cancels every active and waitlisted enrollment of a student regardless of the drop
+// deadline, handing the freed seats to the section waitlists. It is used to delete the student and joins the
+// unit of work of the caller.
+func (enrollmentUC *EnrollmentUseCase) CancelStudentEnrollments(ctx context.Context, studentID int64) error {
+	// Only student managers may remove a student from every course
+	if err := auth.Authorize(ctx, auth.PermManageStudents); err != nil {
+		return err
+	}
+
+	return enrollmentUC.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		// Lock the student so sign-ups running meanwhile cannot add an enrollment
+		if err := enrollmentUC.studentService.LockStudentByID(ctx, studentID); err != nil {
+			return err
+		}
+
+		enrollments, err := enrollmentUC.courseEnrollmentService.GetEnrollmentByStudentID(ctx, studentID)
+		if err != nil {
+			return err
+		}
+		for _, enrollment := range enrollments {
+			if err := enrollmentUC.cancelEnrollment(ctx, enrollment); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// cancelEnrollment cancels the enrollment and promotes the waitlist of its section when it held a seat,
+// it must run inside a unit of work.
+func (enrollmentUC *EnrollmentUseCase) cancelEnrollment(ctx context.Context, enrollment courseEnrollmentDomain.CourseEnrollment) error {
+	if _, err := enrollmentUC.courseEnrollmentService.CancelEnrollment(ctx, enrollment); err != nil {
+		return err
+	}
+	if enrollment.Status != courseEnrollmentDomain.StatusActive {
+		return nil
+	}
+
+	return enrollmentUC.promoteWaitlist(ctx, enrollment.SectionID)
+}
+
+// promoteWaitlist gives the seat freed in the section to the longest waiting student who could sign up for it,
+// it must run inside a unit of work. Students whose schedule would conflict or who would exceed the credit
+// limit of the term stay on the waitlist, as do deleted students.
+func (enrollmentUC *EnrollmentUseCase) promoteWaitlist(ctx context.Context, sectionID int64) error {
+	// Read the waitlist without locking it, each student is locked before being checked as in a sign-up
+	waitlist, err := enrollmentUC.courseEnrollmentService.GetWaitlistBySectionID(ctx, sectionID)
+	if err != nil || len(waitlist) == 0 {
+		return err
+	}
+
+	sectionData, err := enrollmentUC.sectionService.GetSectionByID(ctx, sectionID)
+	if err != nil {
+		return err
+	}
+	if sectionData == nil {
+		return fmt.Errorf("section %d not found", sectionID)
+	}
+	courseData, err := enrollmentUC.courseService.GetCourseByID(ctx, sectionData.CourseID)
+	if err != nil {
+		return err
+	}
+	if courseData == nil {
+		return fmt.Errorf("course %d of section %d not found", sectionData.CourseID, sectionID)
+	}
+
+	for _, enrollment := range waitlist {
+		// Lock the student like a sign-up does, so the checks below see the student's other enrollments
+		if err := enrollmentUC.studentService.LockStudentByID(ctx, enrollment.StudentID); err != nil {
+			if errors.Is(err, studentDomain.ErrNoRowsAffected) {
+				continue
+			}
+			return err
+		}
+
+		conflict, err := enrollmentUC.scheduleConflict(ctx, enrollment.StudentID, *sectionData)
+		if err != nil {
+			return err
+		}
+		if conflict != nil {
+			continue
+		}
+
+		_, exceeded, err := enrollmentUC.exceedsCreditLimit(ctx, enrollment.StudentID, sectionData.TermID, *courseData)
+		if err != nil {
+			return err
+		}
+		if exceeded {
+			continue
+		}
+
+		// The student may have withdrawn from the waitlist since it was read
+		_, err = enrollmentUC.courseEnrollmentService.PromoteEnrollment(ctx, enrollment)
+		if errors.Is(err, courseEnrollmentDomain.ErrNoRowsAffected) {
+			continue
+		}
+		return err
+	}
+
+	return nil
+}
+
+// BatchCourseSignUp signs every item of the batch up in request order, see runBatch.
+func (enrollmentUC *EnrollmentUseCase) BatchCourseSignUp(ctx context.Context, req BatchCourseSignUpRequest) (BatchCourseSignUpResp, error) {
+	mode := batchModeOrDefault(req.Mode)
+	results := make([]CourseSignUpResp, len(req.Items))
+	failedIndex, err := enrollmentUC.runBatch(ctx, mode, len(req.Items), func(ctx context.Context, i int) bool {
+		var err error
+		results[i], err = enrollmentUC.CourseSignUp(ctx, req.Items[i])
+		if err != nil {
+			logger.Warnf("batch sign-up item %d failed: %v", i+1, err)
+		}
+		return results[i].Status == common.StatusSuccess
+	})
+	if err != nil {
+		return BatchCourseSignUpResp{Status: common.StatusFailure, Message: "failed to sign up courses", Mode: mode}, err
+	}
+
+	resp := BatchCourseSignUpResp{Status: common.StatusSuccess, Mode: mode, Results: results}
+	if failedIndex >= 0 {
+		// Nothing of an all-or-nothing batch was kept
+		for i := range resp.Results {
+			if i != failedIndex {
+				resp.Results[i] = CourseSignUpResp{Status: common.StatusFailure, Message: batchSkippedMessage(i, failedIndex)}
+			}
+		}
+	}
+	for _, result := range resp.Results {
+		if result.Status == common.StatusSuccess {
+			resp.Succeeded++
+		} else {
+			resp.Failed++
+		}
+	}
+	resp.Status, resp.Message = batchStatus(failedIndex, resp.Failed, len(req.Items))
+
+	return resp, nil
+}
+
+// BatchCancelCourse cancels every item of the batch in request order, see runBatch.
+func (enrollmentUC *EnrollmentUseCase) BatchCancelCourse(ctx context.Context, req BatchCancelCourseRequest) (BatchCancelCourseResp, error) {
+	mode := batchModeOrDefault(req.Mode)
+	results := make([]CancelCourseResp, len(req.Items))
+	failedIndex, err := enrollmentUC.runBatch(ctx, mode, len(req.Items), func(ctx context.Context, i int) bool {
+		var err error
+		results[i], err = enrollmentUC.CancelCourse(ctx, req.Items[i].StudentID, req.Items[i].SectionID)
+		if err != nil {
+			logger.Warnf("batch cancel item %d failed: %v", i+1, err)
+		}
+		return results[i].Status == common.StatusSuccess
+	})
+	if err != nil {
+		return BatchCancelCourseResp{Status: common.StatusFailure, Message: "failed to cancel course enrollments", Mode: mode}, err
+	}
+
+	resp := BatchCancelCourseResp{Status: common.StatusSuccess, Mode: mode, Results: results}
+	if failedIndex >= 0 {
+		// Nothing of an all-or-nothing batch was kept
+		for i := range resp.Results {
+			if i != failedIndex {
+				resp.Results[i] = CancelCourseResp{Status: common.StatusFailure, Message: batchSkippedMessage(i, failedIndex)}
+			}
+		}
+	}
+	for _, result := range resp.Results {
+		if result.Status == common.StatusSuccess {
+			resp.Succeeded++
+		} else {
+			resp.Failed++
+		}
+	}
+	resp.Status, resp.Message = batchStatus(failedIndex, resp.Failed, len(req.Items))
+
+	return resp, nil
+}
+
+// errBatchItemFailed rolls back an all-or-nothing batch once one of its items fails.
+var errBatchItemFailed = errors.New("batch item failed")
+
+// runBatch calls item for the n items of a batch in order, item reports whether it succeeded.
+// An all-or-nothing batch runs as one unit of work, so later items see the enrollments of the
+// earlier ones, and stops at the first failed item, whose index is returned after rolling back.
+// A best-effort batch runs every item in its own unit of work and returns -1.
+func (enrollmentUC *EnrollmentUseCase) runBatch(ctx context.Context, mode BatchMode, n int, item func(ctx context.Context, i int) bool) (int, error) {
+	if mode == BatchModeBestEffort {
+		for i := 0; i < n; i++ {
+			item(ctx, i)
+		}
+		return -1, nil
+	}
+
+	failedIndex := -1
+	err := enrollmentUC.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		for i := 0; i < n; i++ {
+			if !item(ctx, i) {
+				failedIndex = i
+				return errBatchItemFailed
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, errBatchItemFailed) {
+		return failedIndex, nil
+	}
+
+	return -1, err
+}
+
+// batchModeOrDefault returns mode, or BatchModeAllOrNothing when it is not set.
+func batchModeOrDefault(mode BatchMode) BatchMode {
+	if mode == "" {
+		return BatchModeAllOrNothing
+	}
+	return mode
+}
+
+// batchSkippedMessage explains why item i of an all-or-nothing batch was not kept.
+func batchSkippedMessage(i, failedIndex int) string {
+	if i < failedIndex {
+		return "rolled back, item " + strconv.Itoa(failedIndex+1) + " of the batch failed"
+	}
+	return "not processed, item " + strconv.Itoa(failedIndex+1) + " of the batch failed"
+}
+
+// batchStatus summarizes a batch of n items of which failed did not succeed.
+func batchStatus(failedIndex, failed, n int) (string, string) {
+	switch {
+	case failedIndex >= 0:
+		return common.StatusFailure, "item " + strconv.Itoa(failedIndex+1) + " failed, the batch was rolled back"
+	case failed > 0:
+		return common.StatusSuccess, strconv.Itoa(failed) + " of " + strconv.Itoa(n) + " items failed"
+	default:
+		return common.StatusSuccess, ""
+	}
+}
+
+// ListClassmates retrieves a page of the classmates of the given student, grouped by course
+// in the order of the page.
+func (enrollmentUC *EnrollmentUseCase) ListClassmates(ctx context.Context, req ListClassmatesRequest) (ListClassmatesResp, error) {
+	// Ensure the caller may act for the student
+	if err := auth.AuthorizeStudent(ctx, req.StudentID); err != nil {
+		return ListClassmatesResp{Status: common.StatusFailure, Message: enrollmentErrorMessage(err, "failed to get list of classmates")}, err
+	}
+
 	// Ensure the student data exists
-	studentData, err := enrollmentUC.studentService.GetStudentByID(ctx, studentID)
+	studentData, err := enrollmentUC.studentService.GetStudentByID(ctx, req.StudentID)
 	if err != nil {
 		return ListClassmatesResp{Status: common.StatusFailure, Message: "failed to retrieve student data"}, err
 	}
@@ -148,48 +886,68 @@ func (enrollmentUC *EnrollmentUseCase) ListClassmates(ctx context.Context, stude
 		return ListClassmatesResp{Status: common.StatusFailure, Message: "student data not found"}, nil
 	}
 
-	// Get course enrollments for the student
-	enrollments, err := enrollmentUC.courseEnrollmentService.GetListClassmates(ctx, studentID)
+	query, limit, err := req.ListOptions.toListQuery()
+	if err != nil {
+		return ListClassmatesResp{Status: common.StatusFailure, Message: enrollmentErrorMessage(err, "failed to get list of classmates")}, err
+	}
+
+	// Get a page of classmate enrollments for the student
+	enrollments, err := enrollmentUC.courseEnrollmentService.GetListClassmates(ctx, req.StudentID, query)
 	if err != nil {
-		return ListClassmatesResp{Status: common.StatusFailure, Message: "failed to get list of classmates"}, err
+		return ListClassmatesResp{Status: common.StatusFailure, Message: enrollmentErrorMessage(err, "failed to get list of classmates")}, err
 	}
+	enrollments, hasNextPage := trimPage(enrollments, limit)
 
 	// Create a map to group students by course ID
 	mapCourseGroup := make(map[int64][]int64)
+	var courseIDs, classmateIDs []int64
 	for _, enrollment := range enrollments {
+		if _, ok := mapCourseGroup[enrollment.CourseID]; !ok {
+			courseIDs = append(courseIDs, enrollment.CourseID)
+		}
 		mapCourseGroup[enrollment.CourseID] = append(mapCourseGroup[enrollment.CourseID], enrollment.StudentID)
+		classmateIDs = append(classmateIDs, enrollment.StudentID)
+	}
+
+	// Get the courses and classmates in a single lookup each
+	courseByID, err := enrollmentUC.courseService.GetCoursesByIDs(ctx, courseIDs)
+	if err != nil {
+		return ListClassmatesResp{Status: common.StatusFailure, Message: "failed to retrieve course data"}, err
+	}
+	studentByID, err := enrollmentUC.studentService.GetStudentsByIDs(ctx, classmateIDs)
+	if err != nil {
+		return ListClassmatesResp{Status: common.StatusFailure, Message: "failed to retrieve student data"}, err
 	}
 
 	// Prepare the response
 	var response ListClassmatesResp
-	for courseID, studentIDs := range mapCourseGroup {
-		course, err := enrollmentUC.courseService.GetCourseByID(ctx, courseID) // todo: improve this with get bulk
-		if err != nil {
-			return ListClassmatesResp{Status: common.StatusFailure, Message: "failed to retrieve course data"}, err
-		}
-		if course == nil {
+	for _, courseID := range courseIDs {
+		course, ok := courseByID[courseID]
+		if !ok {
 			return ListClassmatesResp{Status: common.StatusFailure, Message: "course data is not found for courseID: " + strconv.FormatInt(courseID, 10)}, nil
 		}
 
 		var classmates []ListClassmatesStudentsResp
-		for _, id := range studentIDs {
-			if id == studentID {
-				continue // Skip the current student
+		for _, id := range mapCourseGroup[courseID] {
+			// Deleted students are no longer returned by the student service
+			student, ok := studentByID[id]
+			if !ok {
+				continue
 			}
 
-			student, err := enrollmentUC.studentService.GetStudentByID(ctx, id) // todo: improve this with get bulk
-			if err != nil {
-				return ListClassmatesResp{Status: common.StatusFailure, Message: "failed to retrieve student data: " + strconv.FormatInt(id, 10)}, err
-			}
-			if student == nil {
-				return ListClassmatesResp{Status: common.StatusFailure, Message: "student data is not found for studentID: " + strconv.FormatInt(id, 10)}, nil
+			// Honour the classmate's privacy settings
+			if !student.Privacy.Visibility.VisibleToClassmates() {
+				continue
 			}
 
 			classmates = append(classmates, ListClassmatesStudentsResp{
 				StudentID:    strconv.FormatInt(student.ID, 10),
-				StudentEmail: student.Email,
+				StudentEmail: classmateEmail(student),
 			})
 		}
+		if len(classmates) == 0 {
+			continue
+		}
 
 		response.Courses = append(response.Courses, ListClassmatesCourseResp{
 			CourseID:   course.ID,
@@ -198,8 +956,427 @@ func (enrollmentUC *EnrollmentUseCase) ListClassmates(ctx context.Context, stude
 		})
 	}
 
+	if hasNextPage {
+		last := enrollments[len(enrollments)-1]
+		response.NextCursor = courseEnrollmentDomain.NewEnrollmentCursor(query.SortBy, courseByID[last.CourseID].Name, last).Encode()
+	}
+
 	return ListClassmatesResp{
-		Status:  common.StatusSuccess,
-		Courses: response.Courses,
+		Status:     common.StatusSuccess,
+		Courses:    response.Courses,
+		NextCursor: response.NextCursor,
+	}, nil
+}
+
+// GetSchedule builds the weekly timetable of the student's active enrollments, optionally within one term.
+func (enrollmentUC *EnrollmentUseCase) GetSchedule(ctx context.Context, req ScheduleRequest) (ScheduleResp, error) {
+	// Ensure the caller may act for the student
+	if err := auth.AuthorizeStudent(ctx, req.StudentID); err != nil {
+		return ScheduleResp{Status: common.StatusFailure, Message: enrollmentErrorMessage(err, "failed to get schedule")}, err
+	}
+
+	// Ensure the student data exists
+	studentData, err := enrollmentUC.studentService.GetStudentByID(ctx, req.StudentID)
+	if err != nil {
+		return ScheduleResp{Status: common.StatusFailure, Message: "failed to retrieve student data"}, err
+	}
+	if studentData == nil {
+		return ScheduleResp{Status: common.StatusFailure, Message: "student data not found"}, nil
+	}
+
+	enrollments, err := enrollmentUC.courseEnrollmentService.GetEnrollmentByStudentID(ctx, req.StudentID)
+	if err != nil {
+		return ScheduleResp{Status: common.StatusFailure, Message: "failed to retrieve enrollments"}, err
+	}
+	var sectionIDs []int64
+	for _, enrollment := range enrollments {
+		if enrollment.Status == courseEnrollmentDomain.StatusActive {
+			sectionIDs = append(sectionIDs, enrollment.SectionID)
+		}
+	}
+
+	// Get the enrolled sections of the term with their meetings and courses
+	sectionByID, err := enrollmentUC.sectionService.GetSectionsByIDs(ctx, sectionIDs)
+	if err != nil {
+		return ScheduleResp{Status: common.StatusFailure, Message: "failed to retrieve section data"}, err
+	}
+	var termSectionIDs, courseIDs []int64
+	for _, sectionID := range sectionIDs {
+		section, ok := sectionByID[sectionID]
+		if !ok || (req.TermID != 0 && section.TermID != req.TermID) {
+			continue
+		}
+		termSectionIDs = append(termSectionIDs, sectionID)
+		courseIDs = append(courseIDs, section.CourseID)
+	}
+	meetingsBySectionID, err := enrollmentUC.sectionService.GetMeetingsBySectionIDs(ctx, termSectionIDs)
+	if err != nil {
+		return ScheduleResp{Status: common.StatusFailure, Message: "failed to retrieve section meetings"}, err
+	}
+	courseByID, err := enrollmentUC.courseService.GetCoursesByIDs(ctx, courseIDs)
+	if err != nil {
+		return ScheduleResp{Status: common.StatusFailure, Message: "failed to retrieve course data"}, err
+	}
+
+	// Group the meetings by weekday
+	meetingsByDay := make(map[sectionDomain.DayOfWeek][]ScheduleMeeting)
+	for _, sectionID := range termSectionIDs {
+		section := sectionByID[sectionID]
+		for _, meeting := range meetingsBySectionID[sectionID] {
+			meetingsByDay[meeting.DayOfWeek] = append(meetingsByDay[meeting.DayOfWeek], ScheduleMeeting{
+				CourseID:   section.CourseID,
+				CourseName: courseByID[section.CourseID].Name,
+				SectionID:  section.ID,
+				TermID:     section.TermID,
+				StartTime:  meeting.StartTime,
+				EndTime:    meeting.EndTime,
+				Room:       meeting.Room,
+			})
+		}
+	}
+
+	days := make([]ScheduleDay, 0, len(meetingsByDay))
+	for day, meetings := range meetingsByDay {
+		sort.SliceStable(meetings, func(i, j int) bool {
+			return meetings[i].StartTime < meetings[j].StartTime
+		})
+		days = append(days, ScheduleDay{DayOfWeek: day, Meetings: meetings})
+	}
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].DayOfWeek.WeekOrder() < days[j].DayOfWeek.WeekOrder()
+	})
+
+	return ScheduleResp{
+		Status:    common.StatusSuccess,
+		StudentID: req.StudentID,
+		Days:      days,
+	}, nil
+}
+
+// GetCourseRoster retrieves the active, waitlisted and cancelled students of a course in enrollment order.
+func (enrollmentUC *EnrollmentUseCase) GetCourseRoster(ctx context.Context, courseID int64) (CourseRosterResp, error) {
+	// Only course managers and the course's own instructors may view its roster
+	if err := enrollmentUC.authorizeCourseStaff(ctx, auth.PermViewRosters, courseID); err != nil {
+		return CourseRosterResp{Status: common.StatusFailure, Message: enrollmentErrorMessage(err, "failed to get course roster")}, err
+	}
+
+	// Ensure the course data exists
+	courseData, err := enrollmentUC.courseService.GetCourseByID(ctx, courseID)
+	if err != nil {
+		return CourseRosterResp{Status: common.StatusFailure, Message: "failed to retrieve course data"}, err
+	}
+	if courseData == nil {
+		return CourseRosterResp{Status: common.StatusFailure, Message: "course data not found"}, nil
+	}
+
+	enrollments, err := enrollmentUC.courseEnrollmentService.GetEnrollmentsByCourseID(ctx, courseID, RosterStatuses)
+	if err != nil {
+		return CourseRosterResp{Status: common.StatusFailure, Message: enrollmentErrorMessage(err, "failed to get course roster")}, err
+	}
+
+	studentIDs := make([]int64, 0, len(enrollments))
+	for _, enrollment := range enrollments {
+		studentIDs = append(studentIDs, enrollment.StudentID)
+	}
+	studentByID, err := enrollmentUC.studentService.GetStudentsByIDs(ctx, studentIDs)
+	if err != nil {
+		return CourseRosterResp{Status: common.StatusFailure, Message: "failed to retrieve student data"}, err
+	}
+
+	principal, _ := auth.PrincipalFromContext(ctx)
+	students := make([]RosterStudent, 0, len(enrollments))
+	for _, enrollment := range enrollments {
+		// Deleted students are no longer returned by the student service
+		student, ok := studentByID[enrollment.StudentID]
+		if !ok {
+			continue
+		}
+
+		students = append(students, RosterStudent{
+			StudentID:    student.ID,
+			StudentEmail: rosterEmail(principal, student),
+			Status:       enrollment.Status,
+			CreateTime:   enrollment.CreateTime,
+			UpdateTime:   enrollment.UpdateTime,
+		})
+	}
+
+	return CourseRosterResp{
+		Status:     common.StatusSuccess,
+		CourseID:   courseData.ID,
+		CourseName: courseData.Name,
+		Students:   students,
+	}, nil
+}
+
+// RecordGrade records the final grade of a student's active enrollment in a section of a course.
+// A passing grade completes the enrollment and a failing one fails it.
+func (enrollmentUC *EnrollmentUseCase) RecordGrade(ctx context.Context, req RecordGradeRequest) (RecordGradeResp, error) {
+	// Only course managers and the course's own instructors may grade it
+	if err := enrollmentUC.authorizeCourseStaff(ctx, auth.PermWriteGrades, req.CourseID); err != nil {
+		return RecordGradeResp{Status: common.StatusFailure, Message: enrollmentErrorMessage(err, "failed to record grade")}, err
+	}
+
+	// Ensure the course data exists
+	courseData, err := enrollmentUC.courseService.GetCourseByID(ctx, req.CourseID)
+	if err != nil {
+		return RecordGradeResp{Status: common.StatusFailure, Message: "failed to retrieve course data"}, err
+	}
+	if courseData == nil {
+		return RecordGradeResp{Status: common.StatusFailure, Message: "course data not found"}, nil
+	}
+
+	// Ensure the section belongs to the course being graded
+	sectionData, err := enrollmentUC.sectionService.GetSectionByID(ctx, req.SectionID)
+	if err != nil {
+		return RecordGradeResp{Status: common.StatusFailure, Message: "failed to retrieve section data"}, err
+	}
+	if sectionData == nil || sectionData.CourseID != courseData.ID {
+		return RecordGradeResp{Status: common.StatusFailure, Message: "section data not found"}, nil
+	}
+
+	enrollment, err := enrollmentUC.courseEnrollmentService.RecordGrade(ctx, req.StudentID, sectionData.ID, req.Grade)
+	if errors.Is(err, courseEnrollmentDomain.ErrNoRowsAffected) {
+		return RecordGradeResp{Status: common.StatusFailure, Message: "course enrollment not found"}, nil
+	}
+	if err != nil {
+		return RecordGradeResp{Status: common.StatusFailure, Message: enrollmentErrorMessage(err, "failed to record grade")}, err
+	}
+
+	return RecordGradeResp{
+		Status: common.StatusSuccess,
+		GradeData: &GradeDetail{
+			StudentID:   enrollment.StudentID,
+			CourseID:    enrollment.CourseID,
+			SectionID:   enrollment.SectionID,
+			Status:      enrollment.Status,
+			Grade:       enrollment.Grade.Letter,
+			GradePoints: enrollment.Grade.Points,
+			GradeTime:   enrollment.UpdateTime,
+		},
 	}, nil
 }
+
+// GetTranscript lists the graded courses of a student in the order they were graded, with the credit
+// totals and the credit weighted GPA. Failed courses count towards the GPA but earn no credits.
+func (enrollmentUC *EnrollmentUseCase) GetTranscript(ctx context.Context, studentID int64) (TranscriptResp, error) {
+	// Ensure the caller may act for the student
+	if err := auth.AuthorizeStudent(ctx, studentID); err != nil {
+		return TranscriptResp{Status: common.StatusFailure, Message: enrollmentErrorMessage(err, "failed to get transcript")}, err
+	}
+
+	// Ensure the student data exists
+	studentData, err := enrollmentUC.studentService.GetStudentByID(ctx, studentID)
+	if err != nil {
+		return TranscriptResp{Status: common.StatusFailure, Message: "failed to retrieve student data"}, err
+	}
+	if studentData == nil {
+		return TranscriptResp{Status: common.StatusFailure, Message: "student data not found"}, nil
+	}
+
+	enrollments, err := enrollmentUC.courseEnrollmentService.GetGradedEnrollmentsByStudentID(ctx, studentID)
+	if err != nil {
+		return TranscriptResp{Status: common.StatusFailure, Message: "failed to retrieve enrollments"}, err
+	}
+
+	// Get the graded courses and the terms they were taken in
+	courseIDs := make([]int64, 0, len(enrollments))
+	sectionIDs := make([]int64, 0, len(enrollments))
+	for _, enrollment := range enrollments {
+		courseIDs = append(courseIDs, enrollment.CourseID)
+		sectionIDs = append(sectionIDs, enrollment.SectionID)
+	}
+	courseByID, err := enrollmentUC.courseService.GetCoursesByIDs(ctx, courseIDs)
+	if err != nil {
+		return TranscriptResp{Status: common.StatusFailure, Message: "failed to retrieve course data"}, err
+	}
+	sectionByID, err := enrollmentUC.sectionService.GetSectionsByIDs(ctx, sectionIDs)
+	if err != nil {
+		return TranscriptResp{Status: common.StatusFailure, Message: "failed to retrieve section data"}, err
+	}
+	termIDs := make([]int64, 0, len(sectionIDs))
+	for _, sectionID := range sectionIDs {
+		if section, ok := sectionByID[sectionID]; ok {
+			termIDs = append(termIDs, section.TermID)
+		}
+	}
+	termByID, err := enrollmentUC.termService.GetTermsByIDs(ctx, termIDs)
+	if err != nil {
+		return TranscriptResp{Status: common.StatusFailure, Message: "failed to retrieve term data"}, err
+	}
+
+	response := TranscriptResp{
+		Status:    common.StatusSuccess,
+		StudentID: studentID,
+		Courses:   make([]TranscriptCourse, 0, len(enrollments)),
+	}
+	creditHours := make(map[int64]int, len(courseByID))
+	for _, enrollment := range enrollments {
+		if enrollment.Grade == nil {
+			continue
+		}
+
+		course := courseByID[enrollment.CourseID]
+		section := sectionByID[enrollment.SectionID]
+		creditHours[course.ID] = course.CreditHours
+
+		transcriptCourse := TranscriptCourse{
+			CourseID:    enrollment.CourseID,
+			CourseName:  course.Name,
+			TermID:      section.TermID,
+			TermName:    termByID[section.TermID].Name,
+			Status:      enrollment.Status,
+			Grade:       enrollment.Grade.Letter,
+			GradePoints: enrollment.Grade.Points,
+			CreditHours: course.CreditHours,
+			GradeTime:   enrollment.UpdateTime,
+		}
+		response.CreditsAttempted += course.CreditHours
+		if enrollment.Status == courseEnrollmentDomain.StatusCompleted {
+			transcriptCourse.CreditsEarned = course.CreditHours
+			response.CreditsEarned += course.CreditHours
+		}
+		response.Courses = append(response.Courses, transcriptCourse)
+	}
+	response.GPA = courseEnrollmentDomain.GradePointAverage(enrollments, creditHours)
+
+	return response, nil
+}
+
+// authorizeCourseStaff returns nil when the caller holds perm for the course: course managers
+// hold it for every course, other holders of perm only for the courses they teach.
+func (enrollmentUC *EnrollmentUseCase) authorizeCourseStaff(ctx context.Context, perm auth.Permission, courseID int64) error {
+	if err := auth.Authorize(ctx, perm); err != nil {
+		return err
+	}
+
+	principal, _ := auth.PrincipalFromContext(ctx)
+	if principal.Can(auth.PermManageCourses) {
+		return nil
+	}
+	if !principal.IsInstructor() {
+		return auth.ErrForbidden
+	}
+
+	courseIDs, err := enrollmentUC.instructorService.GetCourseIDsByInstructorID(ctx, principal.InstructorID)
+	if err != nil {
+		return err
+	}
+	for _, id := range courseIDs {
+		if id == courseID {
+			return nil
+		}
+	}
+
+	return auth.ErrForbidden
+}
+
+// rosterEmail returns the email shown on a course roster. Student managers always see it;
+// others do not see the email of students who chose to be hidden.
+func rosterEmail(principal auth.Principal, student studentDomain.Student) string {
+	if principal.Can(auth.PermManageStudents) || student.Privacy.Visibility != studentDomain.VisibilityHidden {
+		return student.Email
+	}
+
+	return ""
+}
+
+// classmateEmail returns the email shown to the student's classmates, masked unless the student opted in.
+func classmateEmail(student studentDomain.Student) string {
+	if student.Privacy.ShowEmail {
+		return student.Email
+	}
+
+	return studentDomain.MaskEmail(student.Email)
+}
+
+// toListQuery converts the list options into a domain list query. The query asks for one
+// enrollment more than the returned page limit so the caller can tell whether another page follows.
+func (opts ListOptions) toListQuery() (courseEnrollmentDomain.EnrollmentListQuery, int, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = DefaultListLimit
+	}
+	if limit > MaxListLimit {
+		limit = MaxListLimit
+	}
+
+	query := courseEnrollmentDomain.EnrollmentListQuery{
+		Statuses:     opts.Statuses,
+		TermID:       opts.TermID,
+		CreatedAfter: opts.CreatedAfter,
+		SortBy:       opts.SortBy,
+		Limit:        limit + 1,
+	}
+	if query.SortBy == "" {
+		query.SortBy = courseEnrollmentDomain.SortByEnrollTime
+	}
+	if opts.Cursor != "" {
+		cursor, err := courseEnrollmentDomain.DecodeEnrollmentCursor(opts.Cursor)
+		if err != nil {
+			return courseEnrollmentDomain.EnrollmentListQuery{}, 0, err
+		}
+		query.After = &cursor
+	}
+
+	return query, limit, nil
+}
+
+// sectionTerm retrieves the term a section is offered in.
+func (enrollmentUC *EnrollmentUseCase) sectionTerm(ctx context.Context, sectionID int64) (termDomain.Term, error) {
+	sectionData, err := enrollmentUC.sectionService.GetSectionByID(ctx, sectionID)
+	if err != nil {
+		return termDomain.Term{}, err
+	}
+	if sectionData == nil {
+		return termDomain.Term{}, fmt.Errorf("section %d not found", sectionID)
+	}
+	termData, err := enrollmentUC.termService.GetTermByID(ctx, sectionData.TermID)
+	if err != nil {
+		return termDomain.Term{}, err
+	}
+	if termData == nil {
+		return termDomain.Term{}, fmt.Errorf("term %d not found", sectionData.TermID)
+	}
+
+	return *termData, nil
+}
+
+// trimPage drops the extra enrollment fetched by toListQuery and reports whether it was there.
+func trimPage(enrollments []courseEnrollmentDomain.CourseEnrollment, limit int) ([]courseEnrollmentDomain.CourseEnrollment, bool) {
+	if len(enrollments) > limit {
+		return enrollments[:limit], true
+	}
+
+	return enrollments, false
+}
+
+// enrollmentErrorMessage maps known course enrollment domain errors to a client facing message.
+func enrollmentErrorMessage(err error, fallback string) string {
+	switch {
+	case errors.Is(err, courseEnrollmentDomain.ErrEnrollmentAlreadyExists):
+		return "student has enrolled before"
+	case errors.Is(err, courseEnrollmentDomain.ErrReEnrollmentCooldown):
+		return "student cancelled this course too recently to re-enroll"
+	case errors.Is(err, courseEnrollmentDomain.ErrReEnrollmentLimitReached):
+		return "student has reached the re-enrollment limit for this course"
+	case errors.Is(err, courseEnrollmentDomain.ErrInvalidStatusTransition):
+		return "enrollment status does not allow this change"
+	case errors.Is(err, termDomain.ErrEnrollmentNotOpen):
+		return "enrollment for this term has not opened yet"
+	case errors.Is(err, termDomain.ErrEnrollmentClosed):
+		return "enrollment for this term has closed"
+	case errors.Is(err, termDomain.ErrDropDeadlinePassed):
+		return "the drop deadline for this term has passed"
+	case errors.Is(err, courseEnrollmentDomain.ErrInvalidCursor):
+		return "invalid page cursor"
+	case errors.Is(err, courseEnrollmentDomain.ErrInvalidGrade):
+		return "grade is not on the grading scale"
+	case errors.Is(err, auth.ErrUnauthenticated):
+		return "authentication required"
+	case errors.Is(err, auth.ErrForbidden):
+		return "permission denied"
+	default:
+		return fallback
+	}
+}
@@ -4,19 +4,48 @@ import (
 	"context"
 	"errors"
 	common "github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/auth"
+	"github/rakadityas/course-management-system/common/testutil"
+	"github/rakadityas/course-management-system/common/transaction"
+	transactionMock "github/rakadityas/course-management-system/common/transaction/mocks"
 	courseDomain "github/rakadityas/course-management-system/domain/course"
 	courseEnrollmentDomain "github/rakadityas/course-management-system/domain/course-enrollment"
 	courseEnrollmentDomainMock "github/rakadityas/course-management-system/domain/course-enrollment/mocks"
 	courseDomainMock "github/rakadityas/course-management-system/domain/course/mocks"
+	instructorDomain "github/rakadityas/course-management-system/domain/instructor"
+	instructorDomainMock "github/rakadityas/course-management-system/domain/instructor/mocks"
+	sectionDomain "github/rakadityas/course-management-system/domain/section"
+	sectionDomainMock "github/rakadityas/course-management-system/domain/section/mocks"
 	studentDomain "github/rakadityas/course-management-system/domain/student"
 	studentDomainMock "github/rakadityas/course-management-system/domain/student/mocks"
+	termDomain "github/rakadityas/course-management-system/domain/term"
+	termDomainMock "github/rakadityas/course-management-system/domain/term/mocks"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 
 	gomock "github.com/golang/mock/gomock"
 )
 
+// studentCtx returns a context authenticated as the student, who may manage only their own enrollments.
+func studentCtx(studentID int64) context.Context {
+	return auth.WithPrincipal(context.Background(), auth.Principal{
+		Subject:     auth.StudentSubject(studentID),
+		StudentID:   studentID,
+		Roles:       []string{auth.RoleStudent},
+		Permissions: []auth.Permission{auth.PermManageOwnEnrollments},
+	})
+}
+
+func registrarCtx() context.Context {
+	return auth.WithPrincipal(context.Background(), auth.Principal{
+		Subject:     "registrar",
+		Roles:       []string{auth.RoleAdmin},
+		Permissions: []auth.Permission{auth.PermManageEnrollments},
+	})
+}
+
 func TestEnrollmentUseCase_CourseSignUp(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -24,6 +53,8 @@ func TestEnrollmentUseCase_CourseSignUp(t *testing.T) {
 	const (
 		studentID int64 = 1
 		courseID  int64 = 101
+		sectionID int64 = 201
+		termID    int64 = 301
 		status          = courseEnrollmentDomain.StatusActive
 	)
 	constCreateTime := time.Date(2023, 8, 25, 0, 0, 0, 0, time.UTC)
@@ -32,6 +63,8 @@ func TestEnrollmentUseCase_CourseSignUp(t *testing.T) {
 	type fields struct {
 		studentService          studentDomain.StudentDomainItf
 		courseService           courseDomain.CourseDomainItf
+		sectionService          sectionDomain.SectionDomainItf
+		termService             termDomain.TermDomainItf
 		courseEnrollmentService courseEnrollmentDomain.CourseEnrollmentDomainItf
 	}
 	type args struct {
@@ -51,17 +84,31 @@ func TestEnrollmentUseCase_CourseSignUp(t *testing.T) {
 				studentService: func() studentDomain.StudentDomainItf {
 					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
 					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
+					mock.EXPECT().LockStudentByID(gomock.Any(), studentID).Return(nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: courseID, TermID: termID}, nil)
+					mock.EXPECT().GetMeetingsBySectionIDs(gomock.Any(), []int64{sectionID}).Return(nil, nil)
+					mock.EXPECT().LockSectionByID(gomock.Any(), sectionID).Return(nil)
 					return mock
 				}(),
 				courseService: func() courseDomain.CourseDomainItf {
 					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
 					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course Name"}, nil)
+					mock.EXPECT().GetPrerequisiteIDs(gomock.Any(), courseID).Return(nil, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2024 Fall"}, nil)
 					return mock
 				}(),
 				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
 					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
 					mock.EXPECT().GetEnrollmentByStudentIDAndCourseID(gomock.Any(), studentID, courseID).Return([]courseEnrollmentDomain.CourseEnrollment{}, nil)
-					mock.EXPECT().CreateEnrollment(gomock.Any(), studentID, courseID, status).Return(courseEnrollmentDomain.CourseEnrollment{
+					mock.EXPECT().CreateEnrollment(gomock.Any(), studentID, courseID, sectionID, status).Return(courseEnrollmentDomain.CourseEnrollment{
 						ID:         1,
 						StudentID:  studentID,
 						CourseID:   courseID,
@@ -73,10 +120,10 @@ func TestEnrollmentUseCase_CourseSignUp(t *testing.T) {
 				}(),
 			},
 			args: args{
-				ctx: context.Background(),
+				ctx: studentCtx(studentID),
 				req: CourseSignUpRequest{
 					StudentID: studentID,
-					CourseID:  courseID,
+					SectionID: sectionID,
 				},
 			},
 			want: CourseSignUpResp{
@@ -87,6 +134,8 @@ func TestEnrollmentUseCase_CourseSignUp(t *testing.T) {
 					StudentEmail: "student@example.com",
 					CourseID:     courseID,
 					CourseName:   "Course Name",
+					SectionID:    sectionID,
+					TermID:       termID,
 					Status:       status,
 					CreateTime:   constCreateTime,
 					UpdateTime:   constUpdateTime,
@@ -94,6 +143,58 @@ func TestEnrollmentUseCase_CourseSignUp(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Another Student",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					return studentDomainMock.NewMockStudentDomainItf(ctrl)
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					return courseDomainMock.NewMockCourseDomainItf(ctrl)
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					return courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(2),
+				req: CourseSignUpRequest{
+					StudentID: studentID,
+					SectionID: sectionID,
+				},
+			},
+			want: CourseSignUpResp{
+				Status:  common.StatusFailure,
+				Message: "permission denied",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Unauthenticated",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					return studentDomainMock.NewMockStudentDomainItf(ctrl)
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					return courseDomainMock.NewMockCourseDomainItf(ctrl)
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					return courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+				}(),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: CourseSignUpRequest{
+					StudentID: studentID,
+					SectionID: sectionID,
+				},
+			},
+			want: CourseSignUpResp{
+				Status:  common.StatusFailure,
+				Message: "authentication required",
+			},
+			wantErr: true,
+		},
 		{
 			name: "Student Not Found",
 			fields: fields{
@@ -110,10 +211,10 @@ func TestEnrollmentUseCase_CourseSignUp(t *testing.T) {
 				}(),
 			},
 			args: args{
-				ctx: context.Background(),
+				ctx: studentCtx(studentID),
 				req: CourseSignUpRequest{
 					StudentID: studentID,
-					CourseID:  courseID,
+					SectionID: sectionID,
 				},
 			},
 			want: CourseSignUpResp{
@@ -123,294 +224,2969 @@ func TestEnrollmentUseCase_CourseSignUp(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "Course Not Found",
+			name: "Section Not Found",
 			fields: fields{
 				studentService: func() studentDomain.StudentDomainItf {
 					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
 					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
 					return mock
 				}(),
-				courseService: func() courseDomain.CourseDomainItf {
-					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
-					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(nil, nil)
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(nil, nil)
 					return mock
 				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					return courseDomainMock.NewMockCourseDomainItf(ctrl)
+				}(),
 				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
 					return courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
 				}(),
 			},
 			args: args{
-				ctx: context.Background(),
+				ctx: studentCtx(studentID),
 				req: CourseSignUpRequest{
 					StudentID: studentID,
-					CourseID:  courseID,
+					SectionID: sectionID,
 				},
 			},
 			want: CourseSignUpResp{
 				Status:  common.StatusFailure,
-				Message: "course data not found",
+				Message: "section data not found",
 			},
 			wantErr: false,
 		},
 		{
-			name: "Enrollment Already Exists",
+			name: "Course Not Found",
 			fields: fields{
 				studentService: func() studentDomain.StudentDomainItf {
 					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
 					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
 					return mock
 				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: courseID, TermID: termID}, nil)
+					return mock
+				}(),
 				courseService: func() courseDomain.CourseDomainItf {
 					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
-					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course Name"}, nil)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(nil, nil)
 					return mock
 				}(),
 				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
-					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
-
-					mock.EXPECT().GetEnrollmentByStudentIDAndCourseID(gomock.Any(), studentID, courseID).Return([]courseEnrollmentDomain.CourseEnrollment{
-						{ID: 1, StudentID: studentID, CourseID: courseID, Status: status}}, nil)
-					return mock
+					return courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
 				}(),
 			},
 			args: args{
-				ctx: context.Background(),
+				ctx: studentCtx(studentID),
 				req: CourseSignUpRequest{
 					StudentID: studentID,
-					CourseID:  courseID,
+					SectionID: sectionID,
 				},
 			},
 			want: CourseSignUpResp{
 				Status:  common.StatusFailure,
-				Message: "student has enrolled before",
+				Message: "course data not found",
 			},
 			wantErr: false,
 		},
 		{
-			name: "Create Enrollment Error",
+			name: "Course Archived",
 			fields: fields{
 				studentService: func() studentDomain.StudentDomainItf {
 					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
 					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
 					return mock
 				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: courseID, TermID: termID}, nil)
+					return mock
+				}(),
 				courseService: func() courseDomain.CourseDomainItf {
 					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
-					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course Name"}, nil)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course Name", ArchiveTime: &constUpdateTime}, nil)
 					return mock
 				}(),
 				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
-					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
-					mock.EXPECT().GetEnrollmentByStudentIDAndCourseID(gomock.Any(), studentID, courseID).Return([]courseEnrollmentDomain.CourseEnrollment{}, nil)
-					mock.EXPECT().CreateEnrollment(gomock.Any(), studentID, courseID, status).Return(courseEnrollmentDomain.CourseEnrollment{}, errors.New("create enrollment error"))
-					return mock
+					return courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
 				}(),
 			},
 			args: args{
-				ctx: context.Background(),
+				ctx: studentCtx(studentID),
 				req: CourseSignUpRequest{
 					StudentID: studentID,
-					CourseID:  courseID,
+					SectionID: sectionID,
 				},
 			},
 			want: CourseSignUpResp{
 				Status:  common.StatusFailure,
-				Message: "failed to sign up course",
+				Message: "course is archived",
 			},
-			wantErr: true,
+			wantErr: false,
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			enrollmentUC := &EnrollmentUseCase{
-				studentService:          tt.fields.studentService,
-				courseService:           tt.fields.courseService,
-				courseEnrollmentService: tt.fields.courseEnrollmentService,
-			}
-			got, err := enrollmentUC.CourseSignUp(tt.args.ctx, tt.args.req)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("EnrollmentUseCase.CourseSignUp() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("EnrollmentUseCase.CourseSignUp() = %v, want %v", got, tt.want)
-			}
-		})
-	}
-}
-
-func TestEnrollmentUseCase_ListCourses(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	const studentID int64 = 1
-	timestamp := time.Date(2023, 8, 25, 0, 0, 0, 0, time.UTC)
-
-	type fields struct {
-		studentService          studentDomain.StudentDomainItf
-		courseService           courseDomain.CourseDomainItf
-		courseEnrollmentService courseEnrollmentDomain.CourseEnrollmentDomainItf
-	}
-	type args struct {
-		ctx       context.Context
-		studentID int64
-	}
-	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		want    ListCoursesResp
-		wantErr bool
-	}{
 		{
-			name: "Success",
+			name: "Enrollment Not Open",
 			fields: fields{
 				studentService: func() studentDomain.StudentDomainItf {
 					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
 					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
 					return mock
 				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: courseID, TermID: termID}, nil)
+					return mock
+				}(),
 				courseService: func() courseDomain.CourseDomainItf {
 					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
-					mock.EXPECT().GetCourseByID(gomock.Any(), int64(101)).Return(&courseDomain.Course{ID: int64(101), Name: "Course Name"}, nil)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course Name"}, nil)
 					return mock
 				}(),
-				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
-					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
-					mock.EXPECT().GetEnrollmentByStudentID(gomock.Any(), studentID).Return([]courseEnrollmentDomain.CourseEnrollment{
-						{
-							ID:         1,
-							StudentID:  studentID,
-							CourseID:   int64(101),
-							Status:     courseEnrollmentDomain.StatusActive,
-							CreateTime: timestamp,
-							UpdateTime: timestamp,
-						},
-					}, nil)
+				termService: func() termDomain.TermDomainItf {
+					openTime := constUpdateTime.Add(time.Hour)
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2024 Fall", EnrollmentOpenTime: &openTime}, nil)
 					return mock
 				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					return courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+				}(),
 			},
 			args: args{
-				ctx:       context.Background(),
-				studentID: studentID,
-			},
-			want: ListCoursesResp{
-				Status: common.StatusSuccess,
-				Courses: []CourseDetail{
-					{
-						CourseID:   int64(101),
-						CourseName: "Course Name",
-						Status:     courseEnrollmentDomain.StatusActive,
-						CreateTime: timestamp,
-						UpdateTime: timestamp,
-					},
+				ctx: studentCtx(studentID),
+				req: CourseSignUpRequest{
+					StudentID: studentID,
+					SectionID: sectionID,
 				},
 			},
-			wantErr: false,
+			want: CourseSignUpResp{
+				Status:  common.StatusFailure,
+				Message: "enrollment for this term has not opened yet",
+			},
+			wantErr: true,
 		},
 		{
-			name: "Failed to Retrieve Enrollments",
+			name: "Enrollment Closed",
 			fields: fields{
 				studentService: func() studentDomain.StudentDomainItf {
 					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
 					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
 					return mock
 				}(),
-				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
-					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
-					mock.EXPECT().GetEnrollmentByStudentID(gomock.Any(), studentID).Return(nil, errors.New("enrollments error"))
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: courseID, TermID: termID}, nil)
 					return mock
 				}(),
 				courseService: func() courseDomain.CourseDomainItf {
-					return courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course Name"}, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					openTime := constUpdateTime.AddDate(0, -1, 0)
+					closeTime := constUpdateTime
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2024 Fall", EnrollmentOpenTime: &openTime, EnrollmentCloseTime: &closeTime}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					return courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
 				}(),
 			},
 			args: args{
-				ctx:       context.Background(),
-				studentID: studentID,
+				ctx: studentCtx(studentID),
+				req: CourseSignUpRequest{
+					StudentID: studentID,
+					SectionID: sectionID,
+				},
 			},
-			want: ListCoursesResp{
+			want: CourseSignUpResp{
 				Status:  common.StatusFailure,
-				Message: "failed to retrieve enrollments",
+				Message: "enrollment for this term has closed",
 			},
 			wantErr: true,
 		},
 		{
-			name: "Failed to Retrieve Course Data",
+			name: "Prerequisites Not Completed",
 			fields: fields{
 				studentService: func() studentDomain.StudentDomainItf {
 					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
 					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
 					return mock
 				}(),
-				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
-					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
-					mock.EXPECT().GetEnrollmentByStudentID(gomock.Any(), studentID).Return([]courseEnrollmentDomain.CourseEnrollment{
-						{
-							ID:         1,
-							StudentID:  studentID,
-							CourseID:   int64(101),
-							Status:     courseEnrollmentDomain.StatusActive,
-							CreateTime: timestamp,
-							UpdateTime: timestamp,
-						},
-					}, nil)
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: courseID, TermID: termID}, nil)
 					return mock
 				}(),
 				courseService: func() courseDomain.CourseDomainItf {
 					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
-					mock.EXPECT().GetCourseByID(gomock.Any(), int64(101)).Return(nil, errors.New("course data error"))
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course Name"}, nil)
+					mock.EXPECT().GetPrerequisiteIDs(gomock.Any(), courseID).Return([]int64{11, 12, 13}, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2024 Fall"}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetEnrollmentByStudentIDAndStatus(gomock.Any(), studentID, courseEnrollmentDomain.StatusCompleted).Return([]courseEnrollmentDomain.CourseEnrollment{
+						{ID: 5, StudentID: studentID, CourseID: 12, Status: courseEnrollmentDomain.StatusCompleted},
+					}, nil)
 					return mock
 				}(),
 			},
 			args: args{
-				ctx:       context.Background(),
-				studentID: studentID,
+				ctx: studentCtx(studentID),
+				req: CourseSignUpRequest{
+					StudentID: studentID,
+					SectionID: sectionID,
+				},
 			},
-			want: ListCoursesResp{
-				Status:  common.StatusFailure,
-				Message: "failed to retrieve course data",
+			want: CourseSignUpResp{
+				Status:                       common.StatusFailure,
+				Message:                      "course prerequisites are not completed",
+				MissingPrerequisiteCourseIDs: []int64{11, 13},
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 		{
-			name: "Course Data Not Found",
+			name: "Prerequisites Completed",
 			fields: fields{
 				studentService: func() studentDomain.StudentDomainItf {
 					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
 					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
+					mock.EXPECT().LockStudentByID(gomock.Any(), studentID).Return(nil)
 					return mock
 				}(),
-				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
-					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
-					mock.EXPECT().GetEnrollmentByStudentID(gomock.Any(), studentID).Return([]courseEnrollmentDomain.CourseEnrollment{
-						{
-							ID:         1,
-							StudentID:  studentID,
-							CourseID:   int64(101),
-							Status:     courseEnrollmentDomain.StatusActive,
-							CreateTime: timestamp,
-							UpdateTime: timestamp,
-						},
-					}, nil)
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: courseID, TermID: termID}, nil)
+					mock.EXPECT().GetMeetingsBySectionIDs(gomock.Any(), []int64{sectionID}).Return(nil, nil)
+					mock.EXPECT().LockSectionByID(gomock.Any(), sectionID).Return(nil)
 					return mock
 				}(),
 				courseService: func() courseDomain.CourseDomainItf {
 					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
-					mock.EXPECT().GetCourseByID(gomock.Any(), int64(101)).Return(nil, nil)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course Name"}, nil)
+					mock.EXPECT().GetPrerequisiteIDs(gomock.Any(), courseID).Return([]int64{11}, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2024 Fall"}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetEnrollmentByStudentIDAndStatus(gomock.Any(), studentID, courseEnrollmentDomain.StatusCompleted).Return([]courseEnrollmentDomain.CourseEnrollment{
+						{ID: 5, StudentID: studentID, CourseID: 11, Status: courseEnrollmentDomain.StatusCompleted},
+					}, nil)
+					mock.EXPECT().GetEnrollmentByStudentIDAndCourseID(gomock.Any(), studentID, courseID).Return([]courseEnrollmentDomain.CourseEnrollment{}, nil)
+					mock.EXPECT().CreateEnrollment(gomock.Any(), studentID, courseID, sectionID, status).Return(courseEnrollmentDomain.CourseEnrollment{
+						ID:         1,
+						StudentID:  studentID,
+						CourseID:   courseID,
+						Status:     status,
+						CreateTime: constCreateTime,
+						UpdateTime: constUpdateTime,
+					}, nil)
 					return mock
 				}(),
 			},
 			args: args{
-				ctx:       context.Background(),
-				studentID: studentID,
-			},
-			want: ListCoursesResp{
-				Status:  common.StatusFailure,
-				Message: "course data is not found for courseID: 101",
+				ctx: studentCtx(studentID),
+				req: CourseSignUpRequest{
+					StudentID: studentID,
+					SectionID: sectionID,
+				},
 			},
-			wantErr: false,
-		},
+			want: CourseSignUpResp{
+				Status: common.StatusSuccess,
+				EnrollmentData: &CourseEnrollment{
+					ID:           1,
+					StudentID:    studentID,
+					StudentEmail: "student@example.com",
+					CourseID:     courseID,
+					CourseName:   "Course Name",
+					SectionID:    sectionID,
+					TermID:       termID,
+					Status:       status,
+					CreateTime:   constCreateTime,
+					UpdateTime:   constUpdateTime,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Schedule Conflict",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
+					mock.EXPECT().LockStudentByID(gomock.Any(), studentID).Return(nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: courseID, TermID: termID}, nil)
+					mock.EXPECT().LockSectionByID(gomock.Any(), sectionID).Return(nil)
+					mock.EXPECT().GetMeetingsBySectionIDs(gomock.Any(), []int64{sectionID}).Return(map[int64][]sectionDomain.Meeting{
+						sectionID: {{SectionID: sectionID, DayOfWeek: sectionDomain.Monday, StartTime: 9 * 60, EndTime: 10*60 + 30}},
+					}, nil)
+					mock.EXPECT().GetSectionsByIDs(gomock.Any(), []int64{202, 203}).Return(map[int64]sectionDomain.Section{
+						202: {ID: 202, CourseID: 102, TermID: termID},
+						203: {ID: 203, CourseID: 103, TermID: termID + 1},
+					}, nil)
+					mock.EXPECT().GetMeetingsBySectionIDs(gomock.Any(), []int64{202}).Return(map[int64][]sectionDomain.Meeting{
+						202: {{SectionID: 202, DayOfWeek: sectionDomain.Monday, StartTime: 10 * 60, EndTime: 11 * 60}},
+					}, nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course Name"}, nil)
+					mock.EXPECT().GetPrerequisiteIDs(gomock.Any(), courseID).Return(nil, nil)
+					mock.EXPECT().GetCourseByID(gomock.Any(), int64(102)).Return(&courseDomain.Course{ID: 102, Name: "Course 102"}, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2024 Fall"}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetEnrollmentByStudentID(gomock.Any(), studentID).Return([]courseEnrollmentDomain.CourseEnrollment{
+						{ID: 2, StudentID: studentID, CourseID: 102, SectionID: 202, Status: courseEnrollmentDomain.StatusActive},
+						{ID: 3, StudentID: studentID, CourseID: 103, SectionID: 203, Status: courseEnrollmentDomain.StatusActive},
+						{ID: 4, StudentID: studentID, CourseID: 104, SectionID: 204, Status: courseEnrollmentDomain.StatusCancelled},
+					}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: CourseSignUpRequest{
+					StudentID: studentID,
+					SectionID: sectionID,
+				},
+			},
+			want: CourseSignUpResp{
+				Status:  common.StatusFailure,
+				Message: "schedule conflicts with an enrolled course",
+				ScheduleConflict: &ScheduleConflict{
+					CourseID:   102,
+					CourseName: "Course 102",
+					SectionID:  202,
+					DayOfWeek:  sectionDomain.Monday,
+					StartTime:  10 * 60,
+					EndTime:    11 * 60,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Meetings Back To Back",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
+					mock.EXPECT().LockStudentByID(gomock.Any(), studentID).Return(nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: courseID, TermID: termID}, nil)
+					mock.EXPECT().GetMeetingsBySectionIDs(gomock.Any(), []int64{sectionID}).Return(map[int64][]sectionDomain.Meeting{
+						sectionID: {{SectionID: sectionID, DayOfWeek: sectionDomain.Monday, StartTime: 9 * 60, EndTime: 10 * 60}},
+					}, nil)
+					mock.EXPECT().GetSectionsByIDs(gomock.Any(), []int64{202}).Return(map[int64]sectionDomain.Section{
+						202: {ID: 202, CourseID: 102, TermID: termID},
+					}, nil)
+					mock.EXPECT().GetMeetingsBySectionIDs(gomock.Any(), []int64{202}).Return(map[int64][]sectionDomain.Meeting{
+						202: {{SectionID: 202, DayOfWeek: sectionDomain.Monday, StartTime: 10 * 60, EndTime: 11 * 60}},
+					}, nil)
+					mock.EXPECT().LockSectionByID(gomock.Any(), sectionID).Return(nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course Name"}, nil)
+					mock.EXPECT().GetPrerequisiteIDs(gomock.Any(), courseID).Return(nil, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2024 Fall"}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetEnrollmentByStudentID(gomock.Any(), studentID).Return([]courseEnrollmentDomain.CourseEnrollment{
+						{ID: 2, StudentID: studentID, CourseID: 102, SectionID: 202, Status: courseEnrollmentDomain.StatusActive},
+					}, nil)
+					mock.EXPECT().GetEnrollmentByStudentIDAndCourseID(gomock.Any(), studentID, courseID).Return([]courseEnrollmentDomain.CourseEnrollment{}, nil)
+					mock.EXPECT().CreateEnrollment(gomock.Any(), studentID, courseID, sectionID, status).Return(courseEnrollmentDomain.CourseEnrollment{
+						ID:         1,
+						StudentID:  studentID,
+						CourseID:   courseID,
+						Status:     status,
+						CreateTime: constCreateTime,
+						UpdateTime: constUpdateTime,
+					}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: CourseSignUpRequest{
+					StudentID: studentID,
+					SectionID: sectionID,
+				},
+			},
+			want: CourseSignUpResp{
+				Status: common.StatusSuccess,
+				EnrollmentData: &CourseEnrollment{
+					ID:           1,
+					StudentID:    studentID,
+					StudentEmail: "student@example.com",
+					CourseID:     courseID,
+					CourseName:   "Course Name",
+					SectionID:    sectionID,
+					TermID:       termID,
+					Status:       status,
+					CreateTime:   constCreateTime,
+					UpdateTime:   constUpdateTime,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Enrollment Already Exists",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
+					mock.EXPECT().LockStudentByID(gomock.Any(), studentID).Return(nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: courseID, TermID: termID}, nil)
+					mock.EXPECT().GetMeetingsBySectionIDs(gomock.Any(), []int64{sectionID}).Return(nil, nil)
+					mock.EXPECT().LockSectionByID(gomock.Any(), sectionID).Return(nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course Name"}, nil)
+					mock.EXPECT().GetPrerequisiteIDs(gomock.Any(), courseID).Return(nil, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2024 Fall"}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+
+					mock.EXPECT().GetEnrollmentByStudentIDAndCourseID(gomock.Any(), studentID, courseID).Return([]courseEnrollmentDomain.CourseEnrollment{
+						{ID: 1, StudentID: studentID, CourseID: courseID, Status: status}}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: CourseSignUpRequest{
+					StudentID: studentID,
+					SectionID: sectionID,
+				},
+			},
+			want: CourseSignUpResp{
+				Status:  common.StatusFailure,
+				Message: "student has enrolled before",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Cancelled Enrollment Re-enrolled",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
+					mock.EXPECT().LockStudentByID(gomock.Any(), studentID).Return(nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: courseID, TermID: termID}, nil)
+					mock.EXPECT().GetMeetingsBySectionIDs(gomock.Any(), []int64{sectionID}).Return(nil, nil)
+					mock.EXPECT().LockSectionByID(gomock.Any(), sectionID).Return(nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course Name"}, nil)
+					mock.EXPECT().GetPrerequisiteIDs(gomock.Any(), courseID).Return(nil, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2024 Fall"}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					cancelled := courseEnrollmentDomain.CourseEnrollment{
+						ID:         1,
+						StudentID:  studentID,
+						CourseID:   courseID,
+						SectionID:  sectionID,
+						Status:     courseEnrollmentDomain.StatusCancelled,
+						CreateTime: constCreateTime,
+						UpdateTime: constCreateTime,
+					}
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetEnrollmentByStudentIDAndCourseID(gomock.Any(), studentID, courseID).Return([]courseEnrollmentDomain.CourseEnrollment{cancelled}, nil)
+					mock.EXPECT().ReEnroll(gomock.Any(), cancelled, courseEnrollmentDomain.StatusActive).Return(courseEnrollmentDomain.CourseEnrollment{
+						ID:            1,
+						StudentID:     studentID,
+						CourseID:      courseID,
+						SectionID:     sectionID,
+						Status:        courseEnrollmentDomain.StatusActive,
+						ReEnrollCount: 1,
+						CreateTime:    constCreateTime,
+						UpdateTime:    constUpdateTime,
+					}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: CourseSignUpRequest{
+					StudentID: studentID,
+					SectionID: sectionID,
+				},
+			},
+			want: CourseSignUpResp{
+				Status: common.StatusSuccess,
+				EnrollmentData: &CourseEnrollment{
+					ID:           1,
+					StudentID:    studentID,
+					StudentEmail: "student@example.com",
+					CourseID:     courseID,
+					CourseName:   "Course Name",
+					SectionID:    sectionID,
+					TermID:       termID,
+					Status:       courseEnrollmentDomain.StatusActive,
+					CreateTime:   constCreateTime,
+					UpdateTime:   constUpdateTime,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Re-enrollment Within Cooldown",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
+					mock.EXPECT().LockStudentByID(gomock.Any(), studentID).Return(nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: courseID, TermID: termID}, nil)
+					mock.EXPECT().GetMeetingsBySectionIDs(gomock.Any(), []int64{sectionID}).Return(nil, nil)
+					mock.EXPECT().LockSectionByID(gomock.Any(), sectionID).Return(nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course Name"}, nil)
+					mock.EXPECT().GetPrerequisiteIDs(gomock.Any(), courseID).Return(nil, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2024 Fall"}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					cancelled := courseEnrollmentDomain.CourseEnrollment{ID: 1, StudentID: studentID, CourseID: courseID, SectionID: sectionID, Status: courseEnrollmentDomain.StatusCancelled}
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetEnrollmentByStudentIDAndCourseID(gomock.Any(), studentID, courseID).Return([]courseEnrollmentDomain.CourseEnrollment{cancelled}, nil)
+					mock.EXPECT().ReEnroll(gomock.Any(), cancelled, courseEnrollmentDomain.StatusActive).Return(courseEnrollmentDomain.CourseEnrollment{}, courseEnrollmentDomain.ErrReEnrollmentCooldown)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: CourseSignUpRequest{
+					StudentID: studentID,
+					SectionID: sectionID,
+				},
+			},
+			want: CourseSignUpResp{
+				Status:  common.StatusFailure,
+				Message: "student cancelled this course too recently to re-enroll",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Course Full Student Waitlisted",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
+					mock.EXPECT().LockStudentByID(gomock.Any(), studentID).Return(nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: courseID, TermID: termID, Capacity: 2}, nil)
+					mock.EXPECT().GetMeetingsBySectionIDs(gomock.Any(), []int64{sectionID}).Return(nil, nil)
+					mock.EXPECT().LockSectionByID(gomock.Any(), sectionID).Return(nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course Name"}, nil)
+					mock.EXPECT().GetPrerequisiteIDs(gomock.Any(), courseID).Return(nil, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2024 Fall"}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetEnrollmentByStudentIDAndCourseID(gomock.Any(), studentID, courseID).Return([]courseEnrollmentDomain.CourseEnrollment{}, nil)
+					mock.EXPECT().CountEnrollmentBySectionIDAndStatus(gomock.Any(), sectionID, courseEnrollmentDomain.StatusActive).Return(2, nil)
+					mock.EXPECT().CountEnrollmentBySectionIDAndStatus(gomock.Any(), sectionID, courseEnrollmentDomain.StatusWaitlisted).Return(4, nil)
+					mock.EXPECT().CreateEnrollment(gomock.Any(), studentID, courseID, sectionID, courseEnrollmentDomain.StatusWaitlisted).Return(courseEnrollmentDomain.CourseEnrollment{
+						ID:         1,
+						StudentID:  studentID,
+						CourseID:   courseID,
+						Status:     courseEnrollmentDomain.StatusWaitlisted,
+						CreateTime: constCreateTime,
+						UpdateTime: constUpdateTime,
+					}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: CourseSignUpRequest{
+					StudentID: studentID,
+					SectionID: sectionID,
+				},
+			},
+			want: CourseSignUpResp{
+				Status:  common.StatusSuccess,
+				Message: "course is full, student has been waitlisted",
+				EnrollmentData: &CourseEnrollment{
+					ID:               1,
+					StudentID:        studentID,
+					StudentEmail:     "student@example.com",
+					CourseID:         courseID,
+					CourseName:       "Course Name",
+					SectionID:        sectionID,
+					TermID:           termID,
+					Status:           courseEnrollmentDomain.StatusWaitlisted,
+					WaitlistPosition: 5,
+					CreateTime:       constCreateTime,
+					UpdateTime:       constUpdateTime,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Course With Free Seat",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
+					mock.EXPECT().LockStudentByID(gomock.Any(), studentID).Return(nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: courseID, TermID: termID, Capacity: 2}, nil)
+					mock.EXPECT().GetMeetingsBySectionIDs(gomock.Any(), []int64{sectionID}).Return(nil, nil)
+					mock.EXPECT().LockSectionByID(gomock.Any(), sectionID).Return(nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course Name"}, nil)
+					mock.EXPECT().GetPrerequisiteIDs(gomock.Any(), courseID).Return(nil, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2024 Fall"}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetEnrollmentByStudentIDAndCourseID(gomock.Any(), studentID, courseID).Return([]courseEnrollmentDomain.CourseEnrollment{}, nil)
+					mock.EXPECT().CountEnrollmentBySectionIDAndStatus(gomock.Any(), sectionID, courseEnrollmentDomain.StatusActive).Return(1, nil)
+					mock.EXPECT().CreateEnrollment(gomock.Any(), studentID, courseID, sectionID, status).Return(courseEnrollmentDomain.CourseEnrollment{
+						ID:         1,
+						StudentID:  studentID,
+						CourseID:   courseID,
+						Status:     status,
+						CreateTime: constCreateTime,
+						UpdateTime: constUpdateTime,
+					}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: CourseSignUpRequest{
+					StudentID: studentID,
+					SectionID: sectionID,
+				},
+			},
+			want: CourseSignUpResp{
+				Status: common.StatusSuccess,
+				EnrollmentData: &CourseEnrollment{
+					ID:           1,
+					StudentID:    studentID,
+					StudentEmail: "student@example.com",
+					CourseID:     courseID,
+					CourseName:   "Course Name",
+					SectionID:    sectionID,
+					TermID:       termID,
+					Status:       status,
+					CreateTime:   constCreateTime,
+					UpdateTime:   constUpdateTime,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Concurrent Duplicate Enrollment",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
+					mock.EXPECT().LockStudentByID(gomock.Any(), studentID).Return(nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: courseID, TermID: termID}, nil)
+					mock.EXPECT().GetMeetingsBySectionIDs(gomock.Any(), []int64{sectionID}).Return(nil, nil)
+					mock.EXPECT().LockSectionByID(gomock.Any(), sectionID).Return(nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course Name"}, nil)
+					mock.EXPECT().GetPrerequisiteIDs(gomock.Any(), courseID).Return(nil, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2024 Fall"}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetEnrollmentByStudentIDAndCourseID(gomock.Any(), studentID, courseID).Return([]courseEnrollmentDomain.CourseEnrollment{}, nil)
+					mock.EXPECT().CreateEnrollment(gomock.Any(), studentID, courseID, sectionID, status).Return(courseEnrollmentDomain.CourseEnrollment{}, courseEnrollmentDomain.ErrEnrollmentAlreadyExists)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: CourseSignUpRequest{
+					StudentID: studentID,
+					SectionID: sectionID,
+				},
+			},
+			want: CourseSignUpResp{
+				Status:  common.StatusFailure,
+				Message: "student has enrolled before",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create Enrollment Error",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
+					mock.EXPECT().LockStudentByID(gomock.Any(), studentID).Return(nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: courseID, TermID: termID}, nil)
+					mock.EXPECT().GetMeetingsBySectionIDs(gomock.Any(), []int64{sectionID}).Return(nil, nil)
+					mock.EXPECT().LockSectionByID(gomock.Any(), sectionID).Return(nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course Name"}, nil)
+					mock.EXPECT().GetPrerequisiteIDs(gomock.Any(), courseID).Return(nil, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2024 Fall"}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetEnrollmentByStudentIDAndCourseID(gomock.Any(), studentID, courseID).Return([]courseEnrollmentDomain.CourseEnrollment{}, nil)
+					mock.EXPECT().CreateEnrollment(gomock.Any(), studentID, courseID, sectionID, status).Return(courseEnrollmentDomain.CourseEnrollment{}, errors.New("create enrollment error"))
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: CourseSignUpRequest{
+					StudentID: studentID,
+					SectionID: sectionID,
+				},
+			},
+			want: CourseSignUpResp{
+				Status:  common.StatusFailure,
+				Message: "failed to sign up course",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enrollmentUC := &EnrollmentUseCase{
+				studentService:          tt.fields.studentService,
+				courseService:           tt.fields.courseService,
+				sectionService:          tt.fields.sectionService,
+				termService:             tt.fields.termService,
+				courseEnrollmentService: tt.fields.courseEnrollmentService,
+				unitOfWork:              testutil.NewPassThroughUnitOfWork(ctrl),
+				features:                Features{Waitlist: true, Prerequisites: true},
+				now:                     func() time.Time { return constUpdateTime },
+			}
+			got, err := enrollmentUC.CourseSignUp(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EnrollmentUseCase.CourseSignUp() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EnrollmentUseCase.CourseSignUp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnrollmentUseCase_CourseSignUp_FeaturesDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const (
+		studentID int64 = 1
+		courseID  int64 = 101
+		sectionID int64 = 201
+		termID    int64 = 301
+	)
+	timestamp := time.Now()
+	type fields struct {
+		courseService           courseDomain.CourseDomainItf
+		sectionService          sectionDomain.SectionDomainItf
+		termService             termDomain.TermDomainItf
+		courseEnrollmentService courseEnrollmentDomain.CourseEnrollmentDomainItf
+		features                Features
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   CourseSignUpResp
+	}{
+		{
+			name: "Course Full Without Waitlist",
+			fields: fields{
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: courseID, TermID: termID, Capacity: 1}, nil)
+					mock.EXPECT().GetMeetingsBySectionIDs(gomock.Any(), []int64{sectionID}).Return(nil, nil)
+					mock.EXPECT().LockSectionByID(gomock.Any(), sectionID).Return(nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course Name"}, nil)
+					mock.EXPECT().GetPrerequisiteIDs(gomock.Any(), courseID).Return(nil, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2024 Fall"}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetEnrollmentByStudentIDAndCourseID(gomock.Any(), studentID, courseID).Return(nil, nil)
+					mock.EXPECT().CountEnrollmentBySectionIDAndStatus(gomock.Any(), sectionID, courseEnrollmentDomain.StatusActive).Return(1, nil)
+					return mock
+				}(),
+				features: Features{Waitlist: false, Prerequisites: true},
+			},
+			want: CourseSignUpResp{Status: common.StatusFailure, Message: "course is full"},
+		},
+		{
+			name: "Prerequisites Not Checked",
+			fields: fields{
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: courseID, TermID: termID}, nil)
+					mock.EXPECT().GetMeetingsBySectionIDs(gomock.Any(), []int64{sectionID}).Return(nil, nil)
+					mock.EXPECT().LockSectionByID(gomock.Any(), sectionID).Return(nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course Name"}, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2024 Fall"}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetEnrollmentByStudentIDAndCourseID(gomock.Any(), studentID, courseID).Return(nil, nil)
+					mock.EXPECT().CreateEnrollment(gomock.Any(), studentID, courseID, sectionID, courseEnrollmentDomain.StatusActive).Return(courseEnrollmentDomain.CourseEnrollment{
+						ID: 1, StudentID: studentID, CourseID: courseID, Status: courseEnrollmentDomain.StatusActive, CreateTime: timestamp, UpdateTime: timestamp,
+					}, nil)
+					return mock
+				}(),
+				features: Features{Waitlist: true, Prerequisites: false},
+			},
+			want: CourseSignUpResp{
+				Status: common.StatusSuccess,
+				EnrollmentData: &CourseEnrollment{
+					ID:           1,
+					StudentID:    studentID,
+					StudentEmail: "student@example.com",
+					CourseID:     courseID,
+					CourseName:   "Course Name",
+					SectionID:    sectionID,
+					TermID:       termID,
+					Status:       courseEnrollmentDomain.StatusActive,
+					CreateTime:   timestamp,
+					UpdateTime:   timestamp,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			studentService := studentDomainMock.NewMockStudentDomainItf(ctrl)
+			studentService.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
+			studentService.EXPECT().LockStudentByID(gomock.Any(), studentID).Return(nil)
+
+			enrollmentUC := &EnrollmentUseCase{
+				studentService:          studentService,
+				courseService:           tt.fields.courseService,
+				sectionService:          tt.fields.sectionService,
+				termService:             tt.fields.termService,
+				courseEnrollmentService: tt.fields.courseEnrollmentService,
+				unitOfWork:              testutil.NewPassThroughUnitOfWork(ctrl),
+				features:                tt.fields.features,
+				now:                     func() time.Time { return timestamp },
+			}
+			got, err := enrollmentUC.CourseSignUp(studentCtx(studentID), CourseSignUpRequest{StudentID: studentID, SectionID: sectionID})
+			if err != nil {
+				t.Fatalf("EnrollmentUseCase.CourseSignUp() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EnrollmentUseCase.CourseSignUp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnrollmentUseCase_CourseSignUp_CreditLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const (
+		studentID int64 = 1
+		courseID  int64 = 101
+		sectionID int64 = 201
+		termID    int64 = 301
+	)
+	timestamp := time.Now()
+
+	// 4 credits active in the term, the active enrollment of another term and the waitlisted one do not count
+	enrolledCourses := func() courseDomain.CourseDomainItf {
+		mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+		mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course Name", CreditHours: 3}, nil)
+		mock.EXPECT().GetCoursesByIDs(gomock.Any(), []int64{102, 103}).Return(map[int64]courseDomain.Course{
+			102: {ID: 102, CreditHours: 4},
+			103: {ID: 103, CreditHours: 3},
+		}, nil)
+		return mock
+	}
+	enrolledSections := func() sectionDomain.SectionDomainItf {
+		mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+		mock.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: courseID, TermID: termID}, nil)
+		mock.EXPECT().GetSectionsByIDs(gomock.Any(), []int64{202, 203}).Return(map[int64]sectionDomain.Section{
+			202: {ID: 202, CourseID: 102, TermID: termID},
+			203: {ID: 203, CourseID: 103, TermID: 302},
+		}, nil)
+		mock.EXPECT().LockSectionByID(gomock.Any(), sectionID).Return(nil)
+		mock.EXPECT().GetMeetingsBySectionIDs(gomock.Any(), []int64{sectionID}).Return(nil, nil)
+		return mock
+	}
+	studentEnrollments := []courseEnrollmentDomain.CourseEnrollment{
+		{ID: 2, StudentID: studentID, CourseID: 102, SectionID: 202, Status: courseEnrollmentDomain.StatusActive},
+		{ID: 3, StudentID: studentID, CourseID: 103, SectionID: 203, Status: courseEnrollmentDomain.StatusActive},
+		{ID: 4, StudentID: studentID, CourseID: 104, SectionID: 204, Status: courseEnrollmentDomain.StatusWaitlisted},
+	}
+
+	type fields struct {
+		courseService           courseDomain.CourseDomainItf
+		sectionService          sectionDomain.SectionDomainItf
+		courseEnrollmentService courseEnrollmentDomain.CourseEnrollmentDomainItf
+		creditLoadPolicy        courseEnrollmentDomain.CreditLoadPolicy
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   CourseSignUpResp
+	}{
+		{
+			name: "Credit Limit Exceeded",
+			fields: fields{
+				courseService:  enrolledCourses(),
+				sectionService: enrolledSections(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetEnrollmentByStudentID(gomock.Any(), studentID).Return(studentEnrollments, nil)
+					return mock
+				}(),
+				creditLoadPolicy: courseEnrollmentDomain.CreditLoadPolicy{MaxCredits: 6, MinFullTimeCredits: 3},
+			},
+			want: CourseSignUpResp{
+				Status:  common.StatusFailure,
+				Message: "course exceeds the credit limit for the term",
+				CreditLoad: &TermCreditLoad{
+					TermID:           termID,
+					TermName:         "2024 Fall",
+					EnrolledCredits:  4,
+					MaxCredits:       6,
+					RemainingCredits: func() *int { remaining := 2; return &remaining }(),
+					FullTime:         true,
+				},
+			},
+		},
+		{
+			name: "Within Credit Limit",
+			fields: fields{
+				courseService:  enrolledCourses(),
+				sectionService: enrolledSections(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetEnrollmentByStudentID(gomock.Any(), studentID).Return(studentEnrollments, nil)
+					mock.EXPECT().GetEnrollmentByStudentIDAndCourseID(gomock.Any(), studentID, courseID).Return(nil, nil)
+					mock.EXPECT().CreateEnrollment(gomock.Any(), studentID, courseID, sectionID, courseEnrollmentDomain.StatusActive).Return(courseEnrollmentDomain.CourseEnrollment{
+						ID: 1, StudentID: studentID, CourseID: courseID, Status: courseEnrollmentDomain.StatusActive, CreateTime: timestamp, UpdateTime: timestamp,
+					}, nil)
+					return mock
+				}(),
+				creditLoadPolicy: courseEnrollmentDomain.CreditLoadPolicy{MaxCredits: 7},
+			},
+			want: CourseSignUpResp{
+				Status: common.StatusSuccess,
+				EnrollmentData: &CourseEnrollment{
+					ID:           1,
+					StudentID:    studentID,
+					StudentEmail: "student@example.com",
+					CourseID:     courseID,
+					CourseName:   "Course Name",
+					SectionID:    sectionID,
+					TermID:       termID,
+					Status:       courseEnrollmentDomain.StatusActive,
+					CreateTime:   timestamp,
+					UpdateTime:   timestamp,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			studentService := studentDomainMock.NewMockStudentDomainItf(ctrl)
+			studentService.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
+			studentService.EXPECT().LockStudentByID(gomock.Any(), studentID).Return(nil)
+			termService := termDomainMock.NewMockTermDomainItf(ctrl)
+			termService.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2024 Fall"}, nil)
+
+			enrollmentUC := &EnrollmentUseCase{
+				studentService:          studentService,
+				courseService:           tt.fields.courseService,
+				sectionService:          tt.fields.sectionService,
+				termService:             termService,
+				courseEnrollmentService: tt.fields.courseEnrollmentService,
+				unitOfWork:              testutil.NewPassThroughUnitOfWork(ctrl),
+				features:                Features{Waitlist: true},
+				creditLoadPolicy:        tt.fields.creditLoadPolicy,
+				now:                     func() time.Time { return timestamp },
+			}
+			got, err := enrollmentUC.CourseSignUp(studentCtx(studentID), CourseSignUpRequest{StudentID: studentID, SectionID: sectionID})
+			if err != nil {
+				t.Fatalf("EnrollmentUseCase.CourseSignUp() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EnrollmentUseCase.CourseSignUp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnrollmentUseCase_CourseSignUp_Concurrent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const (
+		studentID int64 = 1
+		courseID  int64 = 101
+		termID    int64 = 301
+	)
+	timestamp := time.Now()
+	sectionIDs := []int64{201, 202}
+
+	studentLock := &sync.Mutex{}
+	studentService := studentDomainMock.NewMockStudentDomainItf(ctrl)
+	studentService.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil).Times(2)
+	studentService.EXPECT().LockStudentByID(gomock.Any(), studentID).DoAndReturn(func(ctx context.Context, id int64) error {
+		lockRow(ctx, studentLock)
+		return nil
+	}).Times(2)
+
+	sectionService := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+	for _, sectionID := range sectionIDs {
+		sectionService.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: courseID, TermID: termID}, nil)
+		sectionService.EXPECT().GetMeetingsBySectionIDs(gomock.Any(), []int64{sectionID}).Return(nil, nil)
+		sectionService.EXPECT().LockSectionByID(gomock.Any(), sectionID).Return(nil)
+	}
+	courseService := courseDomainMock.NewMockCourseDomainItf(ctrl)
+	courseService.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course Name"}, nil).Times(2)
+	termService := termDomainMock.NewMockTermDomainItf(ctrl)
+	termService.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2024 Fall"}, nil).Times(2)
+
+	// the enrollments table, a sign-up reads it and pauses so that an unserialized sign-up would read the same rows
+	var (
+		enrollmentsMu sync.Mutex
+		enrollments   []courseEnrollmentDomain.CourseEnrollment
+	)
+	courseEnrollmentService := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+	courseEnrollmentService.EXPECT().GetEnrollmentByStudentIDAndCourseID(gomock.Any(), studentID, courseID).DoAndReturn(func(ctx context.Context, studentID, courseID int64) ([]courseEnrollmentDomain.CourseEnrollment, error) {
+		enrollmentsMu.Lock()
+		found := append([]courseEnrollmentDomain.CourseEnrollment(nil), enrollments...)
+		enrollmentsMu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		return found, nil
+	}).Times(2)
+	courseEnrollmentService.EXPECT().CreateEnrollment(gomock.Any(), studentID, courseID, gomock.Any(), courseEnrollmentDomain.StatusActive).DoAndReturn(func(ctx context.Context, studentID, courseID, sectionID int64, status courseEnrollmentDomain.EnrollmentStatus) (courseEnrollmentDomain.CourseEnrollment, error) {
+		enrollmentsMu.Lock()
+		defer enrollmentsMu.Unlock()
+		enrollment := courseEnrollmentDomain.CourseEnrollment{ID: int64(len(enrollments) + 1), StudentID: studentID, CourseID: courseID, SectionID: sectionID, Status: status, CreateTime: timestamp, UpdateTime: timestamp}
+		enrollments = append(enrollments, enrollment)
+		return enrollment, nil
+	}).MaxTimes(2)
+
+	enrollmentUC := &EnrollmentUseCase{
+		studentService:          studentService,
+		courseService:           courseService,
+		sectionService:          sectionService,
+		termService:             termService,
+		courseEnrollmentService: courseEnrollmentService,
+		unitOfWork:              newRowLockingUnitOfWork(ctrl),
+		now:                     func() time.Time { return timestamp },
+	}
+
+	// sign the student up for both sections of the course at once
+	resps := make([]CourseSignUpResp, len(sectionIDs))
+	var wg sync.WaitGroup
+	for i, sectionID := range sectionIDs {
+		wg.Add(1)
+		go func(i int, sectionID int64) {
+			defer wg.Done()
+			resp, err := enrollmentUC.CourseSignUp(studentCtx(studentID), CourseSignUpRequest{StudentID: studentID, SectionID: sectionID})
+			if err != nil {
+				t.Errorf("EnrollmentUseCase.CourseSignUp() error = %v", err)
+			}
+			resps[i] = resp
+		}(i, sectionID)
+	}
+	wg.Wait()
+
+	var succeeded, rejected int
+	for _, resp := range resps {
+		switch {
+		case resp.Status == common.StatusSuccess:
+			succeeded++
+		case resp.Message == "student has enrolled before":
+			rejected++
+		}
+	}
+	if succeeded != 1 || rejected != 1 {
+		t.Errorf("EnrollmentUseCase.CourseSignUp() = %v, want one sign-up to succeed and the other to be rejected", resps)
+	}
+	if len(enrollments) != 1 {
+		t.Errorf("enrollments = %v, want a single enrollment in the course", enrollments)
+	}
+}
+
+func TestEnrollmentUseCase_ListCourses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const studentID int64 = 1
+	timestamp := time.Date(2023, 8, 25, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		studentService          studentDomain.StudentDomainItf
+		courseService           courseDomain.CourseDomainItf
+		sectionService          sectionDomain.SectionDomainItf
+		termService             termDomain.TermDomainItf
+		courseEnrollmentService courseEnrollmentDomain.CourseEnrollmentDomainItf
+		instructorService       instructorDomain.InstructorDomainItf
+		creditLoadPolicy        courseEnrollmentDomain.CreditLoadPolicy
+	}
+	type args struct {
+		ctx context.Context
+		req ListCoursesRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    ListCoursesResp
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCoursesByIDs(gomock.Any(), []int64{101}).Return(map[int64]courseDomain.Course{101: {ID: 101, Name: "Course Name"}}, nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionsByIDs(gomock.Any(), []int64{201}).Return(map[int64]sectionDomain.Section{201: {ID: 201, CourseID: 101, TermID: 301}}, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermsByIDs(gomock.Any(), []int64{301}).Return(map[int64]termDomain.Term{301: {ID: 301, Name: "2024 Fall"}}, nil)
+					return mock
+				}(),
+				instructorService: func() instructorDomain.InstructorDomainItf {
+					mock := instructorDomainMock.NewMockInstructorDomainItf(ctrl)
+					mock.EXPECT().GetInstructorsByCourseIDs(gomock.Any(), []int64{101}).Return(map[int64][]instructorDomain.Instructor{
+						101: {{ID: 1, Name: "Ada Lovelace"}, {ID: 2, Name: "Alan Turing"}},
+					}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().ListEnrollmentsByStudentID(gomock.Any(), studentID, defaultListQuery).Return([]courseEnrollmentDomain.CourseEnrollment{
+						{
+							ID:         1,
+							StudentID:  studentID,
+							CourseID:   int64(101),
+							SectionID:  201,
+							Status:     courseEnrollmentDomain.StatusActive,
+							CreateTime: timestamp,
+							UpdateTime: timestamp,
+						},
+					}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: ListCoursesRequest{StudentID: studentID},
+			},
+			want: ListCoursesResp{
+				Status: common.StatusSuccess,
+				Courses: []CourseDetail{
+					{
+						CourseID:    int64(101),
+						CourseName:  "Course Name",
+						SectionID:   201,
+						TermID:      301,
+						TermName:    "2024 Fall",
+						Instructors: []string{"Ada Lovelace", "Alan Turing"},
+						Status:      courseEnrollmentDomain.StatusActive,
+						CreateTime:  timestamp,
+						UpdateTime:  timestamp,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Failed to Retrieve Enrollments",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().ListEnrollmentsByStudentID(gomock.Any(), studentID, defaultListQuery).Return(nil, errors.New("enrollments error"))
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					return courseDomainMock.NewMockCourseDomainItf(ctrl)
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: ListCoursesRequest{StudentID: studentID},
+			},
+			want: ListCoursesResp{
+				Status:  common.StatusFailure,
+				Message: "failed to retrieve enrollments",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Failed to Retrieve Course Data",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().ListEnrollmentsByStudentID(gomock.Any(), studentID, defaultListQuery).Return([]courseEnrollmentDomain.CourseEnrollment{
+						{
+							ID:         1,
+							StudentID:  studentID,
+							CourseID:   int64(101),
+							SectionID:  201,
+							Status:     courseEnrollmentDomain.StatusActive,
+							CreateTime: timestamp,
+							UpdateTime: timestamp,
+						},
+					}, nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCoursesByIDs(gomock.Any(), []int64{101}).Return(nil, errors.New("course data error"))
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: ListCoursesRequest{StudentID: studentID},
+			},
+			want: ListCoursesResp{
+				Status:  common.StatusFailure,
+				Message: "failed to retrieve course data",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Course Data Not Found",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().ListEnrollmentsByStudentID(gomock.Any(), studentID, defaultListQuery).Return([]courseEnrollmentDomain.CourseEnrollment{
+						{
+							ID:         1,
+							StudentID:  studentID,
+							CourseID:   int64(101),
+							SectionID:  201,
+							Status:     courseEnrollmentDomain.StatusActive,
+							CreateTime: timestamp,
+							UpdateTime: timestamp,
+						},
+					}, nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCoursesByIDs(gomock.Any(), []int64{101}).Return(map[int64]courseDomain.Course{}, nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionsByIDs(gomock.Any(), []int64{201}).Return(map[int64]sectionDomain.Section{201: {ID: 201, CourseID: 101, TermID: 301}}, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermsByIDs(gomock.Any(), []int64{301}).Return(map[int64]termDomain.Term{301: {ID: 301, Name: "2024 Fall"}}, nil)
+					return mock
+				}(),
+				instructorService: func() instructorDomain.InstructorDomainItf {
+					mock := instructorDomainMock.NewMockInstructorDomainItf(ctrl)
+					mock.EXPECT().GetInstructorsByCourseIDs(gomock.Any(), []int64{101}).Return(map[int64][]instructorDomain.Instructor{}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: ListCoursesRequest{StudentID: studentID},
+			},
+			want: ListCoursesResp{
+				Status:  common.StatusFailure,
+				Message: "course data is not found for courseID: 101",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Next Page Cursor",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCoursesByIDs(gomock.Any(), []int64{101}).Return(map[int64]courseDomain.Course{101: {ID: 101, Name: "Biology"}}, nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionsByIDs(gomock.Any(), []int64{201}).Return(map[int64]sectionDomain.Section{201: {ID: 201, CourseID: 101, TermID: 301}}, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermsByIDs(gomock.Any(), []int64{301}).Return(map[int64]termDomain.Term{301: {ID: 301, Name: "2024 Fall"}}, nil)
+					return mock
+				}(),
+				instructorService: func() instructorDomain.InstructorDomainItf {
+					mock := instructorDomainMock.NewMockInstructorDomainItf(ctrl)
+					mock.EXPECT().GetInstructorsByCourseIDs(gomock.Any(), []int64{101}).Return(map[int64][]instructorDomain.Instructor{}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().ListEnrollmentsByStudentID(gomock.Any(), studentID, courseEnrollmentDomain.EnrollmentListQuery{
+						Statuses: []courseEnrollmentDomain.EnrollmentStatus{courseEnrollmentDomain.StatusActive},
+						SortBy:   courseEnrollmentDomain.SortByName,
+						Limit:    2,
+					}).Return([]courseEnrollmentDomain.CourseEnrollment{
+						{ID: 1, StudentID: studentID, CourseID: 101, SectionID: 201, Status: courseEnrollmentDomain.StatusActive, CreateTime: timestamp, UpdateTime: timestamp},
+						{ID: 2, StudentID: studentID, CourseID: 102, SectionID: 202, Status: courseEnrollmentDomain.StatusActive, CreateTime: timestamp, UpdateTime: timestamp},
+					}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: ListCoursesRequest{
+					StudentID: studentID,
+					ListOptions: ListOptions{
+						Statuses: []courseEnrollmentDomain.EnrollmentStatus{courseEnrollmentDomain.StatusActive},
+						SortBy:   courseEnrollmentDomain.SortByName,
+						Limit:    1,
+					},
+				},
+			},
+			want: ListCoursesResp{
+				Status: common.StatusSuccess,
+				Courses: []CourseDetail{
+					{CourseID: 101, CourseName: "Biology", SectionID: 201, TermID: 301, TermName: "2024 Fall", Status: courseEnrollmentDomain.StatusActive, CreateTime: timestamp, UpdateTime: timestamp},
+				},
+				NextCursor: courseEnrollmentDomain.EnrollmentCursor{SortBy: courseEnrollmentDomain.SortByName, CourseName: "Biology", ID: 1}.Encode(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Credit Loads",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCoursesByIDs(gomock.Any(), []int64{101}).Return(map[int64]courseDomain.Course{101: {ID: 101, Name: "Course Name", CreditHours: 4}}, nil)
+					mock.EXPECT().GetCoursesByIDs(gomock.Any(), []int64{101, 102}).Return(map[int64]courseDomain.Course{
+						101: {ID: 101, CreditHours: 4},
+						102: {ID: 102, CreditHours: 3},
+					}, nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionsByIDs(gomock.Any(), []int64{201}).Return(map[int64]sectionDomain.Section{201: {ID: 201, CourseID: 101, TermID: 301}}, nil)
+					mock.EXPECT().GetSectionsByIDs(gomock.Any(), []int64{201, 202}).Return(map[int64]sectionDomain.Section{
+						201: {ID: 201, CourseID: 101, TermID: 301},
+						202: {ID: 202, CourseID: 102, TermID: 302},
+					}, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermsByIDs(gomock.Any(), []int64{301}).Return(map[int64]termDomain.Term{301: {ID: 301, Name: "2024 Fall"}}, nil)
+					mock.EXPECT().GetTermsByIDs(gomock.Any(), []int64{301, 302}).Return(map[int64]termDomain.Term{
+						301: {ID: 301, Name: "2024 Fall", StartDate: time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)},
+						302: {ID: 302, Name: "2024 Spring", StartDate: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+					}, nil)
+					return mock
+				}(),
+				instructorService: func() instructorDomain.InstructorDomainItf {
+					mock := instructorDomainMock.NewMockInstructorDomainItf(ctrl)
+					mock.EXPECT().GetInstructorsByCourseIDs(gomock.Any(), []int64{101}).Return(map[int64][]instructorDomain.Instructor{}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().ListEnrollmentsByStudentID(gomock.Any(), studentID, defaultListQuery).Return([]courseEnrollmentDomain.CourseEnrollment{
+						{ID: 1, StudentID: studentID, CourseID: 101, SectionID: 201, Status: courseEnrollmentDomain.StatusActive, CreateTime: timestamp, UpdateTime: timestamp},
+					}, nil)
+					mock.EXPECT().GetEnrollmentByStudentID(gomock.Any(), studentID).Return([]courseEnrollmentDomain.CourseEnrollment{
+						{ID: 1, StudentID: studentID, CourseID: 101, SectionID: 201, Status: courseEnrollmentDomain.StatusActive},
+						{ID: 2, StudentID: studentID, CourseID: 102, SectionID: 202, Status: courseEnrollmentDomain.StatusActive},
+						{ID: 3, StudentID: studentID, CourseID: 103, SectionID: 203, Status: courseEnrollmentDomain.StatusCancelled},
+					}, nil)
+					return mock
+				}(),
+				creditLoadPolicy: courseEnrollmentDomain.CreditLoadPolicy{MaxCredits: 6, MinFullTimeCredits: 4},
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: ListCoursesRequest{StudentID: studentID},
+			},
+			want: ListCoursesResp{
+				Status: common.StatusSuccess,
+				Courses: []CourseDetail{
+					{CourseID: 101, CourseName: "Course Name", CreditHours: 4, SectionID: 201, TermID: 301, TermName: "2024 Fall", Status: courseEnrollmentDomain.StatusActive, CreateTime: timestamp, UpdateTime: timestamp},
+				},
+				CreditLoads: []TermCreditLoad{
+					{TermID: 302, TermName: "2024 Spring", EnrolledCredits: 3, MaxCredits: 6, RemainingCredits: func() *int { remaining := 3; return &remaining }()},
+					{TermID: 301, TermName: "2024 Fall", EnrolledCredits: 4, MaxCredits: 6, RemainingCredits: func() *int { remaining := 2; return &remaining }(), FullTime: true},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Invalid Cursor",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "student@example.com"}, nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					return courseDomainMock.NewMockCourseDomainItf(ctrl)
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					return courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: ListCoursesRequest{StudentID: studentID, ListOptions: ListOptions{Cursor: "not-a-cursor"}},
+			},
+			want: ListCoursesResp{
+				Status:  common.StatusFailure,
+				Message: "invalid page cursor",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enrollmentUC := &EnrollmentUseCase{
+				studentService:          tt.fields.studentService,
+				courseService:           tt.fields.courseService,
+				sectionService:          tt.fields.sectionService,
+				termService:             tt.fields.termService,
+				courseEnrollmentService: tt.fields.courseEnrollmentService,
+				instructorService:       tt.fields.instructorService,
+				creditLoadPolicy:        tt.fields.creditLoadPolicy,
+			}
+			got, err := enrollmentUC.ListCourses(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EnrollmentUseCase.ListCourses() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EnrollmentUseCase.ListCourses() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnrollmentUseCase_CancelCourse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const (
+		studentID int64 = 1
+		courseID  int64 = 101
+		sectionID int64 = 201
+		termID    int64 = 301
+	)
+	dropDeadline := time.Date(2024, 9, 20, 0, 0, 0, 0, time.UTC)
+	beforeDeadline := dropDeadline.Add(-time.Second)
+	activeEnrollment := courseEnrollmentDomain.CourseEnrollment{ID: 1, StudentID: studentID, CourseID: courseID, SectionID: sectionID, Status: courseEnrollmentDomain.StatusActive}
+
+	sectionInTerm := func() sectionDomain.SectionDomainItf {
+		mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+		mock.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: courseID, TermID: termID}, nil)
+		return mock
+	}
+	termWithDropDeadline := func() termDomain.TermDomainItf {
+		mock := termDomainMock.NewMockTermDomainItf(ctrl)
+		mock.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2024 Fall", DropDeadline: &dropDeadline}, nil)
+		return mock
+	}
+
+	type fields struct {
+		sectionService          sectionDomain.SectionDomainItf
+		termService             termDomain.TermDomainItf
+		courseEnrollmentService courseEnrollmentDomain.CourseEnrollmentDomainItf
+		unitOfWork              transaction.UnitOfWork
+		now                     time.Time
+	}
+	type args struct {
+		ctx       context.Context
+		studentID int64
+		sectionID int64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    CancelCourseResp
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				sectionService: sectionInTerm(),
+				termService:    termWithDropDeadline(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().LockEnrollmentByStudentIDAndSectionID(gomock.Any(), studentID, sectionID).Return(&activeEnrollment, nil)
+					mock.EXPECT().CancelEnrollment(gomock.Any(), activeEnrollment).Return(courseEnrollmentDomain.CourseEnrollment{}, nil)
+					mock.EXPECT().GetWaitlistBySectionID(gomock.Any(), sectionID).Return(nil, nil)
+					return mock
+				}(),
+				unitOfWork: testutil.NewPassThroughUnitOfWork(ctrl),
+				now:        beforeDeadline,
+			},
+			args: args{
+				ctx:       studentCtx(studentID),
+				studentID: studentID,
+				sectionID: sectionID,
+			},
+			want: CancelCourseResp{
+				Status: common.StatusSuccess,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Drop Deadline Passed",
+			fields: fields{
+				sectionService: sectionInTerm(),
+				termService:    termWithDropDeadline(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().LockEnrollmentByStudentIDAndSectionID(gomock.Any(), studentID, sectionID).Return(&activeEnrollment, nil)
+					return mock
+				}(),
+				unitOfWork: testutil.NewPassThroughUnitOfWork(ctrl),
+				now:        dropDeadline,
+			},
+			args: args{
+				ctx:       studentCtx(studentID),
+				studentID: studentID,
+				sectionID: sectionID,
+			},
+			want: CancelCourseResp{
+				Status:  common.StatusFailure,
+				Message: "the drop deadline for this term has passed",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Waitlisted Enrollment After Drop Deadline",
+			fields: fields{
+				sectionService: sectionDomainMock.NewMockSectionDomainItf(ctrl),
+				termService:    termDomainMock.NewMockTermDomainItf(ctrl),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					waitlisted := activeEnrollment
+					waitlisted.Status = courseEnrollmentDomain.StatusWaitlisted
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().LockEnrollmentByStudentIDAndSectionID(gomock.Any(), studentID, sectionID).Return(&waitlisted, nil)
+					mock.EXPECT().CancelEnrollment(gomock.Any(), waitlisted).Return(courseEnrollmentDomain.CourseEnrollment{}, nil)
+					return mock
+				}(),
+				unitOfWork: testutil.NewPassThroughUnitOfWork(ctrl),
+				now:        dropDeadline.AddDate(0, 1, 0),
+			},
+			args: args{
+				ctx:       studentCtx(studentID),
+				studentID: studentID,
+				sectionID: sectionID,
+			},
+			want: CancelCourseResp{
+				Status: common.StatusSuccess,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Failed to Cancel Course Enrollment",
+			fields: fields{
+				sectionService: sectionInTerm(),
+				termService:    termWithDropDeadline(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().LockEnrollmentByStudentIDAndSectionID(gomock.Any(), studentID, sectionID).Return(&activeEnrollment, nil)
+					mock.EXPECT().CancelEnrollment(gomock.Any(), activeEnrollment).Return(courseEnrollmentDomain.CourseEnrollment{}, errors.New("update error"))
+					return mock
+				}(),
+				unitOfWork: testutil.NewPassThroughUnitOfWork(ctrl),
+				now:        beforeDeadline,
+			},
+			args: args{
+				ctx:       studentCtx(studentID),
+				studentID: studentID,
+				sectionID: sectionID,
+			},
+			want: CancelCourseResp{
+				Status:  common.StatusFailure,
+				Message: "failed to cancel course enrollment",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Completed Enrollment Cannot Be Cancelled",
+			fields: fields{
+				sectionService: sectionDomainMock.NewMockSectionDomainItf(ctrl),
+				termService:    termDomainMock.NewMockTermDomainItf(ctrl),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					completed := activeEnrollment
+					completed.Status = courseEnrollmentDomain.StatusCompleted
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().LockEnrollmentByStudentIDAndSectionID(gomock.Any(), studentID, sectionID).Return(&completed, nil)
+					mock.EXPECT().CancelEnrollment(gomock.Any(), completed).Return(courseEnrollmentDomain.CourseEnrollment{}, courseEnrollmentDomain.ErrInvalidStatusTransition)
+					return mock
+				}(),
+				unitOfWork: testutil.NewPassThroughUnitOfWork(ctrl),
+				now:        beforeDeadline,
+			},
+			args: args{
+				ctx:       studentCtx(studentID),
+				studentID: studentID,
+				sectionID: sectionID,
+			},
+			want: CancelCourseResp{
+				Status:  common.StatusFailure,
+				Message: "enrollment status does not allow this change",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Enrollment Not Found",
+			fields: fields{
+				sectionService: sectionDomainMock.NewMockSectionDomainItf(ctrl),
+				termService:    termDomainMock.NewMockTermDomainItf(ctrl),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().LockEnrollmentByStudentIDAndSectionID(gomock.Any(), studentID, sectionID).Return(nil, nil)
+					return mock
+				}(),
+				unitOfWork: testutil.NewPassThroughUnitOfWork(ctrl),
+				now:        beforeDeadline,
+			},
+			args: args{
+				ctx:       studentCtx(studentID),
+				studentID: studentID,
+				sectionID: sectionID,
+			},
+			want: CancelCourseResp{
+				Status:  common.StatusFailure,
+				Message: "course enrollment not found",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Another Student",
+			fields: fields{
+				sectionService:          sectionDomainMock.NewMockSectionDomainItf(ctrl),
+				termService:             termDomainMock.NewMockTermDomainItf(ctrl),
+				courseEnrollmentService: courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl),
+				now:                     beforeDeadline,
+			},
+			args: args{
+				ctx:       studentCtx(2),
+				studentID: studentID,
+				sectionID: sectionID,
+			},
+			want: CancelCourseResp{
+				Status:  common.StatusFailure,
+				Message: "permission denied",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Admin Cancels For Student",
+			fields: fields{
+				sectionService: sectionInTerm(),
+				termService:    termWithDropDeadline(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().LockEnrollmentByStudentIDAndSectionID(gomock.Any(), studentID, sectionID).Return(&activeEnrollment, nil)
+					mock.EXPECT().CancelEnrollment(gomock.Any(), activeEnrollment).Return(courseEnrollmentDomain.CourseEnrollment{}, nil)
+					mock.EXPECT().GetWaitlistBySectionID(gomock.Any(), sectionID).Return(nil, nil)
+					return mock
+				}(),
+				unitOfWork: testutil.NewPassThroughUnitOfWork(ctrl),
+				now:        beforeDeadline,
+			},
+			args: args{
+				ctx: auth.WithPrincipal(context.Background(), auth.Principal{
+					Subject:     "admin",
+					Roles:       []string{auth.RoleAdmin},
+					Permissions: []auth.Permission{auth.PermManageEnrollments},
+				}),
+				studentID: studentID,
+				sectionID: sectionID,
+			},
+			want: CancelCourseResp{
+				Status: common.StatusSuccess,
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := tt.fields.now
+			enrollmentUC := &EnrollmentUseCase{
+				sectionService:          tt.fields.sectionService,
+				termService:             tt.fields.termService,
+				courseEnrollmentService: tt.fields.courseEnrollmentService,
+				unitOfWork:              tt.fields.unitOfWork,
+				now:                     func() time.Time { return now },
+			}
+			got, err := enrollmentUC.CancelCourse(tt.args.ctx, tt.args.studentID, tt.args.sectionID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EnrollmentUseCase.CancelCourse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EnrollmentUseCase.CancelCourse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnrollmentUseCase_CancelCourse_WaitlistPromotion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const (
+		studentID int64 = 1
+		courseID  int64 = 101
+		sectionID int64 = 201
+		termID    int64 = 301
+	)
+	cancelled := courseEnrollmentDomain.CourseEnrollment{ID: 11, StudentID: studentID, CourseID: courseID, SectionID: sectionID, Status: courseEnrollmentDomain.StatusActive}
+	firstInLine := courseEnrollmentDomain.CourseEnrollment{ID: 12, StudentID: 2, CourseID: courseID, SectionID: sectionID, Status: courseEnrollmentDomain.StatusWaitlisted}
+	secondInLine := courseEnrollmentDomain.CourseEnrollment{ID: 13, StudentID: 3, CourseID: courseID, SectionID: sectionID, Status: courseEnrollmentDomain.StatusWaitlisted}
+	sectionMeeting := sectionDomain.Meeting{SectionID: sectionID, DayOfWeek: sectionDomain.Monday, StartTime: 9 * 60, EndTime: 10*60 + 30}
+	// the first student in line already takes a three credit course in section 202, on Monday from 10:00
+	otherEnrollment := courseEnrollmentDomain.CourseEnrollment{ID: 14, StudentID: 2, CourseID: 102, SectionID: 202, Status: courseEnrollmentDomain.StatusActive}
+	otherSection := sectionDomain.Section{ID: 202, CourseID: 102, TermID: termID}
+	otherMeeting := sectionDomain.Meeting{SectionID: 202, DayOfWeek: sectionDomain.Monday, StartTime: 10 * 60, EndTime: 11 * 60}
+
+	// newSectionService expects the cancelled section to be read and returns meetings for it
+	newSectionService := func(meetings []sectionDomain.Meeting) *sectionDomainMock.MockSectionDomainItf {
+		mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+		mock.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: courseID, TermID: termID}, nil).Times(2)
+		mock.EXPECT().GetMeetingsBySectionIDs(gomock.Any(), []int64{sectionID}).Return(map[int64][]sectionDomain.Meeting{sectionID: meetings}, nil).AnyTimes()
+		return mock
+	}
+	// newCourseEnrollmentService expects the cancellation, the waitlist read and the promotion of promoted
+	newCourseEnrollmentService := func(promoted courseEnrollmentDomain.CourseEnrollment) *courseEnrollmentDomainMock.MockCourseEnrollmentDomainItf {
+		mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+		mock.EXPECT().LockEnrollmentByStudentIDAndSectionID(gomock.Any(), studentID, sectionID).Return(&cancelled, nil)
+		mock.EXPECT().CancelEnrollment(gomock.Any(), cancelled).Return(courseEnrollmentDomain.CourseEnrollment{}, nil)
+		mock.EXPECT().GetWaitlistBySectionID(gomock.Any(), sectionID).Return([]courseEnrollmentDomain.CourseEnrollment{firstInLine, secondInLine}, nil)
+		mock.EXPECT().PromoteEnrollment(gomock.Any(), promoted).Return(courseEnrollmentDomain.CourseEnrollment{}, nil)
+		return mock
+	}
+	courseExists := func() courseDomain.CourseDomainItf {
+		mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+		mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course A", CreditHours: 3}, nil)
+		return mock
+	}
+	locksStudents := func(studentIDs ...int64) studentDomain.StudentDomainItf {
+		mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+		for _, id := range studentIDs {
+			mock.EXPECT().LockStudentByID(gomock.Any(), id).Return(nil)
+		}
+		return mock
+	}
+
+	type fields struct {
+		studentService          studentDomain.StudentDomainItf
+		courseService           courseDomain.CourseDomainItf
+		sectionService          sectionDomain.SectionDomainItf
+		courseEnrollmentService courseEnrollmentDomain.CourseEnrollmentDomainItf
+		creditLoadPolicy        courseEnrollmentDomain.CreditLoadPolicy
+	}
+	tests := []struct {
+		name   string
+		fields fields
+	}{
+		{
+			name: "First In Line Promoted",
+			fields: fields{
+				studentService:          locksStudents(2),
+				courseService:           courseExists(),
+				sectionService:          newSectionService(nil),
+				courseEnrollmentService: newCourseEnrollmentService(firstInLine),
+			},
+		},
+		{
+			name: "Schedule Conflict Stays Waitlisted",
+			fields: fields{
+				studentService: locksStudents(2, 3),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseExists().(*courseDomainMock.MockCourseDomainItf)
+					mock.EXPECT().GetCourseByID(gomock.Any(), int64(102)).Return(&courseDomain.Course{ID: 102, Name: "Course B"}, nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := newSectionService([]sectionDomain.Meeting{sectionMeeting})
+					mock.EXPECT().GetSectionsByIDs(gomock.Any(), []int64{202}).Return(map[int64]sectionDomain.Section{202: otherSection}, nil)
+					mock.EXPECT().GetMeetingsBySectionIDs(gomock.Any(), []int64{202}).Return(map[int64][]sectionDomain.Meeting{202: {otherMeeting}}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := newCourseEnrollmentService(secondInLine)
+					mock.EXPECT().GetEnrollmentByStudentID(gomock.Any(), int64(2)).Return([]courseEnrollmentDomain.CourseEnrollment{otherEnrollment}, nil)
+					mock.EXPECT().GetEnrollmentByStudentID(gomock.Any(), int64(3)).Return(nil, nil)
+					return mock
+				}(),
+			},
+		},
+		{
+			name: "Credit Limit Stays Waitlisted",
+			fields: fields{
+				studentService: locksStudents(2, 3),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseExists().(*courseDomainMock.MockCourseDomainItf)
+					mock.EXPECT().GetCoursesByIDs(gomock.Any(), []int64{102}).Return(map[int64]courseDomain.Course{102: {ID: 102, CreditHours: 4}}, nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := newSectionService(nil)
+					mock.EXPECT().GetSectionsByIDs(gomock.Any(), []int64{202}).Return(map[int64]sectionDomain.Section{202: otherSection}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := newCourseEnrollmentService(secondInLine)
+					mock.EXPECT().GetEnrollmentByStudentID(gomock.Any(), int64(2)).Return([]courseEnrollmentDomain.CourseEnrollment{otherEnrollment}, nil)
+					mock.EXPECT().GetEnrollmentByStudentID(gomock.Any(), int64(3)).Return(nil, nil)
+					return mock
+				}(),
+				creditLoadPolicy: courseEnrollmentDomain.CreditLoadPolicy{MaxCredits: 6},
+			},
+		},
+		{
+			name: "Deleted Student Stays Waitlisted",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().LockStudentByID(gomock.Any(), int64(2)).Return(studentDomain.ErrNoRowsAffected)
+					mock.EXPECT().LockStudentByID(gomock.Any(), int64(3)).Return(nil)
+					return mock
+				}(),
+				courseService:           courseExists(),
+				sectionService:          newSectionService(nil),
+				courseEnrollmentService: newCourseEnrollmentService(secondInLine),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			termService := termDomainMock.NewMockTermDomainItf(ctrl)
+			termService.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2024 Fall"}, nil)
+			enrollmentUC := &EnrollmentUseCase{
+				studentService:          tt.fields.studentService,
+				courseService:           tt.fields.courseService,
+				sectionService:          tt.fields.sectionService,
+				termService:             termService,
+				courseEnrollmentService: tt.fields.courseEnrollmentService,
+				unitOfWork:              testutil.NewPassThroughUnitOfWork(ctrl),
+				creditLoadPolicy:        tt.fields.creditLoadPolicy,
+				now:                     time.Now,
+			}
+			got, err := enrollmentUC.CancelCourse(studentCtx(studentID), studentID, sectionID)
+			if err != nil {
+				t.Fatalf("EnrollmentUseCase.CancelCourse() error = %v", err)
+			}
+			if want := (CancelCourseResp{Status: common.StatusSuccess}); !reflect.DeepEqual(got, want) {
+				t.Errorf("EnrollmentUseCase.CancelCourse() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestEnrollmentUseCase_CancelCourse_Concurrent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const (
+		studentID int64 = 1
+		sectionID int64 = 201
+		termID    int64 = 301
+	)
+	dropDeadline := time.Date(2024, 9, 20, 0, 0, 0, 0, time.UTC)
+
+	sectionService := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+	sectionService.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: 101, TermID: termID}, nil)
+	termService := termDomainMock.NewMockTermDomainItf(ctrl)
+	termService.EXPECT().GetTermByID(gomock.Any(), termID).Return(&termDomain.Term{ID: termID, Name: "2024 Fall", DropDeadline: &dropDeadline}, nil)
+
+	// the enrollment row, waitlisted until a concurrent unit of work promotes it
+	var (
+		enrollmentMu sync.Mutex
+		enrollment   = courseEnrollmentDomain.CourseEnrollment{ID: 1, StudentID: studentID, CourseID: 101, SectionID: sectionID, Status: courseEnrollmentDomain.StatusWaitlisted}
+	)
+	enrollmentLock := &sync.Mutex{}
+	courseEnrollmentService := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+	courseEnrollmentService.EXPECT().LockEnrollmentByStudentIDAndSectionID(gomock.Any(), studentID, sectionID).DoAndReturn(func(ctx context.Context, studentID, sectionID int64) (*courseEnrollmentDomain.CourseEnrollment, error) {
+		lockRow(ctx, enrollmentLock)
+		enrollmentMu.Lock()
+		defer enrollmentMu.Unlock()
+		found := enrollment
+		return &found, nil
+	})
+
+	enrollmentUC := &EnrollmentUseCase{
+		sectionService:          sectionService,
+		termService:             termService,
+		courseEnrollmentService: courseEnrollmentService,
+		unitOfWork:              newRowLockingUnitOfWork(ctrl),
+		now:                     func() time.Time { return dropDeadline.AddDate(0, 0, 1) },
+	}
+
+	// promote the enrollment while the student cancels it after the drop deadline
+	locked := make(chan struct{})
+	promoted := make(chan error)
+	go func() {
+		promoted <- enrollmentUC.unitOfWork.Do(context.Background(), func(ctx context.Context) error {
+			lockRow(ctx, enrollmentLock)
+			close(locked)
+			time.Sleep(10 * time.Millisecond)
+			enrollmentMu.Lock()
+			enrollment.Status = courseEnrollmentDomain.StatusActive
+			enrollmentMu.Unlock()
+			return nil
+		})
+	}()
+	<-locked
+
+	got, err := enrollmentUC.CancelCourse(studentCtx(studentID), studentID, sectionID)
+	if !errors.Is(err, termDomain.ErrDropDeadlinePassed) {
+		t.Errorf("EnrollmentUseCase.CancelCourse() error = %v, want %v", err, termDomain.ErrDropDeadlinePassed)
+	}
+	if want := (CancelCourseResp{Status: common.StatusFailure, Message: "the drop deadline for this term has passed"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("EnrollmentUseCase.CancelCourse() = %v, want %v", got, want)
+	}
+	if err := <-promoted; err != nil {
+		t.Errorf("promotion error = %v", err)
+	}
+}
+
+func TestEnrollmentUseCase_BatchCourseSignUp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	items := []CourseSignUpRequest{{StudentID: 1, SectionID: 201}, {StudentID: 2, SectionID: 202}}
+
+	type fields struct {
+		studentService studentDomain.StudentDomainItf
+		sectionService sectionDomain.SectionDomainItf
+		unitOfWork     transaction.UnitOfWork
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		req     BatchCourseSignUpRequest
+		want    BatchCourseSignUpResp
+		wantErr bool
+	}{
+		{
+			name: "All Or Nothing Stops At First Failure",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), int64(1)).Return(nil, nil)
+					return mock
+				}(),
+				sectionService: sectionDomainMock.NewMockSectionDomainItf(ctrl),
+				unitOfWork:     testutil.NewPassThroughUnitOfWork(ctrl),
+			},
+			req: BatchCourseSignUpRequest{Items: items},
+			want: BatchCourseSignUpResp{
+				Status:  common.StatusFailure,
+				Message: "item 1 failed, the batch was rolled back",
+				Mode:    BatchModeAllOrNothing,
+				Failed:  2,
+				Results: []CourseSignUpResp{
+					{Status: common.StatusFailure, Message: "student data not found"},
+					{Status: common.StatusFailure, Message: "not processed, item 1 of the batch failed"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Best Effort Runs Every Item",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), int64(1)).Return(nil, nil)
+					mock.EXPECT().GetStudentByID(gomock.Any(), int64(2)).Return(&studentDomain.Student{ID: 2, Email: "student2@example.com"}, nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionByID(gomock.Any(), int64(202)).Return(nil, nil)
+					return mock
+				}(),
+				unitOfWork: transactionMock.NewMockUnitOfWork(ctrl),
+			},
+			req: BatchCourseSignUpRequest{Mode: BatchModeBestEffort, Items: items},
+			want: BatchCourseSignUpResp{
+				Status:  common.StatusSuccess,
+				Message: "2 of 2 items failed",
+				Mode:    BatchModeBestEffort,
+				Failed:  2,
+				Results: []CourseSignUpResp{
+					{Status: common.StatusFailure, Message: "student data not found"},
+					{Status: common.StatusFailure, Message: "section data not found"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Unit Of Work Error",
+			fields: fields{
+				unitOfWork: func() transaction.UnitOfWork {
+					mock := transactionMock.NewMockUnitOfWork(ctrl)
+					mock.EXPECT().Do(gomock.Any(), gomock.Any()).Return(errors.New("begin error"))
+					return mock
+				}(),
+			},
+			req: BatchCourseSignUpRequest{Mode: BatchModeAllOrNothing, Items: items},
+			want: BatchCourseSignUpResp{
+				Status:  common.StatusFailure,
+				Message: "failed to sign up courses",
+				Mode:    BatchModeAllOrNothing,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enrollmentUC := &EnrollmentUseCase{
+				studentService: tt.fields.studentService,
+				sectionService: tt.fields.sectionService,
+				unitOfWork:     tt.fields.unitOfWork,
+			}
+			got, err := enrollmentUC.BatchCourseSignUp(registrarCtx(), tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EnrollmentUseCase.BatchCourseSignUp() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EnrollmentUseCase.BatchCourseSignUp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnrollmentUseCase_BatchCancelCourse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const sectionID int64 = 201
+	items := []CancelCourseRequest{{StudentID: 1, SectionID: sectionID}, {StudentID: 2, SectionID: sectionID}, {StudentID: 3, SectionID: sectionID}}
+	enrollments := []courseEnrollmentDomain.CourseEnrollment{
+		{ID: 1, StudentID: 1, SectionID: sectionID, Status: courseEnrollmentDomain.StatusWaitlisted},
+		{ID: 2, StudentID: 2, SectionID: sectionID, Status: courseEnrollmentDomain.StatusWaitlisted},
+		{ID: 3, StudentID: 3, SectionID: sectionID, Status: courseEnrollmentDomain.StatusWaitlisted},
+	}
+
+	type fields struct {
+		courseEnrollmentService courseEnrollmentDomain.CourseEnrollmentDomainItf
+		unitOfWork              transaction.UnitOfWork
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		req     BatchCancelCourseRequest
+		want    BatchCancelCourseResp
+		wantErr bool
+	}{
+		{
+			name: "All Or Nothing Rolled Back",
+			fields: fields{
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().LockEnrollmentByStudentIDAndSectionID(gomock.Any(), int64(1), sectionID).Return(&enrollments[0], nil)
+					mock.EXPECT().CancelEnrollment(gomock.Any(), enrollments[0]).Return(courseEnrollmentDomain.CourseEnrollment{}, nil)
+					mock.EXPECT().LockEnrollmentByStudentIDAndSectionID(gomock.Any(), int64(2), sectionID).Return(nil, errors.New("enrollment error"))
+					return mock
+				}(),
+				unitOfWork: testutil.NewPassThroughUnitOfWork(ctrl),
+			},
+			req: BatchCancelCourseRequest{Mode: BatchModeAllOrNothing, Items: items},
+			want: BatchCancelCourseResp{
+				Status:  common.StatusFailure,
+				Message: "item 2 failed, the batch was rolled back",
+				Mode:    BatchModeAllOrNothing,
+				Failed:  3,
+				Results: []CancelCourseResp{
+					{Status: common.StatusFailure, Message: "rolled back, item 2 of the batch failed"},
+					{Status: common.StatusFailure, Message: "failed to retrieve course enrollment"},
+					{Status: common.StatusFailure, Message: "not processed, item 2 of the batch failed"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "All Or Nothing Success",
+			fields: fields{
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					for i := range enrollments {
+						mock.EXPECT().LockEnrollmentByStudentIDAndSectionID(gomock.Any(), enrollments[i].StudentID, sectionID).Return(&enrollments[i], nil)
+						mock.EXPECT().CancelEnrollment(gomock.Any(), enrollments[i]).Return(courseEnrollmentDomain.CourseEnrollment{}, nil)
+					}
+					return mock
+				}(),
+				unitOfWork: testutil.NewPassThroughUnitOfWork(ctrl),
+			},
+			req: BatchCancelCourseRequest{Items: items},
+			want: BatchCancelCourseResp{
+				Status:    common.StatusSuccess,
+				Mode:      BatchModeAllOrNothing,
+				Succeeded: 3,
+				Results: []CancelCourseResp{
+					{Status: common.StatusSuccess},
+					{Status: common.StatusSuccess},
+					{Status: common.StatusSuccess},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Best Effort Keeps Succeeded Items",
+			fields: fields{
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().LockEnrollmentByStudentIDAndSectionID(gomock.Any(), int64(1), sectionID).Return(&enrollments[0], nil)
+					mock.EXPECT().CancelEnrollment(gomock.Any(), enrollments[0]).Return(courseEnrollmentDomain.CourseEnrollment{}, nil)
+					mock.EXPECT().LockEnrollmentByStudentIDAndSectionID(gomock.Any(), int64(2), sectionID).Return(nil, errors.New("enrollment error"))
+					mock.EXPECT().LockEnrollmentByStudentIDAndSectionID(gomock.Any(), int64(3), sectionID).Return(&enrollments[2], nil)
+					mock.EXPECT().CancelEnrollment(gomock.Any(), enrollments[2]).Return(courseEnrollmentDomain.CourseEnrollment{}, nil)
+					return mock
+				}(),
+				unitOfWork: testutil.NewPassThroughUnitOfWork(ctrl),
+			},
+			req: BatchCancelCourseRequest{Mode: BatchModeBestEffort, Items: items},
+			want: BatchCancelCourseResp{
+				Status:    common.StatusSuccess,
+				Message:   "1 of 3 items failed",
+				Mode:      BatchModeBestEffort,
+				Succeeded: 2,
+				Failed:    1,
+				Results: []CancelCourseResp{
+					{Status: common.StatusSuccess},
+					{Status: common.StatusFailure, Message: "failed to retrieve course enrollment"},
+					{Status: common.StatusSuccess},
+				},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enrollmentUC := &EnrollmentUseCase{
+				courseEnrollmentService: tt.fields.courseEnrollmentService,
+				unitOfWork:              tt.fields.unitOfWork,
+			}
+			got, err := enrollmentUC.BatchCancelCourse(registrarCtx(), tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EnrollmentUseCase.BatchCancelCourse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EnrollmentUseCase.BatchCancelCourse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnrollmentUseCase_ListClassmates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const studentID int64 = 1
+
+	type fields struct {
+		studentService          studentDomain.StudentDomainItf
+		courseService           courseDomain.CourseDomainItf
+		courseEnrollmentService courseEnrollmentDomain.CourseEnrollmentDomainItf
+	}
+	type args struct {
+		ctx context.Context
+		req ListClassmatesRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    ListClassmatesResp
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetListClassmates(gomock.Any(), studentID, defaultListQuery).Return([]courseEnrollmentDomain.CourseEnrollment{
+						{CourseID: 101, StudentID: 2},
+						{CourseID: 101, StudentID: 3},
+					}, nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCoursesByIDs(gomock.Any(), []int64{101}).Return(map[int64]courseDomain.Course{101: {ID: 101, Name: "Course A"}}, nil)
+					return mock
+				}(),
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), int64(1)).Return(&studentDomain.Student{ID: 1, Email: "student1@example.com"}, nil)
+					mock.EXPECT().GetStudentsByIDs(gomock.Any(), []int64{2, 3}).Return(map[int64]studentDomain.Student{
+						2: {ID: 2, Email: "student2@example.com", Privacy: studentDomain.PrivacySettings{Visibility: studentDomain.VisibilityClassmates}},
+						3: {ID: 3, Email: "student3@example.com", Privacy: studentDomain.PrivacySettings{Visibility: studentDomain.VisibilityClassmates, ShowEmail: true}},
+					}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: ListClassmatesRequest{StudentID: studentID},
+			},
+			want: ListClassmatesResp{
+				Status: common.StatusSuccess,
+				Courses: []ListClassmatesCourseResp{
+					{
+						CourseID:   101,
+						CourseName: "Course A",
+						ClassMates: []ListClassmatesStudentsResp{
+							{StudentID: "2", StudentEmail: "s***@example.com"},
+							{StudentID: "3", StudentEmail: "student3@example.com"},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Classmates Not Visible",
+			fields: fields{
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetListClassmates(gomock.Any(), studentID, defaultListQuery).Return([]courseEnrollmentDomain.CourseEnrollment{
+						{CourseID: 101, StudentID: 2},
+						{CourseID: 101, StudentID: 3},
+						{CourseID: 102, StudentID: 3},
+						{CourseID: 102, StudentID: 4},
+					}, nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCoursesByIDs(gomock.Any(), []int64{101, 102}).Return(map[int64]courseDomain.Course{
+						101: {ID: 101, Name: "Course A"},
+						102: {ID: 102, Name: "Course B"},
+					}, nil)
+					return mock
+				}(),
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), int64(1)).Return(&studentDomain.Student{ID: 1, Email: "student1@example.com"}, nil)
+					mock.EXPECT().GetStudentsByIDs(gomock.Any(), []int64{2, 3, 3, 4}).Return(map[int64]studentDomain.Student{
+						2: {ID: 2, Email: "student2@example.com", Privacy: studentDomain.PrivacySettings{Visibility: studentDomain.VisibilityInstructors, ShowEmail: true}},
+						3: {ID: 3, Email: "student3@example.com", Privacy: studentDomain.PrivacySettings{Visibility: studentDomain.VisibilityHidden}},
+						4: {ID: 4, Email: "student4@example.com", Privacy: studentDomain.PrivacySettings{Visibility: studentDomain.VisibilityClassmates}},
+					}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: ListClassmatesRequest{StudentID: studentID},
+			},
+			want: ListClassmatesResp{
+				Status: common.StatusSuccess,
+				Courses: []ListClassmatesCourseResp{
+					{
+						CourseID:   102,
+						CourseName: "Course B",
+						ClassMates: []ListClassmatesStudentsResp{
+							{StudentID: "4", StudentEmail: "s***@example.com"},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Failed to Retrieve Enrollments",
+			fields: fields{
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetListClassmates(gomock.Any(), studentID, defaultListQuery).Return(nil, errors.New("fetch enrollments error"))
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					return courseDomainMock.NewMockCourseDomainItf(ctrl)
+				}(),
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), int64(1)).Return(&studentDomain.Student{ID: 1, Email: "student1@example.com"}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: ListClassmatesRequest{StudentID: studentID},
+			},
+			want: ListClassmatesResp{
+				Status:  common.StatusFailure,
+				Message: "failed to get list of classmates",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Failed to Retrieve Course Data",
+			fields: fields{
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetListClassmates(gomock.Any(), studentID, defaultListQuery).Return([]courseEnrollmentDomain.CourseEnrollment{
+						{CourseID: 101, StudentID: 2},
+					}, nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCoursesByIDs(gomock.Any(), []int64{101}).Return(nil, errors.New("course data error"))
+					return mock
+				}(),
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), int64(1)).Return(&studentDomain.Student{ID: 1, Email: "student1@example.com"}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: ListClassmatesRequest{StudentID: studentID},
+			},
+			want: ListClassmatesResp{
+				Status:  common.StatusFailure,
+				Message: "failed to retrieve course data",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Failed to Retrieve Student Data",
+			fields: fields{
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetListClassmates(gomock.Any(), studentID, defaultListQuery).Return([]courseEnrollmentDomain.CourseEnrollment{
+						{CourseID: 101, StudentID: 2},
+					}, nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCoursesByIDs(gomock.Any(), []int64{101}).Return(map[int64]courseDomain.Course{101: {ID: 101, Name: "Course A"}}, nil)
+					return mock
+				}(),
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), int64(1)).Return(&studentDomain.Student{ID: 1, Email: "student1@example.com"}, nil)
+					mock.EXPECT().GetStudentsByIDs(gomock.Any(), []int64{2}).Return(nil, errors.New("student data error"))
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: ListClassmatesRequest{StudentID: studentID},
+			},
+			want: ListClassmatesResp{
+				Status:  common.StatusFailure,
+				Message: "failed to retrieve student data",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Deleted Classmate Skipped",
+			fields: fields{
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetListClassmates(gomock.Any(), studentID, defaultListQuery).Return([]courseEnrollmentDomain.CourseEnrollment{
+						{CourseID: 101, StudentID: 2, Status: courseEnrollmentDomain.StatusCancelled},
+						{CourseID: 101, StudentID: 3},
+					}, nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCoursesByIDs(gomock.Any(), []int64{101}).Return(map[int64]courseDomain.Course{101: {ID: 101, Name: "Course A"}}, nil)
+					return mock
+				}(),
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), int64(1)).Return(&studentDomain.Student{ID: 1, Email: "student1@example.com"}, nil)
+					// student 2 has been deleted, their cancelled enrollment is kept
+					mock.EXPECT().GetStudentsByIDs(gomock.Any(), []int64{2, 3}).Return(map[int64]studentDomain.Student{
+						3: {ID: 3, Email: "student3@example.com", Privacy: studentDomain.PrivacySettings{Visibility: studentDomain.VisibilityClassmates, ShowEmail: true}},
+					}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: ListClassmatesRequest{StudentID: studentID},
+			},
+			want: ListClassmatesResp{
+				Status: common.StatusSuccess,
+				Courses: []ListClassmatesCourseResp{
+					{
+						CourseID:   101,
+						CourseName: "Course A",
+						ClassMates: []ListClassmatesStudentsResp{
+							{StudentID: "3", StudentEmail: "student3@example.com"},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enrollmentUC := &EnrollmentUseCase{
+				studentService:          tt.fields.studentService,
+				courseService:           tt.fields.courseService,
+				courseEnrollmentService: tt.fields.courseEnrollmentService,
+			}
+			got, err := enrollmentUC.ListClassmates(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EnrollmentUseCase.ListClassmates() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EnrollmentUseCase.ListClassmates() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnrollmentUseCase_GetCourseRoster(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const courseID int64 = 101
+	timestamp := time.Date(2024, time.August, 1, 0, 0, 0, 0, time.UTC)
+
+	adminCtx := auth.WithPrincipal(context.Background(), auth.Principal{
+		Subject:     "admin",
+		Roles:       []string{auth.RoleAdmin},
+		Permissions: []auth.Permission{auth.PermManageCourses, auth.PermManageStudents, auth.PermViewRosters},
+	})
+	instructorCtx := auth.WithPrincipal(context.Background(), auth.Principal{
+		Subject:      auth.InstructorSubject(7),
+		InstructorID: 7,
+		Roles:        []string{auth.RoleInstructor},
+		Permissions:  []auth.Permission{auth.PermViewRosters, auth.PermWriteGrades},
+	})
+
+	rosterEnrollments := []courseEnrollmentDomain.CourseEnrollment{
+		{ID: 1, StudentID: 2, CourseID: courseID, Status: courseEnrollmentDomain.StatusActive, CreateTime: timestamp, UpdateTime: timestamp},
+		{ID: 2, StudentID: 3, CourseID: courseID, Status: courseEnrollmentDomain.StatusCancelled, CreateTime: timestamp, UpdateTime: timestamp},
+		{ID: 3, StudentID: 4, CourseID: courseID, Status: courseEnrollmentDomain.StatusWaitlisted, CreateTime: timestamp, UpdateTime: timestamp},
+	}
+	rosterStudents := map[int64]studentDomain.Student{
+		2: {ID: 2, Email: "student2@example.com", Privacy: studentDomain.PrivacySettings{Visibility: studentDomain.VisibilityClassmates}},
+		3: {ID: 3, Email: "student3@example.com", Privacy: studentDomain.PrivacySettings{Visibility: studentDomain.VisibilityHidden}},
+	}
+
+	type fields struct {
+		studentService          studentDomain.StudentDomainItf
+		courseService           courseDomain.CourseDomainItf
+		courseEnrollmentService courseEnrollmentDomain.CourseEnrollmentDomainItf
+		instructorService       instructorDomain.InstructorDomainItf
+	}
+	type args struct {
+		ctx      context.Context
+		courseID int64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    CourseRosterResp
+		wantErr bool
+	}{
+		{
+			name: "Success As Course Manager",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course A"}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetEnrollmentsByCourseID(gomock.Any(), courseID, RosterStatuses).Return(rosterEnrollments, nil)
+					return mock
+				}(),
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentsByIDs(gomock.Any(), []int64{2, 3, 4}).Return(rosterStudents, nil)
+					return mock
+				}(),
+			},
+			args: args{ctx: adminCtx, courseID: courseID},
+			want: CourseRosterResp{
+				Status:     common.StatusSuccess,
+				CourseID:   courseID,
+				CourseName: "Course A",
+				Students: []RosterStudent{
+					{StudentID: 2, StudentEmail: "student2@example.com", Status: courseEnrollmentDomain.StatusActive, CreateTime: timestamp, UpdateTime: timestamp},
+					{StudentID: 3, StudentEmail: "student3@example.com", Status: courseEnrollmentDomain.StatusCancelled, CreateTime: timestamp, UpdateTime: timestamp},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success As Course Instructor",
+			fields: fields{
+				instructorService: func() instructorDomain.InstructorDomainItf {
+					mock := instructorDomainMock.NewMockInstructorDomainItf(ctrl)
+					mock.EXPECT().GetCourseIDsByInstructorID(gomock.Any(), int64(7)).Return([]int64{100, courseID}, nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course A"}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetEnrollmentsByCourseID(gomock.Any(), courseID, RosterStatuses).Return(rosterEnrollments, nil)
+					return mock
+				}(),
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentsByIDs(gomock.Any(), []int64{2, 3, 4}).Return(rosterStudents, nil)
+					return mock
+				}(),
+			},
+			args: args{ctx: instructorCtx, courseID: courseID},
+			want: CourseRosterResp{
+				Status:     common.StatusSuccess,
+				CourseID:   courseID,
+				CourseName: "Course A",
+				Students: []RosterStudent{
+					{StudentID: 2, StudentEmail: "student2@example.com", Status: courseEnrollmentDomain.StatusActive, CreateTime: timestamp, UpdateTime: timestamp},
+					{StudentID: 3, Status: courseEnrollmentDomain.StatusCancelled, CreateTime: timestamp, UpdateTime: timestamp},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Instructor Does Not Teach Course",
+			fields: fields{
+				instructorService: func() instructorDomain.InstructorDomainItf {
+					mock := instructorDomainMock.NewMockInstructorDomainItf(ctrl)
+					mock.EXPECT().GetCourseIDsByInstructorID(gomock.Any(), int64(7)).Return([]int64{100}, nil)
+					return mock
+				}(),
+			},
+			args:    args{ctx: instructorCtx, courseID: courseID},
+			want:    CourseRosterResp{Status: common.StatusFailure, Message: "permission denied"},
+			wantErr: true,
+		},
+		{
+			name:    "Student Forbidden",
+			args:    args{ctx: studentCtx(1), courseID: courseID},
+			want:    CourseRosterResp{Status: common.StatusFailure, Message: "permission denied"},
+			wantErr: true,
+		},
+		{
+			name: "Course Not Found",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(nil, nil)
+					return mock
+				}(),
+			},
+			args:    args{ctx: adminCtx, courseID: courseID},
+			want:    CourseRosterResp{Status: common.StatusFailure, Message: "course data not found"},
+			wantErr: false,
+		},
+		{
+			name: "Get Enrollments Error",
+			fields: fields{
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course A"}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetEnrollmentsByCourseID(gomock.Any(), courseID, RosterStatuses).Return(nil, errors.New("database error"))
+					return mock
+				}(),
+			},
+			args:    args{ctx: adminCtx, courseID: courseID},
+			want:    CourseRosterResp{Status: common.StatusFailure, Message: "failed to get course roster"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enrollmentUC := &EnrollmentUseCase{
+				studentService:          tt.fields.studentService,
+				courseService:           tt.fields.courseService,
+				courseEnrollmentService: tt.fields.courseEnrollmentService,
+				instructorService:       tt.fields.instructorService,
+			}
+			got, err := enrollmentUC.GetCourseRoster(tt.args.ctx, tt.args.courseID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EnrollmentUseCase.GetCourseRoster() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EnrollmentUseCase.GetCourseRoster() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnrollmentUseCase_GetSchedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const (
+		studentID int64 = 1
+		termID    int64 = 301
+	)
+
+	type fields struct {
+		studentService          studentDomain.StudentDomainItf
+		courseService           courseDomain.CourseDomainItf
+		sectionService          sectionDomain.SectionDomainItf
+		courseEnrollmentService courseEnrollmentDomain.CourseEnrollmentDomainItf
+	}
+	type args struct {
+		ctx context.Context
+		req ScheduleRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    ScheduleResp
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetEnrollmentByStudentID(gomock.Any(), studentID).Return([]courseEnrollmentDomain.CourseEnrollment{
+						{ID: 1, StudentID: studentID, CourseID: 101, SectionID: 201, Status: courseEnrollmentDomain.StatusActive},
+						{ID: 2, StudentID: studentID, CourseID: 102, SectionID: 202, Status: courseEnrollmentDomain.StatusActive},
+						{ID: 3, StudentID: studentID, CourseID: 103, SectionID: 203, Status: courseEnrollmentDomain.StatusWaitlisted},
+						{ID: 4, StudentID: studentID, CourseID: 104, SectionID: 204, Status: courseEnrollmentDomain.StatusActive},
+					}, nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionsByIDs(gomock.Any(), []int64{201, 202, 204}).Return(map[int64]sectionDomain.Section{
+						201: {ID: 201, CourseID: 101, TermID: termID},
+						202: {ID: 202, CourseID: 102, TermID: termID},
+						204: {ID: 204, CourseID: 104, TermID: termID - 1},
+					}, nil)
+					mock.EXPECT().GetMeetingsBySectionIDs(gomock.Any(), []int64{201, 202}).Return(map[int64][]sectionDomain.Meeting{
+						201: {
+							{SectionID: 201, DayOfWeek: sectionDomain.Monday, StartTime: 13 * 60, EndTime: 14 * 60, Room: "A-1"},
+							{SectionID: 201, DayOfWeek: sectionDomain.Wednesday, StartTime: 13 * 60, EndTime: 14 * 60, Room: "A-1"},
+						},
+						202: {
+							{SectionID: 202, DayOfWeek: sectionDomain.Sunday, StartTime: 10 * 60, EndTime: 12 * 60},
+							{SectionID: 202, DayOfWeek: sectionDomain.Monday, StartTime: 9 * 60, EndTime: 10 * 60, Room: "B-2"},
+						},
+					}, nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().GetCoursesByIDs(gomock.Any(), []int64{101, 102}).Return(map[int64]courseDomain.Course{
+						101: {ID: 101, Name: "Course 101"},
+						102: {ID: 102, Name: "Course 102"},
+					}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: ScheduleRequest{StudentID: studentID, TermID: termID},
+			},
+			want: ScheduleResp{
+				Status:    common.StatusSuccess,
+				StudentID: studentID,
+				Days: []ScheduleDay{
+					{DayOfWeek: sectionDomain.Monday, Meetings: []ScheduleMeeting{
+						{CourseID: 102, CourseName: "Course 102", SectionID: 202, TermID: termID, StartTime: 9 * 60, EndTime: 10 * 60, Room: "B-2"},
+						{CourseID: 101, CourseName: "Course 101", SectionID: 201, TermID: termID, StartTime: 13 * 60, EndTime: 14 * 60, Room: "A-1"},
+					}},
+					{DayOfWeek: sectionDomain.Wednesday, Meetings: []ScheduleMeeting{
+						{CourseID: 101, CourseName: "Course 101", SectionID: 201, TermID: termID, StartTime: 13 * 60, EndTime: 14 * 60, Room: "A-1"},
+					}},
+					{DayOfWeek: sectionDomain.Sunday, Meetings: []ScheduleMeeting{
+						{CourseID: 102, CourseName: "Course 102", SectionID: 202, TermID: termID, StartTime: 10 * 60, EndTime: 12 * 60},
+					}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Another Student",
+			fields: fields{
+				studentService:          studentDomainMock.NewMockStudentDomainItf(ctrl),
+				courseService:           courseDomainMock.NewMockCourseDomainItf(ctrl),
+				sectionService:          sectionDomainMock.NewMockSectionDomainItf(ctrl),
+				courseEnrollmentService: courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl),
+			},
+			args: args{
+				ctx: studentCtx(2),
+				req: ScheduleRequest{StudentID: studentID},
+			},
+			want:    ScheduleResp{Status: common.StatusFailure, Message: "permission denied"},
+			wantErr: true,
+		},
+		{
+			name: "Student Not Found",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(nil, nil)
+					return mock
+				}(),
+				courseService:           courseDomainMock.NewMockCourseDomainItf(ctrl),
+				sectionService:          sectionDomainMock.NewMockSectionDomainItf(ctrl),
+				courseEnrollmentService: courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: ScheduleRequest{StudentID: studentID},
+			},
+			want:    ScheduleResp{Status: common.StatusFailure, Message: "student data not found"},
+			wantErr: false,
+		},
+		{
+			name: "Failed to Retrieve Meetings",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetEnrollmentByStudentID(gomock.Any(), studentID).Return([]courseEnrollmentDomain.CourseEnrollment{
+						{ID: 1, StudentID: studentID, CourseID: 101, SectionID: 201, Status: courseEnrollmentDomain.StatusActive},
+					}, nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionsByIDs(gomock.Any(), []int64{201}).Return(map[int64]sectionDomain.Section{
+						201: {ID: 201, CourseID: 101, TermID: termID},
+					}, nil)
+					mock.EXPECT().GetMeetingsBySectionIDs(gomock.Any(), []int64{201}).Return(nil, errors.New("database error"))
+					return mock
+				}(),
+				courseService: courseDomainMock.NewMockCourseDomainItf(ctrl),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: ScheduleRequest{StudentID: studentID},
+			},
+			want:    ScheduleResp{Status: common.StatusFailure, Message: "failed to retrieve section meetings"},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -418,93 +3194,181 @@ func TestEnrollmentUseCase_ListCourses(t *testing.T) {
 			enrollmentUC := &EnrollmentUseCase{
 				studentService:          tt.fields.studentService,
 				courseService:           tt.fields.courseService,
+				sectionService:          tt.fields.sectionService,
 				courseEnrollmentService: tt.fields.courseEnrollmentService,
 			}
-			got, err := enrollmentUC.ListCourses(tt.args.ctx, tt.args.studentID)
+			got, err := enrollmentUC.GetSchedule(tt.args.ctx, tt.args.req)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("EnrollmentUseCase.ListCourses() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("EnrollmentUseCase.GetSchedule() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("EnrollmentUseCase.ListCourses() = %v, want %v", got, tt.want)
+				t.Errorf("EnrollmentUseCase.GetSchedule() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestEnrollmentUseCase_CancelCourse(t *testing.T) {
+func TestEnrollmentUseCase_RecordGrade(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	const studentID int64 = 1
-	const courseID int64 = 101
+	const (
+		studentID int64 = 1
+		courseID  int64 = 101
+		sectionID int64 = 201
+	)
+	gradeTime := time.Date(2024, time.December, 20, 0, 0, 0, 0, time.UTC)
+
+	instructorCtx := auth.WithPrincipal(context.Background(), auth.Principal{
+		Subject:      auth.InstructorSubject(7),
+		InstructorID: 7,
+		Roles:        []string{auth.RoleInstructor},
+		Permissions:  []auth.Permission{auth.PermViewRosters, auth.PermWriteGrades},
+	})
+	courseExists := func() courseDomain.CourseDomainItf {
+		mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+		mock.EXPECT().GetCourseByID(gomock.Any(), courseID).Return(&courseDomain.Course{ID: courseID, Name: "Course A"}, nil)
+		return mock
+	}
+	sectionInCourse := func() sectionDomain.SectionDomainItf {
+		mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+		mock.EXPECT().GetSectionByID(gomock.Any(), sectionID).Return(&sectionDomain.Section{ID: sectionID, CourseID: courseID}, nil)
+		return mock
+	}
+	teachesCourse := func() instructorDomain.InstructorDomainItf {
+		mock := instructorDomainMock.NewMockInstructorDomainItf(ctrl)
+		mock.EXPECT().GetCourseIDsByInstructorID(gomock.Any(), int64(7)).Return([]int64{courseID}, nil)
+		return mock
+	}
 
 	type fields struct {
-		studentService          studentDomain.StudentDomainItf
 		courseService           courseDomain.CourseDomainItf
+		sectionService          sectionDomain.SectionDomainItf
 		courseEnrollmentService courseEnrollmentDomain.CourseEnrollmentDomainItf
+		instructorService       instructorDomain.InstructorDomainItf
 	}
 	type args struct {
-		ctx       context.Context
-		studentID int64
-		courseID  int64
+		ctx context.Context
+		req RecordGradeRequest
 	}
 	tests := []struct {
 		name    string
 		fields  fields
 		args    args
-		want    CancelCourseResp
+		want    RecordGradeResp
 		wantErr bool
 	}{
 		{
 			name: "Success",
 			fields: fields{
+				instructorService: teachesCourse(),
+				courseService:     courseExists(),
+				sectionService:    sectionInCourse(),
 				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
 					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
-					mock.EXPECT().UpdateCourseEnrollmentStatus(gomock.Any(), studentID, courseID, courseEnrollmentDomain.StatusCancelled).Return(nil)
+					mock.EXPECT().RecordGrade(gomock.Any(), studentID, sectionID, "B+").Return(courseEnrollmentDomain.CourseEnrollment{
+						ID: 1, StudentID: studentID, CourseID: courseID, SectionID: 201,
+						Status: courseEnrollmentDomain.StatusCompleted, Grade: &courseEnrollmentDomain.Grade{Letter: "B+", Points: 3.3}, UpdateTime: gradeTime,
+					}, nil)
 					return mock
 				}(),
-				studentService: func() studentDomain.StudentDomainItf {
-					return studentDomainMock.NewMockStudentDomainItf(ctrl)
-				}(),
-				courseService: func() courseDomain.CourseDomainItf {
-					return courseDomainMock.NewMockCourseDomainItf(ctrl)
-				}(),
 			},
-			args: args{
-				ctx:       context.Background(),
-				studentID: studentID,
-				courseID:  courseID,
-			},
-			want: CancelCourseResp{
+			args: args{ctx: instructorCtx, req: RecordGradeRequest{CourseID: courseID, StudentID: studentID, SectionID: sectionID, Grade: "B+"}},
+			want: RecordGradeResp{
 				Status: common.StatusSuccess,
+				GradeData: &GradeDetail{
+					StudentID:   studentID,
+					CourseID:    courseID,
+					SectionID:   201,
+					Status:      courseEnrollmentDomain.StatusCompleted,
+					Grade:       "B+",
+					GradePoints: 3.3,
+					GradeTime:   gradeTime,
+				},
 			},
 			wantErr: false,
 		},
 		{
-			name: "Failed to Cancel Course Enrollment",
+			name: "Instructor Does Not Teach Course",
+			fields: fields{
+				instructorService: func() instructorDomain.InstructorDomainItf {
+					mock := instructorDomainMock.NewMockInstructorDomainItf(ctrl)
+					mock.EXPECT().GetCourseIDsByInstructorID(gomock.Any(), int64(7)).Return([]int64{100}, nil)
+					return mock
+				}(),
+			},
+			args:    args{ctx: instructorCtx, req: RecordGradeRequest{CourseID: courseID, StudentID: studentID, SectionID: sectionID, Grade: "A"}},
+			want:    RecordGradeResp{Status: common.StatusFailure, Message: "permission denied"},
+			wantErr: true,
+		},
+		{
+			name:    "Student Forbidden",
+			args:    args{ctx: studentCtx(studentID), req: RecordGradeRequest{CourseID: courseID, StudentID: studentID, SectionID: sectionID, Grade: "A"}},
+			want:    RecordGradeResp{Status: common.StatusFailure, Message: "permission denied"},
+			wantErr: true,
+		},
+		{
+			name: "Invalid Grade",
 			fields: fields{
+				instructorService: teachesCourse(),
+				courseService:     courseExists(),
+				sectionService:    sectionInCourse(),
 				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
 					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
-					mock.EXPECT().UpdateCourseEnrollmentStatus(gomock.Any(), studentID, courseID, courseEnrollmentDomain.StatusCancelled).Return(errors.New("update error"))
+					mock.EXPECT().RecordGrade(gomock.Any(), studentID, sectionID, "E").Return(courseEnrollmentDomain.CourseEnrollment{}, courseEnrollmentDomain.ErrInvalidGrade)
 					return mock
 				}(),
-				studentService: func() studentDomain.StudentDomainItf {
-					return studentDomainMock.NewMockStudentDomainItf(ctrl)
-				}(),
-				courseService: func() courseDomain.CourseDomainItf {
-					return courseDomainMock.NewMockCourseDomainItf(ctrl)
+			},
+			args:    args{ctx: instructorCtx, req: RecordGradeRequest{CourseID: courseID, StudentID: studentID, SectionID: sectionID, Grade: "E"}},
+			want:    RecordGradeResp{Status: common.StatusFailure, Message: "grade is not on the grading scale"},
+			wantErr: true,
+		},
+		{
+			name: "Section Of Another Course",
+			fields: fields{
+				instructorService: teachesCourse(),
+				courseService:     courseExists(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionByID(gomock.Any(), int64(202)).Return(&sectionDomain.Section{ID: 202, CourseID: 102}, nil)
+					return mock
 				}(),
 			},
-			args: args{
-				ctx:       context.Background(),
-				studentID: studentID,
-				courseID:  courseID,
+			args:    args{ctx: instructorCtx, req: RecordGradeRequest{CourseID: courseID, StudentID: studentID, SectionID: 202, Grade: "A"}},
+			want:    RecordGradeResp{Status: common.StatusFailure, Message: "section data not found"},
+			wantErr: false,
+		},
+		{
+			name: "Enrollment Not Found",
+			fields: fields{
+				instructorService: teachesCourse(),
+				courseService:     courseExists(),
+				sectionService:    sectionInCourse(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().RecordGrade(gomock.Any(), studentID, sectionID, "A").Return(courseEnrollmentDomain.CourseEnrollment{}, courseEnrollmentDomain.ErrNoRowsAffected)
+					return mock
+				}(),
 			},
-			want: CancelCourseResp{
-				Status:  common.StatusFailure,
-				Message: "failed to cancel course enrollment",
+			args:    args{ctx: instructorCtx, req: RecordGradeRequest{CourseID: courseID, StudentID: studentID, SectionID: sectionID, Grade: "A"}},
+			want:    RecordGradeResp{Status: common.StatusFailure, Message: "course enrollment not found"},
+			wantErr: false,
+		},
+		{
+			name: "Enrollment Not Active",
+			fields: fields{
+				instructorService: teachesCourse(),
+				courseService:     courseExists(),
+				sectionService:    sectionInCourse(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().RecordGrade(gomock.Any(), studentID, sectionID, "A").Return(courseEnrollmentDomain.CourseEnrollment{}, courseEnrollmentDomain.ErrInvalidStatusTransition)
+					return mock
+				}(),
 			},
+			args:    args{ctx: instructorCtx, req: RecordGradeRequest{CourseID: courseID, StudentID: studentID, SectionID: sectionID, Grade: "A"}},
+			want:    RecordGradeResp{Status: common.StatusFailure, Message: "enrollment status does not allow this change"},
 			wantErr: true,
 		},
 	}
@@ -512,31 +3376,40 @@ func TestEnrollmentUseCase_CancelCourse(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			enrollmentUC := &EnrollmentUseCase{
-				studentService:          tt.fields.studentService,
 				courseService:           tt.fields.courseService,
+				sectionService:          tt.fields.sectionService,
 				courseEnrollmentService: tt.fields.courseEnrollmentService,
+				instructorService:       tt.fields.instructorService,
 			}
-			got, err := enrollmentUC.CancelCourse(tt.args.ctx, tt.args.studentID, tt.args.courseID)
+			got, err := enrollmentUC.RecordGrade(tt.args.ctx, tt.args.req)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("EnrollmentUseCase.CancelCourse() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("EnrollmentUseCase.RecordGrade() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("EnrollmentUseCase.CancelCourse() = %v, want %v", got, tt.want)
+				t.Errorf("EnrollmentUseCase.RecordGrade() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestEnrollmentUseCase_ListClassmates(t *testing.T) {
+func TestEnrollmentUseCase_GetTranscript(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	const studentID int64 = 1
+	gradeTime := time.Date(2024, time.December, 20, 0, 0, 0, 0, time.UTC)
+
+	gradedEnrollments := []courseEnrollmentDomain.CourseEnrollment{
+		{ID: 1, StudentID: studentID, CourseID: 101, SectionID: 201, Status: courseEnrollmentDomain.StatusCompleted, Grade: &courseEnrollmentDomain.Grade{Letter: "A", Points: 4.0}, UpdateTime: gradeTime},
+		{ID: 2, StudentID: studentID, CourseID: 102, SectionID: 202, Status: courseEnrollmentDomain.StatusFailed, Grade: &courseEnrollmentDomain.Grade{Letter: "F", Points: 0}, UpdateTime: gradeTime},
+	}
 
 	type fields struct {
 		studentService          studentDomain.StudentDomainItf
 		courseService           courseDomain.CourseDomainItf
+		sectionService          sectionDomain.SectionDomainItf
+		termService             termDomain.TermDomainItf
 		courseEnrollmentService courseEnrollmentDomain.CourseEnrollmentDomainItf
 	}
 	type args struct {
@@ -547,140 +3420,95 @@ func TestEnrollmentUseCase_ListClassmates(t *testing.T) {
 		name    string
 		fields  fields
 		args    args
-		want    ListClassmatesResp
+		want    TranscriptResp
 		wantErr bool
 	}{
 		{
 			name: "Success",
 			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID}, nil)
+					return mock
+				}(),
 				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
 					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
-					mock.EXPECT().GetListClassmates(gomock.Any(), studentID).Return([]courseEnrollmentDomain.CourseEnrollment{
-						{CourseID: 101, StudentID: 2},
-						{CourseID: 101, StudentID: 3},
-					}, nil)
+					mock.EXPECT().GetGradedEnrollmentsByStudentID(gomock.Any(), studentID).Return(gradedEnrollments, nil)
 					return mock
 				}(),
 				courseService: func() courseDomain.CourseDomainItf {
 					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
-					mock.EXPECT().GetCourseByID(gomock.Any(), int64(101)).Return(&courseDomain.Course{ID: 101, Name: "Course A"}, nil)
+					mock.EXPECT().GetCoursesByIDs(gomock.Any(), []int64{101, 102}).Return(map[int64]courseDomain.Course{
+						101: {ID: 101, Name: "Course A", CreditHours: 3},
+						102: {ID: 102, Name: "Course B", CreditHours: 4},
+					}, nil)
 					return mock
 				}(),
-				studentService: func() studentDomain.StudentDomainItf {
-					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
-					mock.EXPECT().GetStudentByID(gomock.Any(), int64(1)).Return(&studentDomain.Student{ID: 1, Email: "student1@example.com"}, nil)
-					mock.EXPECT().GetStudentByID(gomock.Any(), int64(2)).Return(&studentDomain.Student{ID: 2, Email: "student2@example.com"}, nil)
-					mock.EXPECT().GetStudentByID(gomock.Any(), int64(3)).Return(&studentDomain.Student{ID: 3, Email: "student3@example.com"}, nil)
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionsByIDs(gomock.Any(), []int64{201, 202}).Return(map[int64]sectionDomain.Section{
+						201: {ID: 201, CourseID: 101, TermID: 301},
+						202: {ID: 202, CourseID: 102, TermID: 301},
+					}, nil)
+					return mock
+				}(),
+				termService: func() termDomain.TermDomainItf {
+					mock := termDomainMock.NewMockTermDomainItf(ctrl)
+					mock.EXPECT().GetTermsByIDs(gomock.Any(), []int64{301, 301}).Return(map[int64]termDomain.Term{
+						301: {ID: 301, Name: "2024 Fall"},
+					}, nil)
 					return mock
 				}(),
 			},
-			args: args{
-				ctx:       context.Background(),
-				studentID: studentID,
-			},
-			want: ListClassmatesResp{
-				Status: common.StatusSuccess,
-				Courses: []ListClassmatesCourseResp{
-					{
-						CourseID:   101,
-						CourseName: "Course A",
-						ClassMates: []ListClassmatesStudentsResp{
-							{StudentID: "2", StudentEmail: "student2@example.com"},
-							{StudentID: "3", StudentEmail: "student3@example.com"},
-						},
-					},
+			args: args{ctx: studentCtx(studentID), studentID: studentID},
+			want: TranscriptResp{
+				Status:    common.StatusSuccess,
+				StudentID: studentID,
+				Courses: []TranscriptCourse{
+					{CourseID: 101, CourseName: "Course A", TermID: 301, TermName: "2024 Fall", Status: courseEnrollmentDomain.StatusCompleted, Grade: "A", GradePoints: 4.0, CreditHours: 3, CreditsEarned: 3, GradeTime: gradeTime},
+					{CourseID: 102, CourseName: "Course B", TermID: 301, TermName: "2024 Fall", Status: courseEnrollmentDomain.StatusFailed, Grade: "F", GradePoints: 0, CreditHours: 4, GradeTime: gradeTime},
 				},
+				CreditsAttempted: 7,
+				CreditsEarned:    3,
+				GPA:              1.71,
 			},
 			wantErr: false,
 		},
 		{
-			name: "Failed to Retrieve Enrollments",
+			name:    "Another Student",
+			args:    args{ctx: studentCtx(2), studentID: studentID},
+			want:    TranscriptResp{Status: common.StatusFailure, Message: "permission denied"},
+			wantErr: true,
+		},
+		{
+			name: "Student Not Found",
 			fields: fields{
-				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
-					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
-					mock.EXPECT().GetListClassmates(gomock.Any(), studentID).Return(nil, errors.New("fetch enrollments error"))
-					return mock
-				}(),
-				courseService: func() courseDomain.CourseDomainItf {
-					return courseDomainMock.NewMockCourseDomainItf(ctrl)
-				}(),
 				studentService: func() studentDomain.StudentDomainItf {
 					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
-					mock.EXPECT().GetStudentByID(gomock.Any(), int64(1)).Return(&studentDomain.Student{ID: 1, Email: "student1@example.com"}, nil)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(nil, nil)
 					return mock
 				}(),
 			},
-			args: args{
-				ctx:       context.Background(),
-				studentID: studentID,
-			},
-			want: ListClassmatesResp{
-				Status:  common.StatusFailure,
-				Message: "failed to get list of classmates",
-			},
-			wantErr: true,
+			args:    args{ctx: studentCtx(studentID), studentID: studentID},
+			want:    TranscriptResp{Status: common.StatusFailure, Message: "student data not found"},
+			wantErr: false,
 		},
 		{
-			name: "Failed to Retrieve Course Data",
+			name: "Failed to Retrieve Enrollments",
 			fields: fields{
-				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
-					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
-					mock.EXPECT().GetListClassmates(gomock.Any(), studentID).Return([]courseEnrollmentDomain.CourseEnrollment{
-						{CourseID: 101, StudentID: 2},
-					}, nil)
-					return mock
-				}(),
-				courseService: func() courseDomain.CourseDomainItf {
-					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
-					mock.EXPECT().GetCourseByID(gomock.Any(), int64(101)).Return(nil, errors.New("course not found"))
-					return mock
-				}(),
 				studentService: func() studentDomain.StudentDomainItf {
 					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
-					mock.EXPECT().GetStudentByID(gomock.Any(), int64(1)).Return(&studentDomain.Student{ID: 1, Email: "student1@example.com"}, nil)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID}, nil)
 					return mock
 				}(),
-			},
-			args: args{
-				ctx:       context.Background(),
-				studentID: studentID,
-			},
-			want: ListClassmatesResp{
-				Status:  common.StatusFailure,
-				Message: "failed to retrieve course data",
-			},
-			wantErr: true,
-		},
-		{
-			name: "Failed to Retrieve Student Data",
-			fields: fields{
 				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
 					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
-					mock.EXPECT().GetListClassmates(gomock.Any(), studentID).Return([]courseEnrollmentDomain.CourseEnrollment{
-						{CourseID: 101, StudentID: 2},
-					}, nil)
-					return mock
-				}(),
-				courseService: func() courseDomain.CourseDomainItf {
-					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
-					mock.EXPECT().GetCourseByID(gomock.Any(), int64(101)).Return(&courseDomain.Course{ID: 101, Name: "Course A"}, nil)
-					return mock
-				}(),
-				studentService: func() studentDomain.StudentDomainItf {
-					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
-					mock.EXPECT().GetStudentByID(gomock.Any(), int64(1)).Return(&studentDomain.Student{ID: 1, Email: "student1@example.com"}, nil)
-					mock.EXPECT().GetStudentByID(gomock.Any(), int64(2)).Return(nil, errors.New("student not found"))
+					mock.EXPECT().GetGradedEnrollmentsByStudentID(gomock.Any(), studentID).Return(nil, errors.New("database error"))
 					return mock
 				}(),
 			},
-			args: args{
-				ctx:       context.Background(),
-				studentID: studentID,
-			},
-			want: ListClassmatesResp{
-				Status:  common.StatusFailure,
-				Message: "failed to retrieve student data: 2",
-			},
+			args:    args{ctx: studentCtx(studentID), studentID: studentID},
+			want:    TranscriptResp{Status: common.StatusFailure, Message: "failed to retrieve enrollments"},
 			wantErr: true,
 		},
 	}
@@ -690,16 +3518,50 @@ func TestEnrollmentUseCase_ListClassmates(t *testing.T) {
 			enrollmentUC := &EnrollmentUseCase{
 				studentService:          tt.fields.studentService,
 				courseService:           tt.fields.courseService,
+				sectionService:          tt.fields.sectionService,
+				termService:             tt.fields.termService,
 				courseEnrollmentService: tt.fields.courseEnrollmentService,
 			}
-			got, err := enrollmentUC.ListClassmates(tt.args.ctx, tt.args.studentID)
+			got, err := enrollmentUC.GetTranscript(tt.args.ctx, tt.args.studentID)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("EnrollmentUseCase.ListClassmates() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("EnrollmentUseCase.GetTranscript() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("EnrollmentUseCase.ListClassmates() = %v, want %v", got, tt.want)
+				t.Errorf("EnrollmentUseCase.GetTranscript() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
+
+// defaultListQuery is the list query sent for a request without list options.
+var defaultListQuery = courseEnrollmentDomain.EnrollmentListQuery{
+	SortBy: courseEnrollmentDomain.SortByEnrollTime,
+	Limit:  DefaultListLimit + 1,
+}
+
+// txLocksKey keys the row locks held by the current unit of work in the context.
+type txLocksKey struct{}
+
+// newRowLockingUnitOfWork returns a unit of work that releases the rows locked with lockRow once the function returns,
+// like a transaction releases its SELECT ... FOR UPDATE locks on commit.
+func newRowLockingUnitOfWork(ctrl *gomock.Controller) transaction.UnitOfWork {
+	mock := transactionMock.NewMockUnitOfWork(ctrl)
+	mock.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		var held []*sync.Mutex
+		defer func() {
+			for _, row := range held {
+				row.Unlock()
+			}
+		}()
+		return fn(context.WithValue(ctx, txLocksKey{}, &held))
+	}).AnyTimes()
+	return mock
+}
+
+// lockRow locks the row until the surrounding unit of work of newRowLockingUnitOfWork ends.
+func lockRow(ctx context.Context, row *sync.Mutex) {
+	row.Lock()
+	held := ctx.Value(txLocksKey{}).(*[]*sync.Mutex)
+	*held = append(*held, row)
+}
@@ -1,8 +1,8 @@
 // Code generated by MockGen. DO NOT EDIT.
 // Source: use-case/enrollment/enrollment.go
 
-// Package enrollmentusecase is a generated GoMock package.
-package enrollmentusecase
+// Package mocks is a generated GoMock package.
+package mocks
 
 import (
 	context "context"
@@ -35,19 +35,63 @@ func (m *MockEnrollmentUseCaseItf) EXPECT() *MockEnrollmentUseCaseItfMockRecorde
 	return m.recorder
 }
 
+// BatchCancelCourse mocks base method.
+func (m *MockEnrollmentUseCaseItf) BatchCancelCourse(ctx context.Context, req enrollmentusecase.BatchCancelCourseRequest) (enrollmentusecase.BatchCancelCourseResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchCancelCourse", ctx, req)
+	ret0, _ := ret[0].(enrollmentusecase.BatchCancelCourseResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchCancelCourse indicates an expected call of BatchCancelCourse.
+func (mr *MockEnrollmentUseCaseItfMockRecorder) BatchCancelCourse(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCancelCourse", reflect.TypeOf((*MockEnrollmentUseCaseItf)(nil).BatchCancelCourse), ctx, req)
+}
+
+// BatchCourseSignUp mocks base method.
+func (m *MockEnrollmentUseCaseItf) BatchCourseSignUp(ctx context.Context, req enrollmentusecase.BatchCourseSignUpRequest) (enrollmentusecase.BatchCourseSignUpResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchCourseSignUp", ctx, req)
+	ret0, _ := ret[0].(enrollmentusecase.BatchCourseSignUpResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchCourseSignUp indicates an expected call of BatchCourseSignUp.
+func (mr *MockEnrollmentUseCaseItfMockRecorder) BatchCourseSignUp(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCourseSignUp", reflect.TypeOf((*MockEnrollmentUseCaseItf)(nil).BatchCourseSignUp), ctx, req)
+}
+
 // CancelCourse mocks base method.
-func (m *MockEnrollmentUseCaseItf) CancelCourse(ctx context.Context, studentID, courseID int64) (enrollmentusecase.CancelCourseResp, error) {
+func (m *MockEnrollmentUseCaseItf) CancelCourse(ctx context.Context, studentID, sectionID int64) (enrollmentusecase.CancelCourseResp, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CancelCourse", ctx, studentID, courseID)
+	ret := m.ctrl.Call(m, "CancelCourse", ctx, studentID, sectionID)
 	ret0, _ := ret[0].(enrollmentusecase.CancelCourseResp)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CancelCourse indicates an expected call of CancelCourse.
-func (mr *MockEnrollmentUseCaseItfMockRecorder) CancelCourse(ctx, studentID, courseID interface{}) *gomock.Call {
+func (mr *MockEnrollmentUseCaseItfMockRecorder) CancelCourse(ctx, studentID, sectionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelCourse", reflect.TypeOf((*MockEnrollmentUseCaseItf)(nil).CancelCourse), ctx, studentID, courseID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelCourse", reflect.TypeOf((*MockEnrollmentUseCaseItf)(nil).CancelCourse), ctx, studentID, sectionID)
+}
+
+// CancelStudentEnrollments mocks base method.
+func (m *MockEnrollmentUseCaseItf) CancelStudentEnrollments(ctx context.Context, studentID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelStudentEnrollments", ctx, studentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelStudentEnrollments indicates an expected call of CancelStudentEnrollments.
+func (mr *MockEnrollmentUseCaseItfMockRecorder) CancelStudentEnrollments(ctx, studentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelStudentEnrollments", reflect.TypeOf((*MockEnrollmentUseCaseItf)(nil).CancelStudentEnrollments), ctx, studentID)
 }
 
 // CourseSignUp mocks base method.
@@ -65,32 +109,92 @@ func (mr *MockEnrollmentUseCaseItfMockRecorder) CourseSignUp(ctx, req interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CourseSignUp", reflect.TypeOf((*MockEnrollmentUseCaseItf)(nil).CourseSignUp), ctx, req)
 }
 
+// GetCourseRoster mocks base method.
+func (m *MockEnrollmentUseCaseItf) GetCourseRoster(ctx context.Context, courseID int64) (enrollmentusecase.CourseRosterResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCourseRoster", ctx, courseID)
+	ret0, _ := ret[0].(enrollmentusecase.CourseRosterResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCourseRoster indicates an expected call of GetCourseRoster.
+func (mr *MockEnrollmentUseCaseItfMockRecorder) GetCourseRoster(ctx, courseID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCourseRoster", reflect.TypeOf((*MockEnrollmentUseCaseItf)(nil).GetCourseRoster), ctx, courseID)
+}
+
+// GetSchedule mocks base method.
+func (m *MockEnrollmentUseCaseItf) GetSchedule(ctx context.Context, req enrollmentusecase.ScheduleRequest) (enrollmentusecase.ScheduleResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedule", ctx, req)
+	ret0, _ := ret[0].(enrollmentusecase.ScheduleResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchedule indicates an expected call of GetSchedule.
+func (mr *MockEnrollmentUseCaseItfMockRecorder) GetSchedule(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedule", reflect.TypeOf((*MockEnrollmentUseCaseItf)(nil).GetSchedule), ctx, req)
+}
+
+// GetTranscript mocks base method.
+func (m *MockEnrollmentUseCaseItf) GetTranscript(ctx context.Context, studentID int64) (enrollmentusecase.TranscriptResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTranscript", ctx, studentID)
+	ret0, _ := ret[0].(enrollmentusecase.TranscriptResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTranscript indicates an expected call of GetTranscript.
+func (mr *MockEnrollmentUseCaseItfMockRecorder) GetTranscript(ctx, studentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTranscript", reflect.TypeOf((*MockEnrollmentUseCaseItf)(nil).GetTranscript), ctx, studentID)
+}
+
 // ListClassmates mocks base method.
-func (m *MockEnrollmentUseCaseItf) ListClassmates(ctx context.Context, studentID int64) (enrollmentusecase.ListClassmatesResp, error) {
+func (m *MockEnrollmentUseCaseItf) ListClassmates(ctx context.Context, req enrollmentusecase.ListClassmatesRequest) (enrollmentusecase.ListClassmatesResp, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListClassmates", ctx, studentID)
+	ret := m.ctrl.Call(m, "ListClassmates", ctx, req)
 	ret0, _ := ret[0].(enrollmentusecase.ListClassmatesResp)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListClassmates indicates an expected call of ListClassmates.
-func (mr *MockEnrollmentUseCaseItfMockRecorder) ListClassmates(ctx, studentID interface{}) *gomock.Call {
+func (mr *MockEnrollmentUseCaseItfMockRecorder) ListClassmates(ctx, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClassmates", reflect.TypeOf((*MockEnrollmentUseCaseItf)(nil).ListClassmates), ctx, studentID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClassmates", reflect.TypeOf((*MockEnrollmentUseCaseItf)(nil).ListClassmates), ctx, req)
 }
 
 // ListCourses mocks base method.
-func (m *MockEnrollmentUseCaseItf) ListCourses(ctx context.Context, studentID int64) (enrollmentusecase.ListCoursesResp, error) {
+func (m *MockEnrollmentUseCaseItf) ListCourses(ctx context.Context, req enrollmentusecase.ListCoursesRequest) (enrollmentusecase.ListCoursesResp, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListCourses", ctx, studentID)
+	ret := m.ctrl.Call(m, "ListCourses", ctx, req)
 	ret0, _ := ret[0].(enrollmentusecase.ListCoursesResp)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListCourses indicates an expected call of ListCourses.
-func (mr *MockEnrollmentUseCaseItfMockRecorder) ListCourses(ctx, studentID interface{}) *gomock.Call {
+func (mr *MockEnrollmentUseCaseItfMockRecorder) ListCourses(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCourses", reflect.TypeOf((*MockEnrollmentUseCaseItf)(nil).ListCourses), ctx, req)
+}
+
+// RecordGrade mocks base method.
+func (m *MockEnrollmentUseCaseItf) RecordGrade(ctx context.Context, req enrollmentusecase.RecordGradeRequest) (enrollmentusecase.RecordGradeResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordGrade", ctx, req)
+	ret0, _ := ret[0].(enrollmentusecase.RecordGradeResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordGrade indicates an expected call of RecordGrade.
+func (mr *MockEnrollmentUseCaseItfMockRecorder) RecordGrade(ctx, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCourses", reflect.TypeOf((*MockEnrollmentUseCaseItf)(nil).ListCourses), ctx, studentID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordGrade", reflect.TypeOf((*MockEnrollmentUseCaseItf)(nil).RecordGrade), ctx, req)
 }
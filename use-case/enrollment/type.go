@@ -1,19 +1,55 @@
 package enrollmentusecase
 
-import "time"
+import (
+	"time"
+
+	courseEnrollmentDomain "github/rakadityas/course-management-system/domain/course-enrollment"
+	sectionDomain "github/rakadityas/course-management-system/domain/section"
+)
+
+// Features switches optional sign-up rules on or off.
+type Features struct {
+	Waitlist      bool // waitlist students when a course is full instead of rejecting them
+	Prerequisites bool // require completed prerequisites before signing up
+}
 
 // CourseSignUp related
 type (
+	// CourseSignUpRequest signs a student up for a section of a course.
 	CourseSignUpRequest struct {
 		StudentID int64 `json:"student_id"`
-		CourseID  int64 `json:"course_id"`
+		SectionID int64 `json:"section_id"`
 	}
 
 	// CourseSignUpResp represents the response structure for course sign-up.
 	CourseSignUpResp struct {
-		Status         string            `json:"status"`
-		Message        string            `json:"message,omitempty"`
-		EnrollmentData *CourseEnrollment `json:"enrollment_data,omitempty"`
+		Status                       string            `json:"status"`
+		Message                      string            `json:"message,omitempty"`
+		MissingPrerequisiteCourseIDs []int64           `json:"missing_prerequisite_course_ids,omitempty"`
+		ScheduleConflict             *ScheduleConflict `json:"schedule_conflict,omitempty"`
+		CreditLoad                   *TermCreditLoad   `json:"credit_load,omitempty"`
+		EnrollmentData               *CourseEnrollment `json:"enrollment_data,omitempty"`
+	}
+
+	// ScheduleConflict is the enrolled course whose meeting overlaps the section signed up for.
+	ScheduleConflict struct {
+		CourseID   int64                   `json:"course_id"`
+		CourseName string                  `json:"course_name"`
+		SectionID  int64                   `json:"section_id"`
+		DayOfWeek  sectionDomain.DayOfWeek `json:"day_of_week"`
+		StartTime  sectionDomain.ClockTime `json:"start_time"`
+		EndTime    sectionDomain.ClockTime `json:"end_time"`
+	}
+
+	// TermCreditLoad is the credit hours of a student's active enrollments in a term against the
+	// credit load policy. MaxCredits and RemainingCredits are left out when the load is unlimited.
+	TermCreditLoad struct {
+		TermID           int64  `json:"term_id"`
+		TermName         string `json:"term_name"`
+		EnrolledCredits  int    `json:"enrolled_credits"`
+		MaxCredits       int    `json:"max_credits,omitempty"`
+		RemainingCredits *int   `json:"remaining_credits,omitempty"`
+		FullTime         bool   `json:"full_time"`
 	}
 )
 
@@ -21,39 +57,57 @@ type (
 type (
 	// CourseEnrollment represents the course enrollment details.
 	CourseEnrollment struct {
-		ID           int64     `json:"id"`
-		StudentID    int64     `json:"student_id"`
-		StudentEmail string    `json:"student_email"`
-		CourseID     int64     `json:"course_id"`
-		CourseName   string    `json:"course_name"`
-		Status       int       `json:"status"`
-		CreateTime   time.Time `json:"create_time"`
-		UpdateTime   time.Time `json:"update_time"`
+		ID               int64                                   `json:"id"`
+		StudentID        int64                                   `json:"student_id"`
+		StudentEmail     string                                  `json:"student_email"`
+		CourseID         int64                                   `json:"course_id"`
+		CourseName       string                                  `json:"course_name"`
+		SectionID        int64                                   `json:"section_id"`
+		TermID           int64                                   `json:"term_id"`
+		Status           courseEnrollmentDomain.EnrollmentStatus `json:"status"`
+		WaitlistPosition int                                     `json:"waitlist_position,omitempty"`
+		CreateTime       time.Time                               `json:"create_time"`
+		UpdateTime       time.Time                               `json:"update_time"`
+	}
+
+	// ListCoursesRequest represents the request for listing the courses of a student.
+	ListCoursesRequest struct {
+		StudentID int64
+		ListOptions
 	}
 
 	// ListCoursesResp represents the response structure for listing courses.
+	// NextCursor is set when another page follows. CreditLoads covers every term the student is
+	// active in, or only the requested term, regardless of the page.
 	ListCoursesResp struct {
-		Status  string         `json:"status"`
-		Message string         `json:"message,omitempty"`
-		Courses []CourseDetail `json:"courses,omitempty"`
+		Status      string           `json:"status"`
+		Message     string           `json:"message,omitempty"`
+		Courses     []CourseDetail   `json:"courses,omitempty"`
+		CreditLoads []TermCreditLoad `json:"credit_loads,omitempty"`
+		NextCursor  string           `json:"next_cursor,omitempty"`
 	}
 
 	// CourseDetail provides detailed information about a course.
 	CourseDetail struct {
-		CourseID   int64     `json:"course_id"`
-		CourseName string    `json:"course_name"`
-		Status     int       `json:"status"`
-		CreateTime time.Time `json:"create_time"`
-		UpdateTime time.Time `json:"update_time"`
+		CourseID    int64                                   `json:"course_id"`
+		CourseName  string                                  `json:"course_name"`
+		SectionID   int64                                   `json:"section_id"`
+		TermID      int64                                   `json:"term_id"`
+		TermName    string                                  `json:"term_name"`
+		CreditHours int                                     `json:"credit_hours"`
+		Instructors []string                                `json:"instructors,omitempty"` // instructor names, ordered by name
+		Status      courseEnrollmentDomain.EnrollmentStatus `json:"status"`
+		CreateTime  time.Time                               `json:"create_time"`
+		UpdateTime  time.Time                               `json:"update_time"`
 	}
 )
 
 // CancelCourseResp related
 type (
-	// CancelCourseRequest represents the request payload for canceling a course enrollment.
+	// CancelCourseRequest represents the request payload for canceling the enrollment of a student in a section.
 	CancelCourseRequest struct {
 		StudentID int64 `json:"student_id"`
-		CourseID  int64 `json:"course_id"`
+		SectionID int64 `json:"section_id"`
 	}
 
 	// CancelCourseResp represents the response structure for course cancel
@@ -63,13 +117,66 @@ type (
 	}
 )
 
+// Batch related
+type (
+	// BatchMode decides whether a batch is applied as a whole or item by item.
+	BatchMode string
+
+	// BatchCourseSignUpRequest signs a list of students up for their sections in one request.
+	// Mode defaults to BatchModeAllOrNothing.
+	BatchCourseSignUpRequest struct {
+		Mode  BatchMode             `json:"mode"`
+		Items []CourseSignUpRequest `json:"items"`
+	}
+
+	// BatchCourseSignUpResp holds the result of every item of a batch sign-up, in request order.
+	BatchCourseSignUpResp struct {
+		Status    string             `json:"status"`
+		Message   string             `json:"message,omitempty"`
+		Mode      BatchMode          `json:"mode"`
+		Succeeded int                `json:"succeeded"`
+		Failed    int                `json:"failed"`
+		Results   []CourseSignUpResp `json:"results"`
+	}
+
+	// BatchCancelCourseRequest cancels a list of course enrollments in one request.
+	// Mode defaults to BatchModeAllOrNothing.
+	BatchCancelCourseRequest struct {
+		Mode  BatchMode             `json:"mode"`
+		Items []CancelCourseRequest `json:"items"`
+	}
+
+	// BatchCancelCourseResp holds the result of every item of a batch cancel, in request order.
+	BatchCancelCourseResp struct {
+		Status    string             `json:"status"`
+		Message   string             `json:"message,omitempty"`
+		Mode      BatchMode          `json:"mode"`
+		Succeeded int                `json:"succeeded"`
+		Failed    int                `json:"failed"`
+		Results   []CancelCourseResp `json:"results"`
+	}
+)
+
+// IsValid reports whether m is a known batch mode.
+func (m BatchMode) IsValid() bool {
+	return m == BatchModeAllOrNothing || m == BatchModeBestEffort
+}
+
 // ListClassmatesResp related
 type (
+	// ListClassmatesRequest represents the request for listing the classmates of a student.
+	ListClassmatesRequest struct {
+		StudentID int64
+		ListOptions
+	}
+
 	// ListClassmatesResp represents lists of students within the same course as the student
+	// NextCursor is set when another page follows.
 	ListClassmatesResp struct {
-		Status  string                     `json:"status"`
-		Message string                     `json:"message,omitempty"`
-		Courses []ListClassmatesCourseResp `json:"courses"`
+		Status     string                     `json:"status"`
+		Message    string                     `json:"message,omitempty"`
+		Courses    []ListClassmatesCourseResp `json:"courses"`
+		NextCursor string                     `json:"next_cursor,omitempty"`
 	}
 
 	ListClassmatesCourseResp struct {
@@ -83,3 +190,126 @@ type (
 		StudentEmail string `json:"student_email"`
 	}
 )
+
+// Listing related
+type (
+	// ListOptions filters, sorts and pages the enrollments behind a list endpoint.
+	ListOptions struct {
+		Statuses     []courseEnrollmentDomain.EnrollmentStatus
+		TermID       int64 // 0 lists every term
+		CreatedAfter *time.Time
+		SortBy       courseEnrollmentDomain.EnrollmentSortField
+		Limit        int
+		Cursor       string // opaque token from a previous response's next_cursor
+	}
+)
+
+// CourseRoster related
+type (
+	// CourseRosterResp represents the students enrolled in a course, in enrollment order.
+	CourseRosterResp struct {
+		Status     string          `json:"status"`
+		Message    string          `json:"message,omitempty"`
+		CourseID   int64           `json:"course_id,omitempty"`
+		CourseName string          `json:"course_name,omitempty"`
+		Students   []RosterStudent `json:"students,omitempty"`
+	}
+
+	// RosterStudent is one enrollment on a course roster. StudentEmail is empty when withheld.
+	RosterStudent struct {
+		StudentID    int64                                   `json:"student_id"`
+		StudentEmail string                                  `json:"student_email,omitempty"`
+		Status       courseEnrollmentDomain.EnrollmentStatus `json:"status"`
+		CreateTime   time.Time                               `json:"create_time"`
+		UpdateTime   time.Time                               `json:"update_time"`
+	}
+)
+
+// Schedule related
+type (
+	// ScheduleRequest represents the request for the weekly timetable of a student.
+	ScheduleRequest struct {
+		StudentID int64
+		TermID    int64 // 0 covers every term
+	}
+
+	// ScheduleResp represents the weekly timetable of a student's active enrollments.
+	// Days run from Monday to Sunday and only days with a meeting are listed.
+	ScheduleResp struct {
+		Status    string        `json:"status"`
+		Message   string        `json:"message,omitempty"`
+		StudentID int64         `json:"student_id,omitempty"`
+		Days      []ScheduleDay `json:"days,omitempty"`
+	}
+
+	// ScheduleDay holds the meetings of one weekday ordered by start time.
+	ScheduleDay struct {
+		DayOfWeek sectionDomain.DayOfWeek `json:"day_of_week"`
+		Meetings  []ScheduleMeeting       `json:"meetings"`
+	}
+
+	// ScheduleMeeting is one weekly meeting of an enrolled section.
+	ScheduleMeeting struct {
+		CourseID   int64                   `json:"course_id"`
+		CourseName string                  `json:"course_name"`
+		SectionID  int64                   `json:"section_id"`
+		TermID     int64                   `json:"term_id"`
+		StartTime  sectionDomain.ClockTime `json:"start_time"`
+		EndTime    sectionDomain.ClockTime `json:"end_time"`
+		Room       string                  `json:"room,omitempty"`
+	}
+)
+
+// Grade related
+type (
+	// RecordGradeRequest represents the request payload for grading a student's enrollment in a section of a course.
+	RecordGradeRequest struct {
+		CourseID  int64  `json:"-"`
+		StudentID int64  `json:"-"`
+		SectionID int64  `json:"section_id"`
+		Grade     string `json:"grade"`
+	}
+
+	// RecordGradeResp represents the response payload for grading an enrollment.
+	RecordGradeResp struct {
+		Status    string       `json:"status"`
+		Message   string       `json:"message,omitempty"`
+		GradeData *GradeDetail `json:"grade_data,omitempty"`
+	}
+
+	// GradeDetail is the recorded grade of an enrollment and the status it moved to.
+	GradeDetail struct {
+		StudentID   int64                                   `json:"student_id"`
+		CourseID    int64                                   `json:"course_id"`
+		SectionID   int64                                   `json:"section_id"`
+		Status      courseEnrollmentDomain.EnrollmentStatus `json:"status"`
+		Grade       string                                  `json:"grade"`
+		GradePoints float64                                 `json:"grade_points"`
+		GradeTime   time.Time                               `json:"grade_time"`
+	}
+
+	// TranscriptResp represents the graded courses of a student with the credit totals and GPA.
+	TranscriptResp struct {
+		Status           string             `json:"status"`
+		Message          string             `json:"message,omitempty"`
+		StudentID        int64              `json:"student_id,omitempty"`
+		Courses          []TranscriptCourse `json:"courses,omitempty"`
+		CreditsAttempted int                `json:"credits_attempted"`
+		CreditsEarned    int                `json:"credits_earned"`
+		GPA              float64            `json:"gpa"`
+	}
+
+	// TranscriptCourse is one completed or failed course on a transcript.
+	TranscriptCourse struct {
+		CourseID      int64                                   `json:"course_id"`
+		CourseName    string                                  `json:"course_name"`
+		TermID        int64                                   `json:"term_id"`
+		TermName      string                                  `json:"term_name"`
+		Status        courseEnrollmentDomain.EnrollmentStatus `json:"status"`
+		Grade         string                                  `json:"grade"`
+		GradePoints   float64                                 `json:"grade_points"`
+		CreditHours   int                                     `json:"credit_hours"`
+		CreditsEarned int                                     `json:"credits_earned"`
+		GradeTime     time.Time                               `json:"grade_time"`
+	}
+)
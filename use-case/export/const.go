@@ -0,0 +1,13 @@
+package exportusecase
+
+// Export formats.
+const (
+	FormatCSV   ExportFormat = "csv"
+	FormatJSONL ExportFormat = "jsonl"
+)
+
+// enrollmentColumns are the header row of a CSV enrollment export, in the order of EnrollmentRecord.
+var enrollmentColumns = []string{
+	"enrollment_id", "student_id", "student_email", "course_id", "course_name", "section_id",
+	"status", "grade", "grade_points", "create_time", "update_time",
+}
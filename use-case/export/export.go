@@ -0,0 +1,189 @@
+package exportusecase
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"time"
+
+	common "github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/auth"
+	courseEnrollmentDomain "github/rakadityas/course-management-system/domain/course-enrollment"
+)
+
+var (
+	// ErrInvalidFormat is returned when an export is requested in a format it does not support.
+	ErrInvalidFormat = errors.New("invalid export format")
+	// ErrInvalidDateRange is returned when the start of the date range is not before its end.
+	ErrInvalidDateRange = errors.New("invalid date range")
+)
+
+// ExportUseCaseItf defines the interface for the ExportUseCase.
+type ExportUseCaseItf interface {
+	ExportEnrollments(ctx context.Context, req ExportEnrollmentsRequest, w io.Writer) (ExportResp, error)
+}
+
+type ExportUseCase struct {
+	courseEnrollmentService courseEnrollmentDomain.CourseEnrollmentDomainItf
+}
+
+func NewExportUseCase(courseEnrollmentService courseEnrollmentDomain.CourseEnrollmentDomainItf) ExportUseCaseItf {
+	return &ExportUseCase{
+		courseEnrollmentService: courseEnrollmentService,
+	}
+}
+
+// ExportEnrollments writes the enrollments matching the request to w, each joined with the email
+// of its student and the name of its course, the earliest created first. Rows are written as they
+// are read from the database. Nothing is written when the request is rejected, so an error without
+// rows leaves w untouched; an error after some rows leaves the export truncated.
+func (exportUC *ExportUseCase) ExportEnrollments(ctx context.Context, req ExportEnrollmentsRequest, w io.Writer) (ExportResp, error) {
+	if err := auth.Authorize(ctx, auth.PermManageEnrollments); err != nil {
+		return ExportResp{Status: common.StatusFailure, Message: exportErrorMessage(err, "failed to export enrollments")}, err
+	}
+	if !req.Format.IsValid() {
+		return ExportResp{Status: common.StatusFailure, Message: ErrInvalidFormat.Error()}, ErrInvalidFormat
+	}
+	if req.CreatedFrom != nil && req.CreatedBefore != nil && !req.CreatedFrom.Before(*req.CreatedBefore) {
+		return ExportResp{Status: common.StatusFailure, Message: ErrInvalidDateRange.Error()}, ErrInvalidDateRange
+	}
+
+	query := courseEnrollmentDomain.EnrollmentExportQuery{
+		CourseID:      req.CourseID,
+		Statuses:      req.Statuses,
+		CreatedFrom:   req.CreatedFrom,
+		CreatedBefore: req.CreatedBefore,
+	}
+
+	writer := newEnrollmentWriter(req.Format, w)
+	var rows int
+	err := exportUC.courseEnrollmentService.ExportEnrollments(ctx, query, func(row courseEnrollmentDomain.EnrollmentExportRow) error {
+		if err := writer.Write(newEnrollmentRecord(row)); err != nil {
+			return err
+		}
+		rows++
+		return nil
+	})
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err != nil {
+		return ExportResp{Status: common.StatusFailure, Message: "failed to export enrollments", Rows: rows}, err
+	}
+
+	return ExportResp{Status: common.StatusSuccess, Rows: rows}, nil
+}
+
+// newEnrollmentRecord flattens an exported enrollment into a record.
+func newEnrollmentRecord(row courseEnrollmentDomain.EnrollmentExportRow) EnrollmentRecord {
+	record := EnrollmentRecord{
+		EnrollmentID: row.Enrollment.ID,
+		StudentID:    row.Enrollment.StudentID,
+		StudentEmail: row.StudentEmail,
+		CourseID:     row.Enrollment.CourseID,
+		CourseName:   row.CourseName,
+		SectionID:    row.Enrollment.SectionID,
+		Status:       row.Enrollment.Status,
+		CreateTime:   row.Enrollment.CreateTime,
+		UpdateTime:   row.Enrollment.UpdateTime,
+	}
+	if grade := row.Enrollment.Grade; grade != nil {
+		points := grade.Points
+		record.Grade = grade.Letter
+		record.GradePoints = &points
+	}
+
+	return record
+}
+
+// enrollmentWriter encodes records in an export format. Writes are buffered until Flush.
+type enrollmentWriter interface {
+	Write(record EnrollmentRecord) error
+	Flush() error
+}
+
+// newEnrollmentWriter returns the writer of the format, which must be valid.
+func newEnrollmentWriter(format ExportFormat, w io.Writer) enrollmentWriter {
+	if format == FormatJSONL {
+		buffered := bufio.NewWriter(w)
+		return &jsonlEnrollmentWriter{buffered: buffered, encoder: json.NewEncoder(buffered)}
+	}
+	return &csvEnrollmentWriter{writer: csv.NewWriter(w)}
+}
+
+// csvEnrollmentWriter writes records as CSV rows under the enrollmentColumns header.
+type csvEnrollmentWriter struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+func (c *csvEnrollmentWriter) Write(record EnrollmentRecord) error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+
+	var gradePoints string
+	if record.GradePoints != nil {
+		gradePoints = strconv.FormatFloat(*record.GradePoints, 'f', 2, 64)
+	}
+	return c.writer.Write([]string{
+		strconv.FormatInt(record.EnrollmentID, 10),
+		strconv.FormatInt(record.StudentID, 10),
+		record.StudentEmail,
+		strconv.FormatInt(record.CourseID, 10),
+		record.CourseName,
+		strconv.FormatInt(record.SectionID, 10),
+		record.Status.String(),
+		record.Grade,
+		gradePoints,
+		record.CreateTime.UTC().Format(time.RFC3339),
+		record.UpdateTime.UTC().Format(time.RFC3339),
+	})
+}
+
+// Flush writes the header as well when there were no rows, so an empty export is still a valid CSV file.
+func (c *csvEnrollmentWriter) Flush() error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+func (c *csvEnrollmentWriter) writeHeader() error {
+	if c.headerWritten {
+		return nil
+	}
+	c.headerWritten = true
+	return c.writer.Write(enrollmentColumns)
+}
+
+// jsonlEnrollmentWriter writes records as JSON objects, one per line.
+type jsonlEnrollmentWriter struct {
+	buffered *bufio.Writer
+	encoder  *json.Encoder
+}
+
+func (j *jsonlEnrollmentWriter) Write(record EnrollmentRecord) error {
+	return j.encoder.Encode(record)
+}
+
+func (j *jsonlEnrollmentWriter) Flush() error {
+	return j.buffered.Flush()
+}
+
+// exportErrorMessage maps errors to the message returned to the client, or fallback when there is none.
+func exportErrorMessage(err error, fallback string) string {
+	switch {
+	case errors.Is(err, auth.ErrUnauthenticated):
+		return "authentication required"
+	case errors.Is(err, auth.ErrForbidden):
+		return "permission denied"
+	default:
+		return fallback
+	}
+}
@@ -0,0 +1,197 @@
+package exportusecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	common "github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/auth"
+	courseEnrollmentDomain "github/rakadityas/course-management-system/domain/course-enrollment"
+	courseEnrollmentDomainMock "github/rakadityas/course-management-system/domain/course-enrollment/mocks"
+	"reflect"
+	"testing"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// registrarCtx is authenticated with the permission to manage every enrollment.
+var registrarCtx = auth.WithPrincipal(context.Background(), auth.Principal{
+	Subject:     "registrar",
+	Permissions: []auth.Permission{auth.PermManageEnrollments},
+})
+
+func TestExportUseCase_ExportEnrollments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	from := time.Date(2024, time.August, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2024, time.September, 1, 0, 0, 0, 0, time.UTC)
+	timestamp := time.Date(2024, time.August, 15, 9, 30, 0, 0, time.UTC)
+	exportRows := []courseEnrollmentDomain.EnrollmentExportRow{
+		{
+			Enrollment:   courseEnrollmentDomain.CourseEnrollment{ID: 1, StudentID: 1, CourseID: 101, SectionID: 11, Status: courseEnrollmentDomain.StatusActive, CreateTime: timestamp, UpdateTime: timestamp},
+			StudentEmail: "alice@example.com",
+			CourseName:   "Mathematics 101",
+		},
+		{
+			Enrollment:   courseEnrollmentDomain.CourseEnrollment{ID: 2, StudentID: 2, CourseID: 101, SectionID: 11, Status: courseEnrollmentDomain.StatusCompleted, Grade: &courseEnrollmentDomain.Grade{Letter: "B+", Points: 3.3}, CreateTime: timestamp, UpdateTime: timestamp},
+			StudentEmail: "bob@example.com",
+			CourseName:   "Mathematics 101, Advanced",
+		},
+	}
+	streamRows := func(rows []courseEnrollmentDomain.EnrollmentExportRow, err error) func(context.Context, courseEnrollmentDomain.EnrollmentExportQuery, func(courseEnrollmentDomain.EnrollmentExportRow) error) error {
+		return func(ctx context.Context, query courseEnrollmentDomain.EnrollmentExportQuery, fn func(courseEnrollmentDomain.EnrollmentExportRow) error) error {
+			for _, row := range rows {
+				if err := fn(row); err != nil {
+					return err
+				}
+			}
+			return err
+		}
+	}
+
+	type fields struct {
+		courseEnrollmentService courseEnrollmentDomain.CourseEnrollmentDomainItf
+	}
+	type args struct {
+		ctx context.Context
+		req ExportEnrollmentsRequest
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		args       args
+		want       ExportResp
+		wantOutput string
+		wantErr    error
+	}{
+		{
+			name: "CSV",
+			fields: fields{
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mockCourseEnrollmentDomain := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mockCourseEnrollmentDomain.EXPECT().ExportEnrollments(gomock.Any(), courseEnrollmentDomain.EnrollmentExportQuery{
+						CourseID:      101,
+						Statuses:      []courseEnrollmentDomain.EnrollmentStatus{courseEnrollmentDomain.StatusActive, courseEnrollmentDomain.StatusCompleted},
+						CreatedFrom:   &from,
+						CreatedBefore: &before,
+					}, gomock.Any()).DoAndReturn(streamRows(exportRows, nil))
+					return mockCourseEnrollmentDomain
+				}(),
+			},
+			args: args{
+				ctx: registrarCtx,
+				req: ExportEnrollmentsRequest{
+					Format:        FormatCSV,
+					CourseID:      101,
+					Statuses:      []courseEnrollmentDomain.EnrollmentStatus{courseEnrollmentDomain.StatusActive, courseEnrollmentDomain.StatusCompleted},
+					CreatedFrom:   &from,
+					CreatedBefore: &before,
+				},
+			},
+			want: ExportResp{Status: common.StatusSuccess, Rows: 2},
+			wantOutput: "enrollment_id,student_id,student_email,course_id,course_name,section_id,status,grade,grade_points,create_time,update_time\n" +
+				"1,1,alice@example.com,101,Mathematics 101,11,active,,,2024-08-15T09:30:00Z,2024-08-15T09:30:00Z\n" +
+				"2,2,bob@example.com,101,\"Mathematics 101, Advanced\",11,completed,B+,3.30,2024-08-15T09:30:00Z,2024-08-15T09:30:00Z\n",
+		},
+		{
+			name: "JSON Lines",
+			fields: fields{
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mockCourseEnrollmentDomain := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mockCourseEnrollmentDomain.EXPECT().ExportEnrollments(gomock.Any(), courseEnrollmentDomain.EnrollmentExportQuery{}, gomock.Any()).DoAndReturn(streamRows(exportRows, nil))
+					return mockCourseEnrollmentDomain
+				}(),
+			},
+			args: args{
+				ctx: registrarCtx,
+				req: ExportEnrollmentsRequest{Format: FormatJSONL},
+			},
+			want: ExportResp{Status: common.StatusSuccess, Rows: 2},
+			wantOutput: `{"enrollment_id":1,"student_id":1,"student_email":"alice@example.com","course_id":101,"course_name":"Mathematics 101","section_id":11,"status":"active","create_time":"2024-08-15T09:30:00Z","update_time":"2024-08-15T09:30:00Z"}` + "\n" +
+				`{"enrollment_id":2,"student_id":2,"student_email":"bob@example.com","course_id":101,"course_name":"Mathematics 101, Advanced","section_id":11,"status":"completed","grade":"B+","grade_points":3.3,"create_time":"2024-08-15T09:30:00Z","update_time":"2024-08-15T09:30:00Z"}` + "\n",
+		},
+		{
+			name: "Empty CSV Has Header",
+			fields: fields{
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mockCourseEnrollmentDomain := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mockCourseEnrollmentDomain.EXPECT().ExportEnrollments(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(streamRows(nil, nil))
+					return mockCourseEnrollmentDomain
+				}(),
+			},
+			args: args{
+				ctx: registrarCtx,
+				req: ExportEnrollmentsRequest{Format: FormatCSV},
+			},
+			want:       ExportResp{Status: common.StatusSuccess},
+			wantOutput: "enrollment_id,student_id,student_email,course_id,course_name,section_id,status,grade,grade_points,create_time,update_time\n",
+		},
+		{
+			name: "Failed Midway",
+			fields: fields{
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mockCourseEnrollmentDomain := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mockCourseEnrollmentDomain.EXPECT().ExportEnrollments(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(streamRows(exportRows[:1], errors.New("connection lost")))
+					return mockCourseEnrollmentDomain
+				}(),
+			},
+			args: args{
+				ctx: registrarCtx,
+				req: ExportEnrollmentsRequest{Format: FormatJSONL},
+			},
+			want:    ExportResp{Status: common.StatusFailure, Message: "failed to export enrollments", Rows: 1},
+			wantErr: errors.New("connection lost"),
+		},
+		{
+			name: "Invalid Format",
+			args: args{
+				ctx: registrarCtx,
+				req: ExportEnrollmentsRequest{Format: "xlsx"},
+			},
+			want:    ExportResp{Status: common.StatusFailure, Message: "invalid export format"},
+			wantErr: ErrInvalidFormat,
+		},
+		{
+			name: "Invalid Date Range",
+			args: args{
+				ctx: registrarCtx,
+				req: ExportEnrollmentsRequest{Format: FormatCSV, CreatedFrom: &before, CreatedBefore: &from},
+			},
+			want:    ExportResp{Status: common.StatusFailure, Message: "invalid date range"},
+			wantErr: ErrInvalidDateRange,
+		},
+		{
+			name: "Missing Permission",
+			args: args{
+				ctx: auth.WithPrincipal(context.Background(), auth.Principal{Subject: "student:1", Permissions: []auth.Permission{auth.PermManageOwnEnrollments}}),
+				req: ExportEnrollmentsRequest{Format: FormatCSV},
+			},
+			want:    ExportResp{Status: common.StatusFailure, Message: "permission denied"},
+			wantErr: auth.ErrForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exportUC := &ExportUseCase{
+				courseEnrollmentService: tt.fields.courseEnrollmentService,
+			}
+			var output bytes.Buffer
+			got, err := exportUC.ExportEnrollments(tt.args.ctx, tt.args.req, &output)
+			if (err != nil) != (tt.wantErr != nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("ExportUseCase.ExportEnrollments() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExportUseCase.ExportEnrollments() = %v, want %v", got, tt.want)
+			}
+			if tt.wantErr == nil && output.String() != tt.wantOutput {
+				t.Errorf("ExportUseCase.ExportEnrollments() output = %q, want %q", output.String(), tt.wantOutput)
+			}
+			if tt.wantErr != nil && tt.want.Rows == 0 && output.Len() != 0 {
+				t.Errorf("ExportUseCase.ExportEnrollments() wrote %q before failing", output.String())
+			}
+		})
+	}
+}
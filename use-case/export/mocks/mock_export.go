@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: use-case/export/export.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	exportusecase "github/rakadityas/course-management-system/use-case/export"
+	io "io"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockExportUseCaseItf is a mock of ExportUseCaseItf interface.
+type MockExportUseCaseItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportUseCaseItfMockRecorder
+}
+
+// MockExportUseCaseItfMockRecorder is the mock recorder for MockExportUseCaseItf.
+type MockExportUseCaseItfMockRecorder struct {
+	mock *MockExportUseCaseItf
+}
+
+// NewMockExportUseCaseItf creates a new mock instance.
+func NewMockExportUseCaseItf(ctrl *gomock.Controller) *MockExportUseCaseItf {
+	mock := &MockExportUseCaseItf{ctrl: ctrl}
+	mock.recorder = &MockExportUseCaseItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportUseCaseItf) EXPECT() *MockExportUseCaseItfMockRecorder {
+	return m.recorder
+}
+
+// ExportEnrollments mocks base method.
+func (m *MockExportUseCaseItf) ExportEnrollments(ctx context.Context, req exportusecase.ExportEnrollmentsRequest, w io.Writer) (exportusecase.ExportResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportEnrollments", ctx, req, w)
+	ret0, _ := ret[0].(exportusecase.ExportResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportEnrollments indicates an expected call of ExportEnrollments.
+func (mr *MockExportUseCaseItfMockRecorder) ExportEnrollments(ctx, req, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportEnrollments", reflect.TypeOf((*MockExportUseCaseItf)(nil).ExportEnrollments), ctx, req, w)
+}
+
+// MockenrollmentWriter is a mock of enrollmentWriter interface.
+type MockenrollmentWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockenrollmentWriterMockRecorder
+}
+
+// MockenrollmentWriterMockRecorder is the mock recorder for MockenrollmentWriter.
+type MockenrollmentWriterMockRecorder struct {
+	mock *MockenrollmentWriter
+}
+
+// NewMockenrollmentWriter creates a new mock instance.
+func NewMockenrollmentWriter(ctrl *gomock.Controller) *MockenrollmentWriter {
+	mock := &MockenrollmentWriter{ctrl: ctrl}
+	mock.recorder = &MockenrollmentWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenrollmentWriter) EXPECT() *MockenrollmentWriterMockRecorder {
+	return m.recorder
+}
+
+// Flush mocks base method.
+func (m *MockenrollmentWriter) Flush() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Flush")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Flush indicates an expected call of Flush.
+func (mr *MockenrollmentWriterMockRecorder) Flush() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Flush", reflect.TypeOf((*MockenrollmentWriter)(nil).Flush))
+}
+
+// Write mocks base method.
+func (m *MockenrollmentWriter) Write(record exportusecase.EnrollmentRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockenrollmentWriterMockRecorder) Write(record interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockenrollmentWriter)(nil).Write), record)
+}
@@ -0,0 +1,57 @@
+package exportusecase
+
+import (
+	"time"
+
+	courseEnrollmentDomain "github/rakadityas/course-management-system/domain/course-enrollment"
+)
+
+// ExportFormat is the file format of an export.
+type ExportFormat string
+
+// Export related
+type (
+	// ExportEnrollmentsRequest filters the enrollments to export. Empty filters export every enrollment.
+	ExportEnrollmentsRequest struct {
+		Format        ExportFormat
+		CourseID      int64 // 0 exports every course
+		Statuses      []courseEnrollmentDomain.EnrollmentStatus
+		CreatedFrom   *time.Time // only enrollments created at or after this time
+		CreatedBefore *time.Time // only enrollments created before this time
+	}
+
+	// ExportResp reports how an export ended. Rows counts the rows written, also when it failed midway.
+	ExportResp struct {
+		Status  string `json:"status"`
+		Message string `json:"message,omitempty"`
+		Rows    int    `json:"rows"`
+	}
+
+	// EnrollmentRecord is an exported enrollment, one line of a JSON Lines export.
+	EnrollmentRecord struct {
+		EnrollmentID int64                                   `json:"enrollment_id"`
+		StudentID    int64                                   `json:"student_id"`
+		StudentEmail string                                  `json:"student_email"`
+		CourseID     int64                                   `json:"course_id"`
+		CourseName   string                                  `json:"course_name"`
+		SectionID    int64                                   `json:"section_id"`
+		Status       courseEnrollmentDomain.EnrollmentStatus `json:"status"`
+		Grade        string                                  `json:"grade,omitempty"`
+		GradePoints  *float64                                `json:"grade_points,omitempty"`
+		CreateTime   time.Time                               `json:"create_time"`
+		UpdateTime   time.Time                               `json:"update_time"`
+	}
+)
+
+// IsValid reports whether the format is one the export supports.
+func (f ExportFormat) IsValid() bool {
+	return f == FormatCSV || f == FormatJSONL
+}
+
+// ContentType returns the media type of an export in the format.
+func (f ExportFormat) ContentType() string {
+	if f == FormatJSONL {
+		return "application/x-ndjson"
+	}
+	return "text/csv; charset=utf-8"
+}
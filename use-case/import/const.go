@@ -0,0 +1,18 @@
+package importusecase
+
+// Import files, also the multipart field names of an upload and the file names of the error report.
+const (
+	FileStudents    = "students"
+	FileCourses     = "courses"
+	FileEnrollments = "enrollments"
+)
+
+// MaxImportRows caps the number of data rows of a single import file.
+const MaxImportRows = 10000
+
+// Columns of the import files. The header row names them in any order; other columns are rejected.
+var (
+	studentColumns    = importColumns{required: []string{"email"}}
+	courseColumns     = importColumns{required: []string{"name", "capacity"}, optional: []string{"credit_hours"}}
+	enrollmentColumns = importColumns{required: []string{"section_id"}, optional: []string{"student_id", "student_email", "status"}}
+)
@@ -0,0 +1,501 @@
+package importusecase
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	common "github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/auth"
+	"github/rakadityas/course-management-system/common/transaction"
+	courseDomain "github/rakadityas/course-management-system/domain/course"
+	courseEnrollmentDomain "github/rakadityas/course-management-system/domain/course-enrollment"
+	sectionDomain "github/rakadityas/course-management-system/domain/section"
+	studentDomain "github/rakadityas/course-management-system/domain/student"
+)
+
+// ImportUseCaseItf defines the interface for the ImportUseCase.
+type ImportUseCaseItf interface {
+	Import(ctx context.Context, req ImportRequest) (ImportResp, error)
+}
+
+type ImportUseCase struct {
+	studentService          studentDomain.StudentDomainItf
+	courseService           courseDomain.CourseDomainItf
+	sectionService          sectionDomain.SectionDomainItf
+	courseEnrollmentService courseEnrollmentDomain.CourseEnrollmentDomainItf
+	unitOfWork              transaction.UnitOfWork
+}
+
+func NewImportUseCase(studentService studentDomain.StudentDomainItf, courseService courseDomain.CourseDomainItf, sectionService sectionDomain.SectionDomainItf, courseEnrollmentService courseEnrollmentDomain.CourseEnrollmentDomainItf, unitOfWork transaction.UnitOfWork) ImportUseCaseItf {
+	return &ImportUseCase{
+		studentService:          studentService,
+		courseService:           courseService,
+		sectionService:          sectionService,
+		courseEnrollmentService: courseEnrollmentService,
+		unitOfWork:              unitOfWork,
+	}
+}
+
+// errRollback rolls back an import that has invalid rows or is a dry run.
+var errRollback = errors.New("import rolled back")
+
+// fileOrder is the order the files are imported in, and reported in.
+var fileOrder = map[string]int{FileStudents: 0, FileCourses: 1, FileEnrollments: 2}
+
+// Import creates the students, then the courses, then the enrollments of the given CSV files in
+// one unit of work. Every row goes through the domain services so the database checks it as well;
+// the import is only kept when no row is invalid and it is not a dry run.
+func (importUC *ImportUseCase) Import(ctx context.Context, req ImportRequest) (ImportResp, error) {
+	// Each file needs the permission to manage its records
+	files := []struct {
+		reader     io.Reader
+		permission auth.Permission
+	}{
+		{req.Students, auth.PermManageStudents},
+		{req.Courses, auth.PermManageCourses},
+		{req.Enrollments, auth.PermManageEnrollments},
+	}
+	given := false
+	for _, file := range files {
+		if file.reader == nil {
+			continue
+		}
+		given = true
+		if err := auth.Authorize(ctx, file.permission); err != nil {
+			return ImportResp{Status: common.StatusFailure, Message: importErrorMessage(err, "failed to import"), DryRun: req.DryRun}, err
+		}
+	}
+	if !given {
+		return ImportResp{Status: common.StatusFailure, Message: "no file to import", DryRun: req.DryRun}, nil
+	}
+
+	// Read every file before writing, a file with a bad header is not imported at all
+	report := &importReport{}
+	resp := ImportResp{DryRun: req.DryRun}
+	var studentRows, courseRows, enrollmentRows []csvRow
+	var err error
+	if req.Students != nil {
+		resp.Students = &ImportFileResult{}
+		studentRows, resp.Students.Rows, err = report.readCSV(FileStudents, req.Students, studentColumns)
+		if err != nil {
+			return ImportResp{Status: common.StatusFailure, Message: "failed to read students file", DryRun: req.DryRun}, err
+		}
+	}
+	if req.Courses != nil {
+		resp.Courses = &ImportFileResult{}
+		courseRows, resp.Courses.Rows, err = report.readCSV(FileCourses, req.Courses, courseColumns)
+		if err != nil {
+			return ImportResp{Status: common.StatusFailure, Message: "failed to read courses file", DryRun: req.DryRun}, err
+		}
+	}
+	if req.Enrollments != nil {
+		resp.Enrollments = &ImportFileResult{}
+		enrollmentRows, resp.Enrollments.Rows, err = report.readCSV(FileEnrollments, req.Enrollments, enrollmentColumns)
+		if err != nil {
+			return ImportResp{Status: common.StatusFailure, Message: "failed to read enrollments file", DryRun: req.DryRun}, err
+		}
+	}
+
+	// Write every valid row, enrollments may refer to the students created before them
+	err = importUC.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		studentIDByEmail, valid, err := importUC.importStudents(ctx, studentRows, report)
+		if err != nil {
+			return err
+		}
+		if resp.Students != nil {
+			resp.Students.Valid = valid
+		}
+
+		valid, err = importUC.importCourses(ctx, courseRows, report)
+		if err != nil {
+			return err
+		}
+		if resp.Courses != nil {
+			resp.Courses.Valid = valid
+		}
+
+		valid, err = importUC.importEnrollments(ctx, enrollmentRows, studentIDByEmail, valid > 0, report)
+		if err != nil {
+			return err
+		}
+		if resp.Enrollments != nil {
+			resp.Enrollments.Valid = valid
+		}
+
+		if len(report.errors) > 0 || req.DryRun {
+			return errRollback
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errRollback) {
+		return ImportResp{Status: common.StatusFailure, Message: "failed to import", DryRun: req.DryRun}, err
+	}
+
+	resp.Errors = report.sorted()
+	switch {
+	case len(resp.Errors) > 0:
+		resp.Status = common.StatusFailure
+		resp.Message = strconv.Itoa(len(resp.Errors)) + " invalid rows, nothing was imported"
+	case req.DryRun:
+		resp.Status = common.StatusSuccess
+		resp.Message = "dry run, nothing was imported"
+	default:
+		resp.Status = common.StatusSuccess
+	}
+
+	return resp, nil
+}
+
+// importStudents registers the students of the rows and returns the IDs of those created by email.
+func (importUC *ImportUseCase) importStudents(ctx context.Context, rows []csvRow, report *importReport) (map[string]int64, int, error) {
+	studentIDByEmail := make(map[string]int64, len(rows))
+	lineByEmail := make(map[string]int, len(rows))
+	valid := 0
+	for _, row := range rows {
+		email := strings.ToLower(row.values["email"])
+		if email == "" {
+			report.add(FileStudents, row.line, "email is required")
+			continue
+		}
+		if line, ok := lineByEmail[email]; ok {
+			report.add(FileStudents, row.line, "email duplicates line "+strconv.Itoa(line))
+			continue
+		}
+		lineByEmail[email] = row.line
+
+		student, err := importUC.studentService.CreateStudent(ctx, email)
+		if errors.Is(err, studentDomain.ErrInvalidEmail) || errors.Is(err, studentDomain.ErrEmailAlreadyExists) {
+			report.add(FileStudents, row.line, err.Error())
+			continue
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		studentIDByEmail[student.Email] = student.ID
+		valid++
+	}
+
+	return studentIDByEmail, valid, nil
+}
+
+// importCourses adds the courses of the rows to the catalog. Without credit hours a course is worth
+// courseDomain.DefaultCreditHours. The courses are not offered in any term, so they have no sections
+// and enrollments cannot be imported for them until a section is created.
+func (importUC *ImportUseCase) importCourses(ctx context.Context, rows []csvRow, report *importReport) (int, error) {
+	lineByName := make(map[string]int, len(rows))
+	valid := 0
+	for _, row := range rows {
+		capacity, err := strconv.Atoi(row.values["capacity"])
+		if err != nil {
+			report.add(FileCourses, row.line, "capacity must be a whole number")
+			continue
+		}
+		creditHours := courseDomain.DefaultCreditHours
+		if value := row.values["credit_hours"]; value != "" {
+			creditHours, err = strconv.Atoi(value)
+			if err != nil {
+				report.add(FileCourses, row.line, "credit_hours must be a whole number")
+				continue
+			}
+		}
+		name := strings.ToLower(row.values["name"])
+		if line, ok := lineByName[name]; ok && name != "" {
+			report.add(FileCourses, row.line, "name duplicates line "+strconv.Itoa(line))
+			continue
+		}
+		lineByName[name] = row.line
+
+		_, err = importUC.courseService.CreateCourse(ctx, row.values["name"], capacity, creditHours)
+		if errors.Is(err, courseDomain.ErrInvalidCourseName) || errors.Is(err, courseDomain.ErrInvalidCourseCapacity) || errors.Is(err, courseDomain.ErrInvalidCreditHours) {
+			report.add(FileCourses, row.line, err.Error())
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		valid++
+	}
+
+	return valid, nil
+}
+
+// importEnrollments enrolls the students of the rows in their sections as active, the default, or
+// waitlisted. A row refers to an existing student by student_id or to a student of the same import
+// by student_email. Like a sign-up, a student takes one section of a course at a time; other sign-up
+// rules such as seat counts are not applied. coursesImported tells an unknown section apart from one
+// expected for a course of the same import, which has none.
+func (importUC *ImportUseCase) importEnrollments(ctx context.Context, rows []csvRow, studentIDByEmail map[string]int64, coursesImported bool, report *importReport) (int, error) {
+	type enrollmentRow struct {
+		line      int
+		studentID int64
+		byID      bool // the student was referred to by student_id and must exist already
+		sectionID int64
+		status    courseEnrollmentDomain.EnrollmentStatus
+	}
+
+	// Parse the rows first so the students and sections are looked up once
+	var parsed []enrollmentRow
+	var studentIDs, sectionIDs []int64
+	for _, row := range rows {
+		enrollment := enrollmentRow{line: row.line, status: courseEnrollmentDomain.StatusActive}
+
+		var ok bool
+		if enrollment.sectionID, ok = parseID(row.values["section_id"]); !ok {
+			report.add(FileEnrollments, row.line, "section_id must be a positive ID")
+			continue
+		}
+		studentIDValue, email := row.values["student_id"], strings.ToLower(row.values["student_email"])
+		switch {
+		case studentIDValue != "" && email != "":
+			report.add(FileEnrollments, row.line, "give either student_id or student_email")
+			continue
+		case studentIDValue != "":
+			if enrollment.studentID, ok = parseID(studentIDValue); !ok {
+				report.add(FileEnrollments, row.line, "student_id must be a positive ID")
+				continue
+			}
+			enrollment.byID = true
+		case email != "":
+			if enrollment.studentID, ok = studentIDByEmail[email]; !ok {
+				report.add(FileEnrollments, row.line, "student_email "+strconv.Quote(email)+" is not a student of this import")
+				continue
+			}
+		default:
+			report.add(FileEnrollments, row.line, "student_id or student_email is required")
+			continue
+		}
+		if value := row.values["status"]; value != "" {
+			if err := enrollment.status.UnmarshalText([]byte(strings.ToLower(value))); err != nil {
+				report.add(FileEnrollments, row.line, "unknown status "+strconv.Quote(value))
+				continue
+			}
+			if enrollment.status != courseEnrollmentDomain.StatusActive && enrollment.status != courseEnrollmentDomain.StatusWaitlisted {
+				report.add(FileEnrollments, row.line, "status "+strconv.Quote(value)+" cannot be imported, use active or waitlisted")
+				continue
+			}
+		}
+
+		parsed = append(parsed, enrollment)
+		if enrollment.byID {
+			studentIDs = append(studentIDs, enrollment.studentID)
+		}
+		sectionIDs = append(sectionIDs, enrollment.sectionID)
+	}
+
+	if len(parsed) == 0 {
+		return 0, nil
+	}
+
+	studentByID, err := importUC.studentService.GetStudentsByIDs(ctx, studentIDs)
+	if err != nil {
+		return 0, err
+	}
+	sectionByID, err := importUC.sectionService.GetSectionsByIDs(ctx, sectionIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	lineByKey := make(map[[2]int64]int, len(parsed))
+	lineByCourse := make(map[[2]int64]int, len(parsed))
+	valid := 0
+	for _, enrollment := range parsed {
+		if _, ok := studentByID[enrollment.studentID]; enrollment.byID && !ok {
+			report.add(FileEnrollments, enrollment.line, "unknown student_id "+strconv.FormatInt(enrollment.studentID, 10))
+			continue
+		}
+		section, ok := sectionByID[enrollment.sectionID]
+		if !ok {
+			message := "unknown section_id " + strconv.FormatInt(enrollment.sectionID, 10)
+			if coursesImported {
+				message += ", courses of this import have no sections"
+			}
+			report.add(FileEnrollments, enrollment.line, message)
+			continue
+		}
+		key := [2]int64{enrollment.studentID, enrollment.sectionID}
+		if line, ok := lineByKey[key]; ok {
+			report.add(FileEnrollments, enrollment.line, "enrollment duplicates line "+strconv.Itoa(line))
+			continue
+		}
+		courseKey := [2]int64{enrollment.studentID, section.CourseID}
+		if line, ok := lineByCourse[courseKey]; ok {
+			report.add(FileEnrollments, enrollment.line, "student enrolls in the course on line "+strconv.Itoa(line))
+			continue
+		}
+		lineByKey[key] = enrollment.line
+		lineByCourse[courseKey] = enrollment.line
+
+		// Students created by this import have no enrollments yet
+		if enrollment.byID {
+			enrolled, err := importUC.enrolledInCourse(ctx, enrollment.studentID, section.CourseID)
+			if err != nil {
+				return 0, err
+			}
+			if enrolled {
+				report.add(FileEnrollments, enrollment.line, "student is already enrolled in the course")
+				continue
+			}
+		}
+
+		_, err := importUC.courseEnrollmentService.CreateEnrollment(ctx, enrollment.studentID, section.CourseID, enrollment.sectionID, enrollment.status)
+		if errors.Is(err, courseEnrollmentDomain.ErrEnrollmentAlreadyExists) {
+			report.add(FileEnrollments, enrollment.line, "student is already enrolled in the course")
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		valid++
+	}
+
+	return valid, nil
+}
+
+// enrolledInCourse reports whether the student holds an enrollment of the course that keeps them from
+// taking another section, only a cancelled, dropped or failed one does not.
+func (importUC *ImportUseCase) enrolledInCourse(ctx context.Context, studentID, courseID int64) (bool, error) {
+	enrollments, err := importUC.courseEnrollmentService.GetEnrollmentByStudentIDAndCourseID(ctx, studentID, courseID)
+	if err != nil {
+		return false, err
+	}
+	for _, enrollment := range enrollments {
+		switch enrollment.Status {
+		case courseEnrollmentDomain.StatusCancelled, courseEnrollmentDomain.StatusDropped, courseEnrollmentDomain.StatusFailed:
+		default:
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// importReport collects the invalid rows of an import.
+type importReport struct {
+	errors []ImportRowError
+}
+
+func (report *importReport) add(file string, line int, message string) {
+	report.errors = append(report.errors, ImportRowError{File: file, Line: line, Message: message})
+}
+
+// sorted returns the errors ordered by file and line.
+func (report *importReport) sorted() []ImportRowError {
+	sort.SliceStable(report.errors, func(i, j int) bool {
+		if report.errors[i].File != report.errors[j].File {
+			return fileOrder[report.errors[i].File] < fileOrder[report.errors[j].File]
+		}
+		return report.errors[i].Line < report.errors[j].Line
+	})
+
+	return report.errors
+}
+
+// readCSV reads the data rows of an import file with its header checked against columns, and the
+// number of data rows. Malformed rows are reported and left out; only a failure to read is returned.
+func (report *importReport) readCSV(file string, r io.Reader, columns importColumns) ([]csvRow, int, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 0 // every row has as many fields as the header
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		report.add(file, 1, "file is empty")
+		return nil, 0, nil
+	}
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			report.add(file, parseErr.StartLine, parseErr.Err.Error())
+			return nil, 0, nil
+		}
+		return nil, 0, err
+	}
+	for i, column := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")))
+	}
+	if message := columns.check(header); message != "" {
+		report.add(file, 1, message)
+		return nil, 0, nil
+	}
+
+	var rows []csvRow
+	count := 0
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		count++
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, 0, err
+			}
+			report.add(file, parseErr.StartLine, parseErr.Err.Error())
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+		if count > MaxImportRows {
+			report.add(file, line, "file has more than "+strconv.Itoa(MaxImportRows)+" rows")
+			break
+		}
+
+		values := make(map[string]string, len(header))
+		for i, column := range header {
+			values[column] = strings.TrimSpace(record[i])
+		}
+		rows = append(rows, csvRow{line: line, values: values})
+	}
+
+	return rows, count, nil
+}
+
+// check returns why the header does not match the columns, or "" when it does.
+func (columns importColumns) check(header []string) string {
+	known := make(map[string]bool, len(columns.required)+len(columns.optional))
+	for _, column := range columns.required {
+		known[column] = true
+	}
+	for _, column := range columns.optional {
+		known[column] = true
+	}
+
+	seen := make(map[string]bool, len(header))
+	for _, column := range header {
+		if !known[column] {
+			return "unknown column " + strconv.Quote(column)
+		}
+		if seen[column] {
+			return "duplicate column " + strconv.Quote(column)
+		}
+		seen[column] = true
+	}
+	for _, column := range columns.required {
+		if !seen[column] {
+			return "missing column " + strconv.Quote(column)
+		}
+	}
+
+	return ""
+}
+
+// parseID parses a positive ID.
+func parseID(value string) (int64, bool) {
+	id, err := strconv.ParseInt(value, 10, 64)
+	return id, err == nil && id > 0
+}
+
+// importErrorMessage maps errors to the message returned to the client, or fallback when there is none.
+func importErrorMessage(err error, fallback string) string {
+	switch {
+	case errors.Is(err, auth.ErrUnauthenticated):
+		return "authentication required"
+	case errors.Is(err, auth.ErrForbidden):
+		return "permission denied"
+	default:
+		return fallback
+	}
+}
@@ -0,0 +1,368 @@
+package importusecase
+
+import (
+	"context"
+	"errors"
+	common "github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/auth"
+	"github/rakadityas/course-management-system/common/testutil"
+	"github/rakadityas/course-management-system/common/transaction"
+	transactionMock "github/rakadityas/course-management-system/common/transaction/mocks"
+	courseDomain "github/rakadityas/course-management-system/domain/course"
+	courseEnrollmentDomain "github/rakadityas/course-management-system/domain/course-enrollment"
+	courseEnrollmentDomainMock "github/rakadityas/course-management-system/domain/course-enrollment/mocks"
+	courseDomainMock "github/rakadityas/course-management-system/domain/course/mocks"
+	sectionDomain "github/rakadityas/course-management-system/domain/section"
+	sectionDomainMock "github/rakadityas/course-management-system/domain/section/mocks"
+	studentDomain "github/rakadityas/course-management-system/domain/student"
+	studentDomainMock "github/rakadityas/course-management-system/domain/student/mocks"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// adminCtx is authenticated as an administrator.
+var adminCtx = testutil.AdminCtx(auth.PermManageStudents, auth.PermManageCourses, auth.PermManageEnrollments)
+
+func TestImportUseCase_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	csvFile := func(lines ...string) io.Reader {
+		return strings.NewReader(strings.Join(lines, "\n") + "\n")
+	}
+
+	type fields struct {
+		studentService          studentDomain.StudentDomainItf
+		courseService           courseDomain.CourseDomainItf
+		sectionService          sectionDomain.SectionDomainItf
+		courseEnrollmentService courseEnrollmentDomain.CourseEnrollmentDomainItf
+		unitOfWork              transaction.UnitOfWork
+	}
+	type args struct {
+		ctx context.Context
+		req ImportRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    ImportResp
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().CreateStudent(gomock.Any(), "new1@example.com").Return(studentDomain.Student{ID: 10, Email: "new1@example.com"}, nil)
+					mock.EXPECT().CreateStudent(gomock.Any(), "new2@example.com").Return(studentDomain.Student{ID: 11, Email: "new2@example.com"}, nil)
+					mock.EXPECT().GetStudentsByIDs(gomock.Any(), []int64{5}).Return(map[int64]studentDomain.Student{5: {ID: 5}}, nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().CreateCourse(gomock.Any(), "Biology", 30, 4).Return(courseDomain.Course{ID: 1}, nil)
+					mock.EXPECT().CreateCourse(gomock.Any(), "Chemistry", 20, courseDomain.DefaultCreditHours).Return(courseDomain.Course{ID: 2}, nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionsByIDs(gomock.Any(), []int64{201, 201}).Return(map[int64]sectionDomain.Section{201: {ID: 201, CourseID: 101}}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().CreateEnrollment(gomock.Any(), int64(10), int64(101), int64(201), courseEnrollmentDomain.StatusActive).Return(courseEnrollmentDomain.CourseEnrollment{ID: 1}, nil)
+					mock.EXPECT().GetEnrollmentByStudentIDAndCourseID(gomock.Any(), int64(5), int64(101)).Return([]courseEnrollmentDomain.CourseEnrollment{
+						{ID: 3, StudentID: 5, CourseID: 101, SectionID: 202, Status: courseEnrollmentDomain.StatusDropped},
+					}, nil)
+					mock.EXPECT().CreateEnrollment(gomock.Any(), int64(5), int64(101), int64(201), courseEnrollmentDomain.StatusWaitlisted).Return(courseEnrollmentDomain.CourseEnrollment{ID: 2}, nil)
+					return mock
+				}(),
+				unitOfWork: testutil.NewPassThroughUnitOfWork(ctrl),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: ImportRequest{
+					Students:    csvFile("\ufeffEmail", "new1@example.com", " NEW2@example.com "),
+					Courses:     csvFile("name,capacity,credit_hours", "Biology,30,4", "Chemistry,20,"),
+					Enrollments: csvFile("student_id,student_email,section_id,status", ",new1@example.com,201,", "5,,201,Waitlisted"),
+				},
+			},
+			want: ImportResp{
+				Status:      common.StatusSuccess,
+				Students:    &ImportFileResult{Rows: 2, Valid: 2},
+				Courses:     &ImportFileResult{Rows: 2, Valid: 2},
+				Enrollments: &ImportFileResult{Rows: 2, Valid: 2},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Invalid Rows",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().CreateStudent(gomock.Any(), "new1@example.com").Return(studentDomain.Student{ID: 10, Email: "new1@example.com"}, nil)
+					mock.EXPECT().CreateStudent(gomock.Any(), "not-an-email").Return(studentDomain.Student{}, studentDomain.ErrInvalidEmail)
+					mock.EXPECT().CreateStudent(gomock.Any(), "taken@example.com").Return(studentDomain.Student{}, studentDomain.ErrEmailAlreadyExists)
+					mock.EXPECT().GetStudentsByIDs(gomock.Any(), []int64{7, 7}).Return(map[int64]studentDomain.Student{}, nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().CreateCourse(gomock.Any(), "Biology", 30, courseDomain.DefaultCreditHours).Return(courseDomain.Course{ID: 1}, nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionsByIDs(gomock.Any(), []int64{201, 999, 201}).Return(map[int64]sectionDomain.Section{201: {ID: 201, CourseID: 101}}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().CreateEnrollment(gomock.Any(), int64(10), int64(101), int64(201), courseEnrollmentDomain.StatusActive).Return(courseEnrollmentDomain.CourseEnrollment{}, courseEnrollmentDomain.ErrEnrollmentAlreadyExists)
+					return mock
+				}(),
+				unitOfWork: testutil.NewPassThroughUnitOfWork(ctrl),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: ImportRequest{
+					Students:    csvFile("email", "new1@example.com", "not-an-email", "taken@example.com", "New1@example.com", ""),
+					Courses:     csvFile("name,capacity", "Biology,30", "Chemistry,many", "biology,10", "Physics"),
+					Enrollments: csvFile("student_id,student_email,section_id,status", ",new1@example.com,201,", "7,,999,", ",other@example.com,201,", ",new1@example.com,201,passed", "7,,201,"),
+				},
+			},
+			want: ImportResp{
+				Status:      common.StatusFailure,
+				Message:     "11 invalid rows, nothing was imported",
+				Students:    &ImportFileResult{Rows: 4, Valid: 1},
+				Courses:     &ImportFileResult{Rows: 4, Valid: 1},
+				Enrollments: &ImportFileResult{Rows: 5, Valid: 0},
+				Errors: []ImportRowError{
+					{File: FileStudents, Line: 3, Message: "invalid email address"},
+					{File: FileStudents, Line: 4, Message: "email is already registered"},
+					{File: FileStudents, Line: 5, Message: "email duplicates line 2"},
+					{File: FileCourses, Line: 3, Message: "capacity must be a whole number"},
+					{File: FileCourses, Line: 4, Message: "name duplicates line 2"},
+					{File: FileCourses, Line: 5, Message: "wrong number of fields"},
+					{File: FileEnrollments, Line: 2, Message: "student is already enrolled in the course"},
+					{File: FileEnrollments, Line: 3, Message: "unknown student_id 7"},
+					{File: FileEnrollments, Line: 4, Message: `student_email "other@example.com" is not a student of this import`},
+					{File: FileEnrollments, Line: 5, Message: `unknown status "passed"`},
+					{File: FileEnrollments, Line: 6, Message: "unknown student_id 7"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Section Of Imported Course",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentsByIDs(gomock.Any(), []int64{5}).Return(map[int64]studentDomain.Student{5: {ID: 5}}, nil)
+					return mock
+				}(),
+				courseService: func() courseDomain.CourseDomainItf {
+					mock := courseDomainMock.NewMockCourseDomainItf(ctrl)
+					mock.EXPECT().CreateCourse(gomock.Any(), "Biology", 30, courseDomain.DefaultCreditHours).Return(courseDomain.Course{ID: 1}, nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionsByIDs(gomock.Any(), []int64{999}).Return(map[int64]sectionDomain.Section{}, nil)
+					return mock
+				}(),
+				unitOfWork: testutil.NewPassThroughUnitOfWork(ctrl),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: ImportRequest{
+					Courses:     csvFile("name,capacity", "Biology,30"),
+					Enrollments: csvFile("student_id,section_id", "5,999"),
+				},
+			},
+			want: ImportResp{
+				Status:      common.StatusFailure,
+				Message:     "1 invalid rows, nothing was imported",
+				Courses:     &ImportFileResult{Rows: 1, Valid: 1},
+				Enrollments: &ImportFileResult{Rows: 1, Valid: 0},
+				Errors: []ImportRowError{
+					{File: FileEnrollments, Line: 2, Message: "unknown section_id 999, courses of this import have no sections"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Enrollment Rules",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentsByIDs(gomock.Any(), []int64{2, 3, 3}).Return(map[int64]studentDomain.Student{2: {ID: 2}, 3: {ID: 3}}, nil)
+					return mock
+				}(),
+				sectionService: func() sectionDomain.SectionDomainItf {
+					mock := sectionDomainMock.NewMockSectionDomainItf(ctrl)
+					mock.EXPECT().GetSectionsByIDs(gomock.Any(), []int64{201, 201, 202}).Return(map[int64]sectionDomain.Section{
+						201: {ID: 201, CourseID: 101},
+						202: {ID: 202, CourseID: 101},
+					}, nil)
+					return mock
+				}(),
+				courseEnrollmentService: func() courseEnrollmentDomain.CourseEnrollmentDomainItf {
+					mock := courseEnrollmentDomainMock.NewMockCourseEnrollmentDomainItf(ctrl)
+					mock.EXPECT().GetEnrollmentByStudentIDAndCourseID(gomock.Any(), int64(2), int64(101)).Return([]courseEnrollmentDomain.CourseEnrollment{
+						{ID: 1, StudentID: 2, CourseID: 101, SectionID: 202, Status: courseEnrollmentDomain.StatusWaitlisted},
+					}, nil)
+					mock.EXPECT().GetEnrollmentByStudentIDAndCourseID(gomock.Any(), int64(3), int64(101)).Return(nil, nil)
+					mock.EXPECT().CreateEnrollment(gomock.Any(), int64(3), int64(101), int64(201), courseEnrollmentDomain.StatusActive).Return(courseEnrollmentDomain.CourseEnrollment{ID: 2}, nil)
+					return mock
+				}(),
+				unitOfWork: testutil.NewPassThroughUnitOfWork(ctrl),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: ImportRequest{
+					Enrollments: csvFile("student_id,section_id,status", "1,201,completed", "1,201,cancelled", "2,201,", "3,201,active", "3,202,waitlisted"),
+				},
+			},
+			want: ImportResp{
+				Status:      common.StatusFailure,
+				Message:     "4 invalid rows, nothing was imported",
+				Enrollments: &ImportFileResult{Rows: 5, Valid: 1},
+				Errors: []ImportRowError{
+					{File: FileEnrollments, Line: 2, Message: `status "completed" cannot be imported, use active or waitlisted`},
+					{File: FileEnrollments, Line: 3, Message: `status "cancelled" cannot be imported, use active or waitlisted`},
+					{File: FileEnrollments, Line: 4, Message: "student is already enrolled in the course"},
+					{File: FileEnrollments, Line: 6, Message: "student enrolls in the course on line 5"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Dry Run",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().CreateStudent(gomock.Any(), "new1@example.com").Return(studentDomain.Student{ID: 10, Email: "new1@example.com"}, nil)
+					return mock
+				}(),
+				unitOfWork: func() transaction.UnitOfWork {
+					mock := transactionMock.NewMockUnitOfWork(ctrl)
+					mock.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						if err := fn(ctx); !errors.Is(err, errRollback) {
+							t.Errorf("dry run was not rolled back: %v", err)
+						}
+						return errRollback
+					})
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: ImportRequest{Students: csvFile("email", "new1@example.com"), DryRun: true},
+			},
+			want: ImportResp{
+				Status:   common.StatusSuccess,
+				Message:  "dry run, nothing was imported",
+				DryRun:   true,
+				Students: &ImportFileResult{Rows: 1, Valid: 1},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Invalid Header",
+			fields: fields{
+				unitOfWork: testutil.NewPassThroughUnitOfWork(ctrl),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: ImportRequest{
+					Courses:     csvFile("name,seats", "Biology,30"),
+					Enrollments: csvFile("student_id,status", "1,active"),
+				},
+			},
+			want: ImportResp{
+				Status:      common.StatusFailure,
+				Message:     "2 invalid rows, nothing was imported",
+				Courses:     &ImportFileResult{},
+				Enrollments: &ImportFileResult{},
+				Errors: []ImportRowError{
+					{File: FileCourses, Line: 1, Message: `unknown column "seats"`},
+					{File: FileEnrollments, Line: 1, Message: `missing column "section_id"`},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Failed to Create Student",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().CreateStudent(gomock.Any(), "new1@example.com").Return(studentDomain.Student{}, errors.New("database error"))
+					return mock
+				}(),
+				unitOfWork: testutil.NewPassThroughUnitOfWork(ctrl),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: ImportRequest{Students: csvFile("email", "new1@example.com")},
+			},
+			want: ImportResp{
+				Status:  common.StatusFailure,
+				Message: "failed to import",
+			},
+			wantErr: true,
+		},
+		{
+			name:   "Missing Permission For File",
+			fields: fields{},
+			args: args{
+				ctx: auth.WithPrincipal(context.Background(), auth.Principal{Subject: "registrar", Permissions: []auth.Permission{auth.PermManageStudents}}),
+				req: ImportRequest{Students: csvFile("email"), Courses: csvFile("name,capacity")},
+			},
+			want: ImportResp{
+				Status:  common.StatusFailure,
+				Message: "permission denied",
+			},
+			wantErr: true,
+		},
+		{
+			name:   "No Files",
+			fields: fields{},
+			args: args{
+				ctx: adminCtx,
+				req: ImportRequest{DryRun: true},
+			},
+			want: ImportResp{
+				Status:  common.StatusFailure,
+				Message: "no file to import",
+				DryRun:  true,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			importUC := &ImportUseCase{
+				studentService:          tt.fields.studentService,
+				courseService:           tt.fields.courseService,
+				sectionService:          tt.fields.sectionService,
+				courseEnrollmentService: tt.fields.courseEnrollmentService,
+				unitOfWork:              tt.fields.unitOfWork,
+			}
+			got, err := importUC.Import(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ImportUseCase.Import() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ImportUseCase.Import() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
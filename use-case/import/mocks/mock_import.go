@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: use-case/import/import.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	importusecase "github/rakadityas/course-management-system/use-case/import"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockImportUseCaseItf is a mock of ImportUseCaseItf interface.
+type MockImportUseCaseItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportUseCaseItfMockRecorder
+}
+
+// MockImportUseCaseItfMockRecorder is the mock recorder for MockImportUseCaseItf.
+type MockImportUseCaseItfMockRecorder struct {
+	mock *MockImportUseCaseItf
+}
+
+// NewMockImportUseCaseItf creates a new mock instance.
+func NewMockImportUseCaseItf(ctrl *gomock.Controller) *MockImportUseCaseItf {
+	mock := &MockImportUseCaseItf{ctrl: ctrl}
+	mock.recorder = &MockImportUseCaseItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportUseCaseItf) EXPECT() *MockImportUseCaseItfMockRecorder {
+	return m.recorder
+}
+
+// Import mocks base method.
+func (m *MockImportUseCaseItf) Import(ctx context.Context, req importusecase.ImportRequest) (importusecase.ImportResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, req)
+	ret0, _ := ret[0].(importusecase.ImportResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockImportUseCaseItfMockRecorder) Import(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockImportUseCaseItf)(nil).Import), ctx, req)
+}
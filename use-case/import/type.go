@@ -0,0 +1,52 @@
+package importusecase
+
+import "io"
+
+// Import related
+type (
+	// ImportRequest holds the CSV files to import, a nil file is left out.
+	// A dry run validates every row the same way and then rolls the import back.
+	ImportRequest struct {
+		Students    io.Reader
+		Courses     io.Reader
+		Enrollments io.Reader
+		DryRun      bool
+	}
+
+	// ImportResp represents the response structure for an import. The file results are set for
+	// the files given, and Errors lists every invalid row ordered by file and line.
+	ImportResp struct {
+		Status      string            `json:"status"`
+		Message     string            `json:"message,omitempty"`
+		DryRun      bool              `json:"dry_run"`
+		Students    *ImportFileResult `json:"students,omitempty"`
+		Courses     *ImportFileResult `json:"courses,omitempty"`
+		Enrollments *ImportFileResult `json:"enrollments,omitempty"`
+		Errors      []ImportRowError  `json:"errors,omitempty"`
+	}
+
+	// ImportFileResult counts the data rows of an import file and those that passed validation.
+	ImportFileResult struct {
+		Rows  int `json:"rows"`
+		Valid int `json:"valid"`
+	}
+
+	// ImportRowError reports an invalid line of an import file, line 1 being the header.
+	ImportRowError struct {
+		File    string `json:"file"`
+		Line    int    `json:"line"`
+		Message string `json:"message"`
+	}
+)
+
+// importColumns are the columns an import file must and may have.
+type importColumns struct {
+	required []string
+	optional []string
+}
+
+// csvRow is a data row of an import file keyed by column, with the line it starts on.
+type csvRow struct {
+	line   int
+	values map[string]string
+}
@@ -0,0 +1,7 @@
+package studentusecase
+
+// Pagination defaults for listing students.
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+)
@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: use-case/student/student.go
+
+// Package studentusecase is a generated GoMock package.
+package studentusecase
+
+import (
+	context "context"
+	studentusecase "github/rakadityas/course-management-system/use-case/student"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStudentUseCaseItf is a mock of StudentUseCaseItf interface.
+type MockStudentUseCaseItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockStudentUseCaseItfMockRecorder
+}
+
+// MockStudentUseCaseItfMockRecorder is the mock recorder for MockStudentUseCaseItf.
+type MockStudentUseCaseItfMockRecorder struct {
+	mock *MockStudentUseCaseItf
+}
+
+// NewMockStudentUseCaseItf creates a new mock instance.
+func NewMockStudentUseCaseItf(ctrl *gomock.Controller) *MockStudentUseCaseItf {
+	mock := &MockStudentUseCaseItf{ctrl: ctrl}
+	mock.recorder = &MockStudentUseCaseItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStudentUseCaseItf) EXPECT() *MockStudentUseCaseItfMockRecorder {
+	return m.recorder
+}
+
+// CreateStudent mocks base method.
+func (m *MockStudentUseCaseItf) CreateStudent(ctx context.Context, req studentusecase.CreateStudentRequest) (studentusecase.StudentResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStudent", ctx, req)
+	ret0, _ := ret[0].(studentusecase.StudentResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStudent indicates an expected call of CreateStudent.
+func (mr *MockStudentUseCaseItfMockRecorder) CreateStudent(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStudent", reflect.TypeOf((*MockStudentUseCaseItf)(nil).CreateStudent), ctx, req)
+}
+
+// DeleteStudent mocks base method.
+func (m *MockStudentUseCaseItf) DeleteStudent(ctx context.Context, studentID int64) (studentusecase.DeleteStudentResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStudent", ctx, studentID)
+	ret0, _ := ret[0].(studentusecase.DeleteStudentResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStudent indicates an expected call of DeleteStudent.
+func (mr *MockStudentUseCaseItfMockRecorder) DeleteStudent(ctx, studentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStudent", reflect.TypeOf((*MockStudentUseCaseItf)(nil).DeleteStudent), ctx, studentID)
+}
+
+// GetPrivacySettings mocks base method.
+func (m *MockStudentUseCaseItf) GetPrivacySettings(ctx context.Context, studentID int64) (studentusecase.PrivacySettingsResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrivacySettings", ctx, studentID)
+	ret0, _ := ret[0].(studentusecase.PrivacySettingsResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrivacySettings indicates an expected call of GetPrivacySettings.
+func (mr *MockStudentUseCaseItfMockRecorder) GetPrivacySettings(ctx, studentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivacySettings", reflect.TypeOf((*MockStudentUseCaseItf)(nil).GetPrivacySettings), ctx, studentID)
+}
+
+// ListStudents mocks base method.
+func (m *MockStudentUseCaseItf) ListStudents(ctx context.Context, req studentusecase.ListStudentsRequest) (studentusecase.ListStudentsResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStudents", ctx, req)
+	ret0, _ := ret[0].(studentusecase.ListStudentsResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStudents indicates an expected call of ListStudents.
+func (mr *MockStudentUseCaseItfMockRecorder) ListStudents(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStudents", reflect.TypeOf((*MockStudentUseCaseItf)(nil).ListStudents), ctx, req)
+}
+
+// UpdatePrivacySettings mocks base method.
+func (m *MockStudentUseCaseItf) UpdatePrivacySettings(ctx context.Context, req studentusecase.UpdatePrivacySettingsRequest) (studentusecase.PrivacySettingsResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePrivacySettings", ctx, req)
+	ret0, _ := ret[0].(studentusecase.PrivacySettingsResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePrivacySettings indicates an expected call of UpdatePrivacySettings.
+func (mr *MockStudentUseCaseItfMockRecorder) UpdatePrivacySettings(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePrivacySettings", reflect.TypeOf((*MockStudentUseCaseItf)(nil).UpdatePrivacySettings), ctx, req)
+}
+
+// UpdateStudentEmail mocks base method.
+func (m *MockStudentUseCaseItf) UpdateStudentEmail(ctx context.Context, req studentusecase.UpdateStudentEmailRequest) (studentusecase.StudentResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStudentEmail", ctx, req)
+	ret0, _ := ret[0].(studentusecase.StudentResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStudentEmail indicates an expected call of UpdateStudentEmail.
+func (mr *MockStudentUseCaseItfMockRecorder) UpdateStudentEmail(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStudentEmail", reflect.TypeOf((*MockStudentUseCaseItf)(nil).UpdateStudentEmail), ctx, req)
+}
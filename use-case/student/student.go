@@ -0,0 +1,256 @@
+package studentusecase
+
+import (
+	"context"
+	"errors"
+
+	common "github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/auth"
+	"github/rakadityas/course-management-system/common/transaction"
+	studentDomain "github/rakadityas/course-management-system/domain/student"
+	enrollmentUseCase "github/rakadityas/course-management-system/use-case/enrollment"
+)
+
+// StudentUseCaseItf defines the interface for the StudentUseCase.
+type StudentUseCaseItf interface {
+	CreateStudent(ctx context.Context, req CreateStudentRequest) (StudentResp, error)
+	UpdateStudentEmail(ctx context.Context, req UpdateStudentEmailRequest) (StudentResp, error)
+	ListStudents(ctx context.Context, req ListStudentsRequest) (ListStudentsResp, error)
+	DeleteStudent(ctx context.Context, studentID int64) (DeleteStudentResp, error)
+	GetPrivacySettings(ctx context.Context, studentID int64) (PrivacySettingsResp, error)
+	UpdatePrivacySettings(ctx context.Context, req UpdatePrivacySettingsRequest) (PrivacySettingsResp, error)
+}
+
+type StudentUseCase struct {
+	studentService    studentDomain.StudentDomainItf
+	enrollmentUseCase enrollmentUseCase.EnrollmentUseCaseItf
+	unitOfWork        transaction.UnitOfWork
+}
+
+func NewStudentUseCase(studentService studentDomain.StudentDomainItf, enrollmentUC enrollmentUseCase.EnrollmentUseCaseItf, unitOfWork transaction.UnitOfWork) StudentUseCaseItf {
+	return &StudentUseCase{
+		studentService:    studentService,
+		enrollmentUseCase: enrollmentUC,
+		unitOfWork:        unitOfWork,
+	}
+}
+
+// CreateStudent registers a new student.
+func (studentUC *StudentUseCase) CreateStudent(ctx context.Context, req CreateStudentRequest) (StudentResp, error) {
+	// Only student managers may manage student records
+	if err := auth.Authorize(ctx, auth.PermManageStudents); err != nil {
+		return StudentResp{Status: common.StatusFailure, Message: studentErrorMessage(err, "failed to create student")}, err
+	}
+
+	student, err := studentUC.studentService.CreateStudent(ctx, req.Email)
+	if err != nil {
+		return StudentResp{Status: common.StatusFailure, Message: studentErrorMessage(err, "failed to create student")}, err
+	}
+
+	return StudentResp{
+		Status:      common.StatusSuccess,
+		StudentData: toStudentDetail(student),
+	}, nil
+}
+
+// UpdateStudentEmail changes the email of an existing student.
+func (studentUC *StudentUseCase) UpdateStudentEmail(ctx context.Context, req UpdateStudentEmailRequest) (StudentResp, error) {
+	// Only student managers may manage student records
+	if err := auth.Authorize(ctx, auth.PermManageStudents); err != nil {
+		return StudentResp{Status: common.StatusFailure, Message: studentErrorMessage(err, "failed to update student email")}, err
+	}
+
+	// Ensure the student data exists
+	studentData, err := studentUC.studentService.GetStudentByID(ctx, req.StudentID)
+	if err != nil {
+		return StudentResp{Status: common.StatusFailure, Message: "failed to retrieve student data"}, err
+	}
+	if studentData == nil {
+		return StudentResp{Status: common.StatusFailure, Message: "student data not found"}, nil
+	}
+
+	err = studentUC.studentService.UpdateStudentEmail(ctx, req.StudentID, req.Email)
+	if err != nil {
+		return StudentResp{Status: common.StatusFailure, Message: studentErrorMessage(err, "failed to update student email")}, err
+	}
+
+	// Re-read so the response reflects the stored email and update time
+	studentData, err = studentUC.studentService.GetStudentByID(ctx, req.StudentID)
+	if err != nil {
+		return StudentResp{Status: common.StatusFailure, Message: "failed to retrieve student data"}, err
+	}
+	if studentData == nil {
+		return StudentResp{Status: common.StatusFailure, Message: "student data not found"}, nil
+	}
+
+	return StudentResp{
+		Status:      common.StatusSuccess,
+		StudentData: toStudentDetail(*studentData),
+	}, nil
+}
+
+// ListStudents retrieves a page of active students.
+func (studentUC *StudentUseCase) ListStudents(ctx context.Context, req ListStudentsRequest) (ListStudentsResp, error) {
+	// Only student managers may manage student records
+	if err := auth.Authorize(ctx, auth.PermManageStudents); err != nil {
+		return ListStudentsResp{Status: common.StatusFailure, Message: studentErrorMessage(err, "failed to retrieve students")}, err
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = DefaultListLimit
+	}
+	if limit > MaxListLimit {
+		limit = MaxListLimit
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	students, err := studentUC.studentService.GetStudents(ctx, limit, offset)
+	if err != nil {
+		return ListStudentsResp{Status: common.StatusFailure, Message: "failed to retrieve students"}, err
+	}
+
+	var studentDetails []StudentDetail
+	for _, student := range students {
+		studentDetails = append(studentDetails, *toStudentDetail(student))
+	}
+
+	return ListStudentsResp{
+		Status:   common.StatusSuccess,
+		Students: studentDetails,
+		Limit:    limit,
+		Offset:   offset,
+	}, nil
+}
+
+// DeleteStudent cancels the student's active enrollments and soft-deletes the student.
+func (studentUC *StudentUseCase) DeleteStudent(ctx context.Context, studentID int64) (DeleteStudentResp, error) {
+	// Only student managers may manage student records
+	if err := auth.Authorize(ctx, auth.PermManageStudents); err != nil {
+		return DeleteStudentResp{Status: common.StatusFailure, Message: studentErrorMessage(err, "failed to delete student")}, err
+	}
+
+	// Ensure the student data exists
+	studentData, err := studentUC.studentService.GetStudentByID(ctx, studentID)
+	if err != nil {
+		return DeleteStudentResp{Status: common.StatusFailure, Message: "failed to retrieve student data"}, err
+	}
+	if studentData == nil {
+		return DeleteStudentResp{Status: common.StatusFailure, Message: "student data not found"}, nil
+	}
+
+	// Release the seats held by the student and delete it atomically,
+	// so deleted students never show up as classmates
+	var resp DeleteStudentResp
+	err = studentUC.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		// The enrollment use case hands the freed seats to the section waitlists
+		err := studentUC.enrollmentUseCase.CancelStudentEnrollments(ctx, studentID)
+		if err != nil {
+			resp = DeleteStudentResp{Status: common.StatusFailure, Message: "failed to cancel course enrollment"}
+			return err
+		}
+
+		err = studentUC.studentService.DeleteStudent(ctx, studentID)
+		if err != nil {
+			resp = DeleteStudentResp{Status: common.StatusFailure, Message: "failed to delete student"}
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return resp, err
+	}
+
+	return DeleteStudentResp{
+		Status: common.StatusSuccess,
+	}, nil
+}
+
+// GetPrivacySettings retrieves the privacy settings of a student.
+func (studentUC *StudentUseCase) GetPrivacySettings(ctx context.Context, studentID int64) (PrivacySettingsResp, error) {
+	// Students manage their own privacy, student managers anyone's
+	if err := auth.AuthorizeSelf(ctx, studentID, auth.PermManageStudents); err != nil {
+		return PrivacySettingsResp{Status: common.StatusFailure, Message: studentErrorMessage(err, "failed to retrieve privacy settings")}, err
+	}
+
+	studentData, err := studentUC.studentService.GetStudentByID(ctx, studentID)
+	if err != nil {
+		return PrivacySettingsResp{Status: common.StatusFailure, Message: "failed to retrieve student data"}, err
+	}
+	if studentData == nil {
+		return PrivacySettingsResp{Status: common.StatusFailure, Message: "student data not found"}, nil
+	}
+
+	return PrivacySettingsResp{
+		Status:  common.StatusSuccess,
+		Privacy: toPrivacySettingsDetail(*studentData),
+	}, nil
+}
+
+// UpdatePrivacySettings replaces the privacy settings of a student.
+func (studentUC *StudentUseCase) UpdatePrivacySettings(ctx context.Context, req UpdatePrivacySettingsRequest) (PrivacySettingsResp, error) {
+	// Students manage their own privacy, student managers anyone's
+	if err := auth.AuthorizeSelf(ctx, req.StudentID, auth.PermManageStudents); err != nil {
+		return PrivacySettingsResp{Status: common.StatusFailure, Message: studentErrorMessage(err, "failed to update privacy settings")}, err
+	}
+
+	// Ensure the student data exists
+	studentData, err := studentUC.studentService.GetStudentByID(ctx, req.StudentID)
+	if err != nil {
+		return PrivacySettingsResp{Status: common.StatusFailure, Message: "failed to retrieve student data"}, err
+	}
+	if studentData == nil {
+		return PrivacySettingsResp{Status: common.StatusFailure, Message: "student data not found"}, nil
+	}
+
+	settings := studentDomain.PrivacySettings{Visibility: req.Visibility, ShowEmail: req.ShowEmail}
+	err = studentUC.studentService.UpdatePrivacySettings(ctx, req.StudentID, settings)
+	if err != nil {
+		return PrivacySettingsResp{Status: common.StatusFailure, Message: studentErrorMessage(err, "failed to update privacy settings")}, err
+	}
+
+	studentData.Privacy = settings
+	return PrivacySettingsResp{
+		Status:  common.StatusSuccess,
+		Privacy: toPrivacySettingsDetail(*studentData),
+	}, nil
+}
+
+// studentErrorMessage maps known student domain errors to a client facing message.
+func studentErrorMessage(err error, fallback string) string {
+	switch {
+	case errors.Is(err, studentDomain.ErrEmailAlreadyExists):
+		return "email is already registered"
+	case errors.Is(err, studentDomain.ErrInvalidEmail):
+		return "invalid email address"
+	case errors.Is(err, studentDomain.ErrInvalidVisibility):
+		return "invalid visibility"
+	case errors.Is(err, auth.ErrUnauthenticated):
+		return "authentication required"
+	case errors.Is(err, auth.ErrForbidden):
+		return "permission denied"
+	default:
+		return fallback
+	}
+}
+
+func toStudentDetail(student studentDomain.Student) *StudentDetail {
+	return &StudentDetail{
+		StudentID:  student.ID,
+		Email:      student.Email,
+		CreateTime: student.CreateTime,
+		UpdateTime: student.UpdateTime,
+	}
+}
+
+func toPrivacySettingsDetail(student studentDomain.Student) *PrivacySettingsDetail {
+	return &PrivacySettingsDetail{
+		StudentID:  student.ID,
+		Visibility: student.Privacy.Visibility,
+		ShowEmail:  student.Privacy.ShowEmail,
+	}
+}
@@ -0,0 +1,595 @@
+package studentusecase
+
+import (
+	"context"
+	"errors"
+	common "github/rakadityas/course-management-system/common"
+	"github/rakadityas/course-management-system/common/auth"
+	"github/rakadityas/course-management-system/common/testutil"
+	studentDomain "github/rakadityas/course-management-system/domain/student"
+	studentDomainMock "github/rakadityas/course-management-system/domain/student/mocks"
+	enrollmentUseCase "github/rakadityas/course-management-system/use-case/enrollment"
+	enrollmentUseCaseMock "github/rakadityas/course-management-system/use-case/enrollment/mocks"
+	"reflect"
+	"testing"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// adminCtx is authenticated as an administrator.
+var adminCtx = testutil.AdminCtx(auth.PermManageStudents)
+
+// studentCtx is authenticated as the given student.
+func studentCtx(studentID int64) context.Context {
+	return auth.WithPrincipal(context.Background(), auth.Principal{
+		Subject:   auth.StudentSubject(studentID),
+		StudentID: studentID,
+		Roles:     []string{auth.RoleStudent},
+	})
+}
+
+func TestStudentUseCase_CreateStudent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const studentEmail = "student@example.com"
+	timestamp := time.Date(2023, 8, 25, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		studentService studentDomain.StudentDomainItf
+	}
+	type args struct {
+		ctx context.Context
+		req CreateStudentRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    StudentResp
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().CreateStudent(gomock.Any(), studentEmail).Return(studentDomain.Student{ID: 1, Email: studentEmail, CreateTime: timestamp, UpdateTime: timestamp}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: CreateStudentRequest{Email: studentEmail},
+			},
+			want: StudentResp{
+				Status:      common.StatusSuccess,
+				StudentData: &StudentDetail{StudentID: 1, Email: studentEmail, CreateTime: timestamp, UpdateTime: timestamp},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Email Already Exists",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().CreateStudent(gomock.Any(), studentEmail).Return(studentDomain.Student{}, studentDomain.ErrEmailAlreadyExists)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: CreateStudentRequest{Email: studentEmail},
+			},
+			want:    StudentResp{Status: common.StatusFailure, Message: "email is already registered"},
+			wantErr: true,
+		},
+		{
+			name: "Invalid Email",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().CreateStudent(gomock.Any(), "invalid").Return(studentDomain.Student{}, studentDomain.ErrInvalidEmail)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: CreateStudentRequest{Email: "invalid"},
+			},
+			want:    StudentResp{Status: common.StatusFailure, Message: "invalid email address"},
+			wantErr: true,
+		},
+		{
+			name: "Not A Student Manager",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					return studentDomainMock.NewMockStudentDomainItf(ctrl)
+				}(),
+			},
+			args: args{
+				ctx: auth.WithPrincipal(context.Background(), auth.Principal{Subject: "instructor:1", Roles: []string{auth.RoleInstructor}, Permissions: []auth.Permission{auth.PermViewRosters}}),
+				req: CreateStudentRequest{Email: studentEmail},
+			},
+			want:    StudentResp{Status: common.StatusFailure, Message: "permission denied"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			studentUC := &StudentUseCase{
+				studentService: tt.fields.studentService,
+			}
+			got, err := studentUC.CreateStudent(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StudentUseCase.CreateStudent() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StudentUseCase.CreateStudent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStudentUseCase_UpdateStudentEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const (
+		studentID int64 = 1
+		newEmail        = "new@example.com"
+	)
+	timestamp := time.Date(2023, 8, 25, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		studentService studentDomain.StudentDomainItf
+	}
+	type args struct {
+		ctx context.Context
+		req UpdateStudentEmailRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    StudentResp
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					gomock.InOrder(
+						mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "old@example.com"}, nil),
+						mock.EXPECT().UpdateStudentEmail(gomock.Any(), studentID, newEmail).Return(nil),
+						mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: newEmail, CreateTime: timestamp, UpdateTime: timestamp}, nil),
+					)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: UpdateStudentEmailRequest{StudentID: studentID, Email: newEmail},
+			},
+			want: StudentResp{
+				Status:      common.StatusSuccess,
+				StudentData: &StudentDetail{StudentID: studentID, Email: newEmail, CreateTime: timestamp, UpdateTime: timestamp},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Student Not Found",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(nil, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: UpdateStudentEmailRequest{StudentID: studentID, Email: newEmail},
+			},
+			want:    StudentResp{Status: common.StatusFailure, Message: "student data not found"},
+			wantErr: false,
+		},
+		{
+			name: "Email Already Exists",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID, Email: "old@example.com"}, nil)
+					mock.EXPECT().UpdateStudentEmail(gomock.Any(), studentID, newEmail).Return(studentDomain.ErrEmailAlreadyExists)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: UpdateStudentEmailRequest{StudentID: studentID, Email: newEmail},
+			},
+			want:    StudentResp{Status: common.StatusFailure, Message: "email is already registered"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			studentUC := &StudentUseCase{
+				studentService: tt.fields.studentService,
+			}
+			got, err := studentUC.UpdateStudentEmail(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StudentUseCase.UpdateStudentEmail() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StudentUseCase.UpdateStudentEmail() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStudentUseCase_ListStudents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	timestamp := time.Date(2023, 8, 25, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		studentService studentDomain.StudentDomainItf
+	}
+	type args struct {
+		ctx context.Context
+		req ListStudentsRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    ListStudentsResp
+		wantErr bool
+	}{
+		{
+			name: "Success With Default Limit",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudents(gomock.Any(), DefaultListLimit, 0).Return([]studentDomain.Student{
+						{ID: 1, Email: "student1@example.com", CreateTime: timestamp, UpdateTime: timestamp},
+					}, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: ListStudentsRequest{},
+			},
+			want: ListStudentsResp{
+				Status: common.StatusSuccess,
+				Students: []StudentDetail{
+					{StudentID: 1, Email: "student1@example.com", CreateTime: timestamp, UpdateTime: timestamp},
+				},
+				Limit:  DefaultListLimit,
+				Offset: 0,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Limit Capped",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudents(gomock.Any(), MaxListLimit, 40).Return(nil, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: ListStudentsRequest{Limit: 1000, Offset: 40},
+			},
+			want: ListStudentsResp{
+				Status: common.StatusSuccess,
+				Limit:  MaxListLimit,
+				Offset: 40,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Failed to Retrieve Students",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudents(gomock.Any(), DefaultListLimit, 0).Return(nil, errors.New("db error"))
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: ListStudentsRequest{},
+			},
+			want:    ListStudentsResp{Status: common.StatusFailure, Message: "failed to retrieve students"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			studentUC := &StudentUseCase{
+				studentService: tt.fields.studentService,
+			}
+			got, err := studentUC.ListStudents(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StudentUseCase.ListStudents() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StudentUseCase.ListStudents() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStudentUseCase_DeleteStudent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const studentID int64 = 1
+
+	type fields struct {
+		studentService    studentDomain.StudentDomainItf
+		enrollmentUseCase enrollmentUseCase.EnrollmentUseCaseItf
+	}
+	type args struct {
+		ctx       context.Context
+		studentID int64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    DeleteStudentResp
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID}, nil)
+					mock.EXPECT().DeleteStudent(gomock.Any(), studentID).Return(nil)
+					return mock
+				}(),
+				enrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mock := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mock.EXPECT().CancelStudentEnrollments(gomock.Any(), studentID).Return(nil)
+					return mock
+				}(),
+			},
+			args:    args{ctx: adminCtx, studentID: studentID},
+			want:    DeleteStudentResp{Status: common.StatusSuccess},
+			wantErr: false,
+		},
+		{
+			name: "Student Not Found",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(nil, nil)
+					return mock
+				}(),
+				enrollmentUseCase: enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl),
+			},
+			args:    args{ctx: adminCtx, studentID: studentID},
+			want:    DeleteStudentResp{Status: common.StatusFailure, Message: "student data not found"},
+			wantErr: false,
+		},
+		{
+			name: "Failed to Cancel Enrollment",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID}, nil)
+					return mock
+				}(),
+				enrollmentUseCase: func() enrollmentUseCase.EnrollmentUseCaseItf {
+					mock := enrollmentUseCaseMock.NewMockEnrollmentUseCaseItf(ctrl)
+					mock.EXPECT().CancelStudentEnrollments(gomock.Any(), studentID).Return(errors.New("db error"))
+					return mock
+				}(),
+			},
+			args:    args{ctx: adminCtx, studentID: studentID},
+			want:    DeleteStudentResp{Status: common.StatusFailure, Message: "failed to cancel course enrollment"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			studentUC := &StudentUseCase{
+				studentService:    tt.fields.studentService,
+				enrollmentUseCase: tt.fields.enrollmentUseCase,
+				unitOfWork:        testutil.NewPassThroughUnitOfWork(ctrl),
+			}
+			got, err := studentUC.DeleteStudent(tt.args.ctx, tt.args.studentID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StudentUseCase.DeleteStudent() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StudentUseCase.DeleteStudent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStudentUseCase_GetPrivacySettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const studentID int64 = 1
+
+	type fields struct {
+		studentService studentDomain.StudentDomainItf
+	}
+	type args struct {
+		ctx       context.Context
+		studentID int64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    PrivacySettingsResp
+		wantErr bool
+	}{
+		{
+			name: "Own Settings",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{
+						ID:      studentID,
+						Privacy: studentDomain.PrivacySettings{Visibility: studentDomain.VisibilityHidden},
+					}, nil)
+					return mock
+				}(),
+			},
+			args: args{ctx: studentCtx(studentID), studentID: studentID},
+			want: PrivacySettingsResp{
+				Status:  common.StatusSuccess,
+				Privacy: &PrivacySettingsDetail{StudentID: studentID, Visibility: studentDomain.VisibilityHidden},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Another Student",
+			fields: fields{
+				studentService: studentDomainMock.NewMockStudentDomainItf(ctrl),
+			},
+			args:    args{ctx: studentCtx(2), studentID: studentID},
+			want:    PrivacySettingsResp{Status: common.StatusFailure, Message: "permission denied"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			studentUC := &StudentUseCase{
+				studentService: tt.fields.studentService,
+			}
+			got, err := studentUC.GetPrivacySettings(tt.args.ctx, tt.args.studentID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StudentUseCase.GetPrivacySettings() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StudentUseCase.GetPrivacySettings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStudentUseCase_UpdatePrivacySettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const studentID int64 = 1
+	settings := studentDomain.PrivacySettings{Visibility: studentDomain.VisibilityInstructors, ShowEmail: true}
+
+	type fields struct {
+		studentService studentDomain.StudentDomainItf
+	}
+	type args struct {
+		ctx context.Context
+		req UpdatePrivacySettingsRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    PrivacySettingsResp
+		wantErr bool
+	}{
+		{
+			name: "Own Settings",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					gomock.InOrder(
+						mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID}, nil),
+						mock.EXPECT().UpdatePrivacySettings(gomock.Any(), studentID, settings).Return(nil),
+					)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: studentCtx(studentID),
+				req: UpdatePrivacySettingsRequest{StudentID: studentID, Visibility: studentDomain.VisibilityInstructors, ShowEmail: true},
+			},
+			want: PrivacySettingsResp{
+				Status:  common.StatusSuccess,
+				Privacy: &PrivacySettingsDetail{StudentID: studentID, Visibility: studentDomain.VisibilityInstructors, ShowEmail: true},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Another Student",
+			fields: fields{
+				studentService: studentDomainMock.NewMockStudentDomainItf(ctrl),
+			},
+			args: args{
+				ctx: studentCtx(2),
+				req: UpdatePrivacySettingsRequest{StudentID: studentID, Visibility: studentDomain.VisibilityHidden},
+			},
+			want:    PrivacySettingsResp{Status: common.StatusFailure, Message: "permission denied"},
+			wantErr: true,
+		},
+		{
+			name: "Student Not Found",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(nil, nil)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: UpdatePrivacySettingsRequest{StudentID: studentID, Visibility: studentDomain.VisibilityHidden},
+			},
+			want:    PrivacySettingsResp{Status: common.StatusFailure, Message: "student data not found"},
+			wantErr: false,
+		},
+		{
+			name: "Invalid Visibility",
+			fields: fields{
+				studentService: func() studentDomain.StudentDomainItf {
+					mock := studentDomainMock.NewMockStudentDomainItf(ctrl)
+					mock.EXPECT().GetStudentByID(gomock.Any(), studentID).Return(&studentDomain.Student{ID: studentID}, nil)
+					mock.EXPECT().UpdatePrivacySettings(gomock.Any(), studentID, studentDomain.PrivacySettings{Visibility: "everyone"}).Return(studentDomain.ErrInvalidVisibility)
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: adminCtx,
+				req: UpdatePrivacySettingsRequest{StudentID: studentID, Visibility: "everyone"},
+			},
+			want:    PrivacySettingsResp{Status: common.StatusFailure, Message: "invalid visibility"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			studentUC := &StudentUseCase{
+				studentService: tt.fields.studentService,
+			}
+			got, err := studentUC.UpdatePrivacySettings(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StudentUseCase.UpdatePrivacySettings() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StudentUseCase.UpdatePrivacySettings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package studentusecase
+
+import (
+	"time"
+
+	studentDomain "github/rakadityas/course-management-system/domain/student"
+)
+
+// Student related
+type (
+	// CreateStudentRequest represents the request payload for registering a student.
+	CreateStudentRequest struct {
+		Email string `json:"email"`
+	}
+
+	// UpdateStudentEmailRequest represents the request payload for changing a student's email.
+	UpdateStudentEmailRequest struct {
+		StudentID int64  `json:"-"`
+		Email     string `json:"email"`
+	}
+
+	// StudentResp represents the response structure for a single student operation.
+	StudentResp struct {
+		Status      string         `json:"status"`
+		Message     string         `json:"message,omitempty"`
+		StudentData *StudentDetail `json:"student_data,omitempty"`
+	}
+
+	// StudentDetail provides detailed information about a student.
+	StudentDetail struct {
+		StudentID  int64     `json:"student_id"`
+		Email      string    `json:"email"`
+		CreateTime time.Time `json:"create_time"`
+		UpdateTime time.Time `json:"update_time"`
+	}
+)
+
+// ListStudents related
+type (
+	// ListStudentsRequest represents the pagination parameters for listing students.
+	ListStudentsRequest struct {
+		Limit  int
+		Offset int
+	}
+
+	// ListStudentsResp represents the response structure for listing students.
+	ListStudentsResp struct {
+		Status   string          `json:"status"`
+		Message  string          `json:"message,omitempty"`
+		Students []StudentDetail `json:"students,omitempty"`
+		Limit    int             `json:"limit"`
+		Offset   int             `json:"offset"`
+	}
+)
+
+// DeleteStudent related
+type (
+	// DeleteStudentResp represents the response structure for deleting a student.
+	DeleteStudentResp struct {
+		Status  string `json:"status"`
+		Message string `json:"message,omitempty"`
+	}
+)
+
+// PrivacySettings related
+type (
+	// UpdatePrivacySettingsRequest represents the request payload for changing a student's privacy settings.
+	UpdatePrivacySettingsRequest struct {
+		StudentID  int64                    `json:"-"`
+		Visibility studentDomain.Visibility `json:"visibility"`
+		ShowEmail  bool                     `json:"show_email"`
+	}
+
+	// PrivacySettingsResp represents the response structure for reading or changing privacy settings.
+	PrivacySettingsResp struct {
+		Status  string                 `json:"status"`
+		Message string                 `json:"message,omitempty"`
+		Privacy *PrivacySettingsDetail `json:"privacy,omitempty"`
+	}
+
+	// PrivacySettingsDetail provides the privacy settings of a student.
+	PrivacySettingsDetail struct {
+		StudentID  int64                    `json:"student_id"`
+		Visibility studentDomain.Visibility `json:"visibility"`
+		ShowEmail  bool                     `json:"show_email"`
+	}
+)